@@ -0,0 +1,2637 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package identity
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Groth16VerifyingKey_5_list)(nil)
+
+type _Groth16VerifyingKey_5_list struct {
+	list *[][]byte
+}
+
+func (x *_Groth16VerifyingKey_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Groth16VerifyingKey_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_Groth16VerifyingKey_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Groth16VerifyingKey_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Groth16VerifyingKey_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Groth16VerifyingKey at list field Ic as it is not of Message kind"))
+}
+
+func (x *_Groth16VerifyingKey_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Groth16VerifyingKey_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_Groth16VerifyingKey_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Groth16VerifyingKey       protoreflect.MessageDescriptor
+	fd_Groth16VerifyingKey_alpha protoreflect.FieldDescriptor
+	fd_Groth16VerifyingKey_beta  protoreflect.FieldDescriptor
+	fd_Groth16VerifyingKey_gamma protoreflect.FieldDescriptor
+	fd_Groth16VerifyingKey_delta protoreflect.FieldDescriptor
+	fd_Groth16VerifyingKey_ic    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_attribute_proto_init()
+	md_Groth16VerifyingKey = File_nexelra_identity_attribute_proto.Messages().ByName("Groth16VerifyingKey")
+	fd_Groth16VerifyingKey_alpha = md_Groth16VerifyingKey.Fields().ByName("alpha")
+	fd_Groth16VerifyingKey_beta = md_Groth16VerifyingKey.Fields().ByName("beta")
+	fd_Groth16VerifyingKey_gamma = md_Groth16VerifyingKey.Fields().ByName("gamma")
+	fd_Groth16VerifyingKey_delta = md_Groth16VerifyingKey.Fields().ByName("delta")
+	fd_Groth16VerifyingKey_ic = md_Groth16VerifyingKey.Fields().ByName("ic")
+}
+
+var _ protoreflect.Message = (*fastReflection_Groth16VerifyingKey)(nil)
+
+type fastReflection_Groth16VerifyingKey Groth16VerifyingKey
+
+func (x *Groth16VerifyingKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Groth16VerifyingKey)(x)
+}
+
+func (x *Groth16VerifyingKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_attribute_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Groth16VerifyingKey_messageType fastReflection_Groth16VerifyingKey_messageType
+var _ protoreflect.MessageType = fastReflection_Groth16VerifyingKey_messageType{}
+
+type fastReflection_Groth16VerifyingKey_messageType struct{}
+
+func (x fastReflection_Groth16VerifyingKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Groth16VerifyingKey)(nil)
+}
+func (x fastReflection_Groth16VerifyingKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_Groth16VerifyingKey)
+}
+func (x fastReflection_Groth16VerifyingKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Groth16VerifyingKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Groth16VerifyingKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_Groth16VerifyingKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Groth16VerifyingKey) Type() protoreflect.MessageType {
+	return _fastReflection_Groth16VerifyingKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Groth16VerifyingKey) New() protoreflect.Message {
+	return new(fastReflection_Groth16VerifyingKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Groth16VerifyingKey) Interface() protoreflect.ProtoMessage {
+	return (*Groth16VerifyingKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Groth16VerifyingKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Alpha) != 0 {
+		value := protoreflect.ValueOfBytes(x.Alpha)
+		if !f(fd_Groth16VerifyingKey_alpha, value) {
+			return
+		}
+	}
+	if len(x.Beta) != 0 {
+		value := protoreflect.ValueOfBytes(x.Beta)
+		if !f(fd_Groth16VerifyingKey_beta, value) {
+			return
+		}
+	}
+	if len(x.Gamma) != 0 {
+		value := protoreflect.ValueOfBytes(x.Gamma)
+		if !f(fd_Groth16VerifyingKey_gamma, value) {
+			return
+		}
+	}
+	if len(x.Delta) != 0 {
+		value := protoreflect.ValueOfBytes(x.Delta)
+		if !f(fd_Groth16VerifyingKey_delta, value) {
+			return
+		}
+	}
+	if len(x.Ic) != 0 {
+		value := protoreflect.ValueOfList(&_Groth16VerifyingKey_5_list{list: &x.Ic})
+		if !f(fd_Groth16VerifyingKey_ic, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Groth16VerifyingKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		return len(x.Alpha) != 0
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		return len(x.Beta) != 0
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		return len(x.Gamma) != 0
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		return len(x.Delta) != 0
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		return len(x.Ic) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Groth16VerifyingKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		x.Alpha = nil
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		x.Beta = nil
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		x.Gamma = nil
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		x.Delta = nil
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		x.Ic = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Groth16VerifyingKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		value := x.Alpha
+		return protoreflect.ValueOfBytes(value)
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		value := x.Beta
+		return protoreflect.ValueOfBytes(value)
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		value := x.Gamma
+		return protoreflect.ValueOfBytes(value)
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		value := x.Delta
+		return protoreflect.ValueOfBytes(value)
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		if len(x.Ic) == 0 {
+			return protoreflect.ValueOfList(&_Groth16VerifyingKey_5_list{})
+		}
+		listValue := &_Groth16VerifyingKey_5_list{list: &x.Ic}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Groth16VerifyingKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		x.Alpha = value.Bytes()
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		x.Beta = value.Bytes()
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		x.Gamma = value.Bytes()
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		x.Delta = value.Bytes()
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		lv := value.List()
+		clv := lv.(*_Groth16VerifyingKey_5_list)
+		x.Ic = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Groth16VerifyingKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		if x.Ic == nil {
+			x.Ic = [][]byte{}
+		}
+		value := &_Groth16VerifyingKey_5_list{list: &x.Ic}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		panic(fmt.Errorf("field alpha of message nexelra.identity.Groth16VerifyingKey is not mutable"))
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		panic(fmt.Errorf("field beta of message nexelra.identity.Groth16VerifyingKey is not mutable"))
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		panic(fmt.Errorf("field gamma of message nexelra.identity.Groth16VerifyingKey is not mutable"))
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		panic(fmt.Errorf("field delta of message nexelra.identity.Groth16VerifyingKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Groth16VerifyingKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.Groth16VerifyingKey.alpha":
+		return protoreflect.ValueOfBytes(nil)
+	case "nexelra.identity.Groth16VerifyingKey.beta":
+		return protoreflect.ValueOfBytes(nil)
+	case "nexelra.identity.Groth16VerifyingKey.gamma":
+		return protoreflect.ValueOfBytes(nil)
+	case "nexelra.identity.Groth16VerifyingKey.delta":
+		return protoreflect.ValueOfBytes(nil)
+	case "nexelra.identity.Groth16VerifyingKey.ic":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_Groth16VerifyingKey_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Groth16VerifyingKey"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.Groth16VerifyingKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Groth16VerifyingKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.Groth16VerifyingKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Groth16VerifyingKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Groth16VerifyingKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Groth16VerifyingKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Groth16VerifyingKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Groth16VerifyingKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Alpha)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Beta)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Gamma)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delta)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Ic) > 0 {
+			for _, b := range x.Ic {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Groth16VerifyingKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ic) > 0 {
+			for iNdEx := len(x.Ic) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Ic[iNdEx])
+				copy(dAtA[i:], x.Ic[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ic[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Delta) > 0 {
+			i -= len(x.Delta)
+			copy(dAtA[i:], x.Delta)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delta)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Gamma) > 0 {
+			i -= len(x.Gamma)
+			copy(dAtA[i:], x.Gamma)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Gamma)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Beta) > 0 {
+			i -= len(x.Beta)
+			copy(dAtA[i:], x.Beta)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beta)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Alpha) > 0 {
+			i -= len(x.Alpha)
+			copy(dAtA[i:], x.Alpha)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Alpha)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Groth16VerifyingKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Groth16VerifyingKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Groth16VerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Alpha = append(x.Alpha[:0], dAtA[iNdEx:postIndex]...)
+				if x.Alpha == nil {
+					x.Alpha = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beta = append(x.Beta[:0], dAtA[iNdEx:postIndex]...)
+				if x.Beta == nil {
+					x.Beta = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Gamma = append(x.Gamma[:0], dAtA[iNdEx:postIndex]...)
+				if x.Gamma == nil {
+					x.Gamma = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delta = append(x.Delta[:0], dAtA[iNdEx:postIndex]...)
+				if x.Delta == nil {
+					x.Delta = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ic", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ic = append(x.Ic, make([]byte, postIndex-iNdEx))
+				copy(x.Ic[len(x.Ic)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AttributeCircuit_4_list)(nil)
+
+type _AttributeCircuit_4_list struct {
+	list *[]string
+}
+
+func (x *_AttributeCircuit_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AttributeCircuit_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AttributeCircuit_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AttributeCircuit_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AttributeCircuit_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AttributeCircuit at list field Parameters as it is not of Message kind"))
+}
+
+func (x *_AttributeCircuit_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AttributeCircuit_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AttributeCircuit_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AttributeCircuit                  protoreflect.MessageDescriptor
+	fd_AttributeCircuit_attribute        protoreflect.FieldDescriptor
+	fd_AttributeCircuit_description      protoreflect.FieldDescriptor
+	fd_AttributeCircuit_verifyingKey     protoreflect.FieldDescriptor
+	fd_AttributeCircuit_parameters       protoreflect.FieldDescriptor
+	fd_AttributeCircuit_flagDuration     protoreflect.FieldDescriptor
+	fd_AttributeCircuit_registeredHeight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_attribute_proto_init()
+	md_AttributeCircuit = File_nexelra_identity_attribute_proto.Messages().ByName("AttributeCircuit")
+	fd_AttributeCircuit_attribute = md_AttributeCircuit.Fields().ByName("attribute")
+	fd_AttributeCircuit_description = md_AttributeCircuit.Fields().ByName("description")
+	fd_AttributeCircuit_verifyingKey = md_AttributeCircuit.Fields().ByName("verifyingKey")
+	fd_AttributeCircuit_parameters = md_AttributeCircuit.Fields().ByName("parameters")
+	fd_AttributeCircuit_flagDuration = md_AttributeCircuit.Fields().ByName("flagDuration")
+	fd_AttributeCircuit_registeredHeight = md_AttributeCircuit.Fields().ByName("registeredHeight")
+}
+
+var _ protoreflect.Message = (*fastReflection_AttributeCircuit)(nil)
+
+type fastReflection_AttributeCircuit AttributeCircuit
+
+func (x *AttributeCircuit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttributeCircuit)(x)
+}
+
+func (x *AttributeCircuit) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_attribute_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AttributeCircuit_messageType fastReflection_AttributeCircuit_messageType
+var _ protoreflect.MessageType = fastReflection_AttributeCircuit_messageType{}
+
+type fastReflection_AttributeCircuit_messageType struct{}
+
+func (x fastReflection_AttributeCircuit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttributeCircuit)(nil)
+}
+func (x fastReflection_AttributeCircuit_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttributeCircuit)
+}
+func (x fastReflection_AttributeCircuit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeCircuit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AttributeCircuit) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeCircuit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AttributeCircuit) Type() protoreflect.MessageType {
+	return _fastReflection_AttributeCircuit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AttributeCircuit) New() protoreflect.Message {
+	return new(fastReflection_AttributeCircuit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AttributeCircuit) Interface() protoreflect.ProtoMessage {
+	return (*AttributeCircuit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AttributeCircuit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Attribute != "" {
+		value := protoreflect.ValueOfString(x.Attribute)
+		if !f(fd_AttributeCircuit_attribute, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_AttributeCircuit_description, value) {
+			return
+		}
+	}
+	if x.VerifyingKey != nil {
+		value := protoreflect.ValueOfMessage(x.VerifyingKey.ProtoReflect())
+		if !f(fd_AttributeCircuit_verifyingKey, value) {
+			return
+		}
+	}
+	if len(x.Parameters) != 0 {
+		value := protoreflect.ValueOfList(&_AttributeCircuit_4_list{list: &x.Parameters})
+		if !f(fd_AttributeCircuit_parameters, value) {
+			return
+		}
+	}
+	if x.FlagDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FlagDuration)
+		if !f(fd_AttributeCircuit_flagDuration, value) {
+			return
+		}
+	}
+	if x.RegisteredHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RegisteredHeight)
+		if !f(fd_AttributeCircuit_registeredHeight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AttributeCircuit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeCircuit.attribute":
+		return x.Attribute != ""
+	case "nexelra.identity.AttributeCircuit.description":
+		return x.Description != ""
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		return x.VerifyingKey != nil
+	case "nexelra.identity.AttributeCircuit.parameters":
+		return len(x.Parameters) != 0
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		return x.FlagDuration != int64(0)
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		return x.RegisteredHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeCircuit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeCircuit.attribute":
+		x.Attribute = ""
+	case "nexelra.identity.AttributeCircuit.description":
+		x.Description = ""
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		x.VerifyingKey = nil
+	case "nexelra.identity.AttributeCircuit.parameters":
+		x.Parameters = nil
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		x.FlagDuration = int64(0)
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		x.RegisteredHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AttributeCircuit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.AttributeCircuit.attribute":
+		value := x.Attribute
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.AttributeCircuit.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		value := x.VerifyingKey
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nexelra.identity.AttributeCircuit.parameters":
+		if len(x.Parameters) == 0 {
+			return protoreflect.ValueOfList(&_AttributeCircuit_4_list{})
+		}
+		listValue := &_AttributeCircuit_4_list{list: &x.Parameters}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		value := x.FlagDuration
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		value := x.RegisteredHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeCircuit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeCircuit.attribute":
+		x.Attribute = value.Interface().(string)
+	case "nexelra.identity.AttributeCircuit.description":
+		x.Description = value.Interface().(string)
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		x.VerifyingKey = value.Message().Interface().(*Groth16VerifyingKey)
+	case "nexelra.identity.AttributeCircuit.parameters":
+		lv := value.List()
+		clv := lv.(*_AttributeCircuit_4_list)
+		x.Parameters = *clv.list
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		x.FlagDuration = value.Int()
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		x.RegisteredHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeCircuit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		if x.VerifyingKey == nil {
+			x.VerifyingKey = new(Groth16VerifyingKey)
+		}
+		return protoreflect.ValueOfMessage(x.VerifyingKey.ProtoReflect())
+	case "nexelra.identity.AttributeCircuit.parameters":
+		if x.Parameters == nil {
+			x.Parameters = []string{}
+		}
+		value := &_AttributeCircuit_4_list{list: &x.Parameters}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.AttributeCircuit.attribute":
+		panic(fmt.Errorf("field attribute of message nexelra.identity.AttributeCircuit is not mutable"))
+	case "nexelra.identity.AttributeCircuit.description":
+		panic(fmt.Errorf("field description of message nexelra.identity.AttributeCircuit is not mutable"))
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		panic(fmt.Errorf("field flagDuration of message nexelra.identity.AttributeCircuit is not mutable"))
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		panic(fmt.Errorf("field registeredHeight of message nexelra.identity.AttributeCircuit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AttributeCircuit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeCircuit.attribute":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.AttributeCircuit.description":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.AttributeCircuit.verifyingKey":
+		m := new(Groth16VerifyingKey)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nexelra.identity.AttributeCircuit.parameters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AttributeCircuit_4_list{list: &list})
+	case "nexelra.identity.AttributeCircuit.flagDuration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.AttributeCircuit.registeredHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeCircuit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeCircuit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AttributeCircuit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.AttributeCircuit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AttributeCircuit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeCircuit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AttributeCircuit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AttributeCircuit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AttributeCircuit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Attribute)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VerifyingKey != nil {
+			l = options.Size(x.VerifyingKey)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Parameters) > 0 {
+			for _, s := range x.Parameters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FlagDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.FlagDuration))
+		}
+		if x.RegisteredHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RegisteredHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AttributeCircuit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RegisteredHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RegisteredHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.FlagDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FlagDuration))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Parameters) > 0 {
+			for iNdEx := len(x.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Parameters[iNdEx])
+				copy(dAtA[i:], x.Parameters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Parameters[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.VerifyingKey != nil {
+			encoded, err := options.Marshal(x.VerifyingKey)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Attribute) > 0 {
+			i -= len(x.Attribute)
+			copy(dAtA[i:], x.Attribute)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attribute)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AttributeCircuit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeCircuit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attribute = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VerifyingKey == nil {
+					x.VerifyingKey = &Groth16VerifyingKey{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerifyingKey); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Parameters = append(x.Parameters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlagDuration", wireType)
+				}
+				x.FlagDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FlagDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+				}
+				x.RegisteredHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RegisteredHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AttributeFlag              protoreflect.MessageDescriptor
+	fd_AttributeFlag_address      protoreflect.FieldDescriptor
+	fd_AttributeFlag_attribute    protoreflect.FieldDescriptor
+	fd_AttributeFlag_idHash       protoreflect.FieldDescriptor
+	fd_AttributeFlag_provenAt     protoreflect.FieldDescriptor
+	fd_AttributeFlag_provenHeight protoreflect.FieldDescriptor
+	fd_AttributeFlag_expiresAt    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_attribute_proto_init()
+	md_AttributeFlag = File_nexelra_identity_attribute_proto.Messages().ByName("AttributeFlag")
+	fd_AttributeFlag_address = md_AttributeFlag.Fields().ByName("address")
+	fd_AttributeFlag_attribute = md_AttributeFlag.Fields().ByName("attribute")
+	fd_AttributeFlag_idHash = md_AttributeFlag.Fields().ByName("idHash")
+	fd_AttributeFlag_provenAt = md_AttributeFlag.Fields().ByName("provenAt")
+	fd_AttributeFlag_provenHeight = md_AttributeFlag.Fields().ByName("provenHeight")
+	fd_AttributeFlag_expiresAt = md_AttributeFlag.Fields().ByName("expiresAt")
+}
+
+var _ protoreflect.Message = (*fastReflection_AttributeFlag)(nil)
+
+type fastReflection_AttributeFlag AttributeFlag
+
+func (x *AttributeFlag) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttributeFlag)(x)
+}
+
+func (x *AttributeFlag) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_attribute_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AttributeFlag_messageType fastReflection_AttributeFlag_messageType
+var _ protoreflect.MessageType = fastReflection_AttributeFlag_messageType{}
+
+type fastReflection_AttributeFlag_messageType struct{}
+
+func (x fastReflection_AttributeFlag_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttributeFlag)(nil)
+}
+func (x fastReflection_AttributeFlag_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttributeFlag)
+}
+func (x fastReflection_AttributeFlag_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeFlag
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AttributeFlag) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttributeFlag
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AttributeFlag) Type() protoreflect.MessageType {
+	return _fastReflection_AttributeFlag_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AttributeFlag) New() protoreflect.Message {
+	return new(fastReflection_AttributeFlag)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AttributeFlag) Interface() protoreflect.ProtoMessage {
+	return (*AttributeFlag)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AttributeFlag) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AttributeFlag_address, value) {
+			return
+		}
+	}
+	if x.Attribute != "" {
+		value := protoreflect.ValueOfString(x.Attribute)
+		if !f(fd_AttributeFlag_attribute, value) {
+			return
+		}
+	}
+	if x.IdHash != "" {
+		value := protoreflect.ValueOfString(x.IdHash)
+		if !f(fd_AttributeFlag_idHash, value) {
+			return
+		}
+	}
+	if x.ProvenAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProvenAt)
+		if !f(fd_AttributeFlag_provenAt, value) {
+			return
+		}
+	}
+	if x.ProvenHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProvenHeight)
+		if !f(fd_AttributeFlag_provenHeight, value) {
+			return
+		}
+	}
+	if x.ExpiresAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAt)
+		if !f(fd_AttributeFlag_expiresAt, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AttributeFlag) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		return x.Address != ""
+	case "nexelra.identity.AttributeFlag.attribute":
+		return x.Attribute != ""
+	case "nexelra.identity.AttributeFlag.idHash":
+		return x.IdHash != ""
+	case "nexelra.identity.AttributeFlag.provenAt":
+		return x.ProvenAt != int64(0)
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		return x.ProvenHeight != int64(0)
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		return x.ExpiresAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeFlag) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		x.Address = ""
+	case "nexelra.identity.AttributeFlag.attribute":
+		x.Attribute = ""
+	case "nexelra.identity.AttributeFlag.idHash":
+		x.IdHash = ""
+	case "nexelra.identity.AttributeFlag.provenAt":
+		x.ProvenAt = int64(0)
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		x.ProvenHeight = int64(0)
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		x.ExpiresAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AttributeFlag) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.AttributeFlag.attribute":
+		value := x.Attribute
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.AttributeFlag.idHash":
+		value := x.IdHash
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.AttributeFlag.provenAt":
+		value := x.ProvenAt
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		value := x.ProvenHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		value := x.ExpiresAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeFlag) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		x.Address = value.Interface().(string)
+	case "nexelra.identity.AttributeFlag.attribute":
+		x.Attribute = value.Interface().(string)
+	case "nexelra.identity.AttributeFlag.idHash":
+		x.IdHash = value.Interface().(string)
+	case "nexelra.identity.AttributeFlag.provenAt":
+		x.ProvenAt = value.Int()
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		x.ProvenHeight = value.Int()
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		x.ExpiresAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeFlag) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		panic(fmt.Errorf("field address of message nexelra.identity.AttributeFlag is not mutable"))
+	case "nexelra.identity.AttributeFlag.attribute":
+		panic(fmt.Errorf("field attribute of message nexelra.identity.AttributeFlag is not mutable"))
+	case "nexelra.identity.AttributeFlag.idHash":
+		panic(fmt.Errorf("field idHash of message nexelra.identity.AttributeFlag is not mutable"))
+	case "nexelra.identity.AttributeFlag.provenAt":
+		panic(fmt.Errorf("field provenAt of message nexelra.identity.AttributeFlag is not mutable"))
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		panic(fmt.Errorf("field provenHeight of message nexelra.identity.AttributeFlag is not mutable"))
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		panic(fmt.Errorf("field expiresAt of message nexelra.identity.AttributeFlag is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AttributeFlag) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.AttributeFlag.address":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.AttributeFlag.attribute":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.AttributeFlag.idHash":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.AttributeFlag.provenAt":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.AttributeFlag.provenHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.AttributeFlag.expiresAt":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.AttributeFlag"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.AttributeFlag does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AttributeFlag) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.AttributeFlag", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AttributeFlag) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttributeFlag) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AttributeFlag) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AttributeFlag) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AttributeFlag)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Attribute)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IdHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProvenAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProvenAt))
+		}
+		if x.ProvenHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProvenHeight))
+		}
+		if x.ExpiresAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AttributeFlag)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiresAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAt))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ProvenHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProvenHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ProvenAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProvenAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.IdHash) > 0 {
+			i -= len(x.IdHash)
+			copy(dAtA[i:], x.IdHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IdHash)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Attribute) > 0 {
+			i -= len(x.Attribute)
+			copy(dAtA[i:], x.Attribute)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attribute)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AttributeFlag)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeFlag: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttributeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attribute = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IdHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IdHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProvenAt", wireType)
+				}
+				x.ProvenAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProvenAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProvenHeight", wireType)
+				}
+				x.ProvenHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProvenHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				}
+				x.ExpiresAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nexelra/identity/attribute.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Groth16VerifyingKey is the verifying key of a Groth16 circuit over BN254, as
+// set up with gnark. Points use the uncompressed EIP-197 encoding gnark's
+// MarshalSolidity writes: 64 bytes per G1 point and 128 bytes per G2 point.
+type Groth16VerifyingKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// alpha is a G1 point.
+	Alpha []byte `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// beta, gamma and delta are G2 points.
+	Beta  []byte `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	Gamma []byte `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Delta []byte `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// ic are the G1 commitments to the public inputs, preceded by the constant
+	// term.
+	Ic [][]byte `protobuf:"bytes,5,rep,name=ic,proto3" json:"ic,omitempty"`
+}
+
+func (x *Groth16VerifyingKey) Reset() {
+	*x = Groth16VerifyingKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_attribute_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Groth16VerifyingKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Groth16VerifyingKey) ProtoMessage() {}
+
+// Deprecated: Use Groth16VerifyingKey.ProtoReflect.Descriptor instead.
+func (*Groth16VerifyingKey) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Groth16VerifyingKey) GetAlpha() []byte {
+	if x != nil {
+		return x.Alpha
+	}
+	return nil
+}
+
+func (x *Groth16VerifyingKey) GetBeta() []byte {
+	if x != nil {
+		return x.Beta
+	}
+	return nil
+}
+
+func (x *Groth16VerifyingKey) GetGamma() []byte {
+	if x != nil {
+		return x.Gamma
+	}
+	return nil
+}
+
+func (x *Groth16VerifyingKey) GetDelta() []byte {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *Groth16VerifyingKey) GetIc() [][]byte {
+	if x != nil {
+		return x.Ic
+	}
+	return nil
+}
+
+// AttributeCircuit is a zero-knowledge circuit registered by governance to
+// prove a predicate on the CCCD data an identity committed to, such as "born
+// in or before N" or "province code in a set", without revealing the data.
+//
+// The public inputs of the circuit are, in order: the two 128-bit halves of
+// the identity's idHash, the holder's account address as a big-endian
+// integer, then the parameters.
+type AttributeCircuit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attribute is the name of the flag a valid proof grants, e.g. age-over-18.
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// description states the predicate the circuit proves.
+	Description  string               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	VerifyingKey *Groth16VerifyingKey `protobuf:"bytes,3,opt,name=verifyingKey,proto3" json:"verifyingKey,omitempty"`
+	// parameters are the public inputs fixed by governance, such as the year N
+	// or the Merkle root of a set of province codes, as decimal elements of the
+	// BN254 scalar field.
+	Parameters []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// flagDuration is how long (seconds) the flags granted by proofs last.
+	FlagDuration     int64 `protobuf:"varint,5,opt,name=flagDuration,proto3" json:"flagDuration,omitempty"`
+	RegisteredHeight int64 `protobuf:"varint,6,opt,name=registeredHeight,proto3" json:"registeredHeight,omitempty"`
+}
+
+func (x *AttributeCircuit) Reset() {
+	*x = AttributeCircuit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_attribute_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeCircuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeCircuit) ProtoMessage() {}
+
+// Deprecated: Use AttributeCircuit.ProtoReflect.Descriptor instead.
+func (*AttributeCircuit) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_attribute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttributeCircuit) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *AttributeCircuit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AttributeCircuit) GetVerifyingKey() *Groth16VerifyingKey {
+	if x != nil {
+		return x.VerifyingKey
+	}
+	return nil
+}
+
+func (x *AttributeCircuit) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AttributeCircuit) GetFlagDuration() int64 {
+	if x != nil {
+		return x.FlagDuration
+	}
+	return 0
+}
+
+func (x *AttributeCircuit) GetRegisteredHeight() int64 {
+	if x != nil {
+		return x.RegisteredHeight
+	}
+	return 0
+}
+
+// AttributeFlag records that the holder of an identity proved an attribute.
+// The flag holds until it expires, the identity's commitment changes or the
+// attribute's circuit is removed.
+type AttributeFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Attribute string `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// idHash is the commitment the proof was made against.
+	IdHash string `protobuf:"bytes,3,opt,name=idHash,proto3" json:"idHash,omitempty"`
+	// provenAt is the block time (unix seconds) of the proof.
+	ProvenAt     int64 `protobuf:"varint,4,opt,name=provenAt,proto3" json:"provenAt,omitempty"`
+	ProvenHeight int64 `protobuf:"varint,5,opt,name=provenHeight,proto3" json:"provenHeight,omitempty"`
+	// expiresAt is the time (unix seconds) the flag expires at.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *AttributeFlag) Reset() {
+	*x = AttributeFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_attribute_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFlag) ProtoMessage() {}
+
+// Deprecated: Use AttributeFlag.ProtoReflect.Descriptor instead.
+func (*AttributeFlag) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_attribute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttributeFlag) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AttributeFlag) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *AttributeFlag) GetIdHash() string {
+	if x != nil {
+		return x.IdHash
+	}
+	return ""
+}
+
+func (x *AttributeFlag) GetProvenAt() int64 {
+	if x != nil {
+		return x.ProvenAt
+	}
+	return 0
+}
+
+func (x *AttributeFlag) GetProvenHeight() int64 {
+	if x != nil {
+		return x.ProvenHeight
+	}
+	return 0
+}
+
+func (x *AttributeFlag) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+var File_nexelra_identity_attribute_proto protoreflect.FileDescriptor
+
+var file_nexelra_identity_attribute_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x13, 0x47, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x61, 0x6d, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x6d,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x63, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x63, 0x22, 0x93, 0x02, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0c,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x66, 0x6c, 0x61, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6c, 0x61, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbd, 0x01,
+	0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x42, 0xa5, 0x01,
+	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x10, 0x4e,
+	0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xca,
+	0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0xe2, 0x02, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x11, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x3a, 0x3a, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nexelra_identity_attribute_proto_rawDescOnce sync.Once
+	file_nexelra_identity_attribute_proto_rawDescData = file_nexelra_identity_attribute_proto_rawDesc
+)
+
+func file_nexelra_identity_attribute_proto_rawDescGZIP() []byte {
+	file_nexelra_identity_attribute_proto_rawDescOnce.Do(func() {
+		file_nexelra_identity_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(file_nexelra_identity_attribute_proto_rawDescData)
+	})
+	return file_nexelra_identity_attribute_proto_rawDescData
+}
+
+var file_nexelra_identity_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_nexelra_identity_attribute_proto_goTypes = []interface{}{
+	(*Groth16VerifyingKey)(nil), // 0: nexelra.identity.Groth16VerifyingKey
+	(*AttributeCircuit)(nil),    // 1: nexelra.identity.AttributeCircuit
+	(*AttributeFlag)(nil),       // 2: nexelra.identity.AttributeFlag
+}
+var file_nexelra_identity_attribute_proto_depIdxs = []int32{
+	0, // 0: nexelra.identity.AttributeCircuit.verifyingKey:type_name -> nexelra.identity.Groth16VerifyingKey
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_nexelra_identity_attribute_proto_init() }
+func file_nexelra_identity_attribute_proto_init() {
+	if File_nexelra_identity_attribute_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nexelra_identity_attribute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groth16VerifyingKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_attribute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeCircuit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_attribute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_nexelra_identity_attribute_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nexelra_identity_attribute_proto_goTypes,
+		DependencyIndexes: file_nexelra_identity_attribute_proto_depIdxs,
+		MessageInfos:      file_nexelra_identity_attribute_proto_msgTypes,
+	}.Build()
+	File_nexelra_identity_attribute_proto = out.File
+	file_nexelra_identity_attribute_proto_rawDesc = nil
+	file_nexelra_identity_attribute_proto_goTypes = nil
+	file_nexelra_identity_attribute_proto_depIdxs = nil
+}
@@ -13,10 +13,21 @@ import (
 )
 
 var (
-	md_Identity           protoreflect.MessageDescriptor
-	fd_Identity_address   protoreflect.FieldDescriptor
-	fd_Identity_idHash    protoreflect.FieldDescriptor
-	fd_Identity_createdAt protoreflect.FieldDescriptor
+	md_Identity                protoreflect.MessageDescriptor
+	fd_Identity_address        protoreflect.FieldDescriptor
+	fd_Identity_idHash         protoreflect.FieldDescriptor
+	fd_Identity_createdAt      protoreflect.FieldDescriptor
+	fd_Identity_createdHeight  protoreflect.FieldDescriptor
+	fd_Identity_hashScheme     protoreflect.FieldDescriptor
+	fd_Identity_status         protoreflect.FieldDescriptor
+	fd_Identity_updatedAt      protoreflect.FieldDescriptor
+	fd_Identity_updatedHeight  protoreflect.FieldDescriptor
+	fd_Identity_statusReason   protoreflect.FieldDescriptor
+	fd_Identity_verifier       protoreflect.FieldDescriptor
+	fd_Identity_attestedAt     protoreflect.FieldDescriptor
+	fd_Identity_attestedHeight protoreflect.FieldDescriptor
+	fd_Identity_level          protoreflect.FieldDescriptor
+	fd_Identity_cccdTag        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +36,17 @@ func init() {
 	fd_Identity_address = md_Identity.Fields().ByName("address")
 	fd_Identity_idHash = md_Identity.Fields().ByName("idHash")
 	fd_Identity_createdAt = md_Identity.Fields().ByName("createdAt")
+	fd_Identity_createdHeight = md_Identity.Fields().ByName("createdHeight")
+	fd_Identity_hashScheme = md_Identity.Fields().ByName("hashScheme")
+	fd_Identity_status = md_Identity.Fields().ByName("status")
+	fd_Identity_updatedAt = md_Identity.Fields().ByName("updatedAt")
+	fd_Identity_updatedHeight = md_Identity.Fields().ByName("updatedHeight")
+	fd_Identity_statusReason = md_Identity.Fields().ByName("statusReason")
+	fd_Identity_verifier = md_Identity.Fields().ByName("verifier")
+	fd_Identity_attestedAt = md_Identity.Fields().ByName("attestedAt")
+	fd_Identity_attestedHeight = md_Identity.Fields().ByName("attestedHeight")
+	fd_Identity_level = md_Identity.Fields().ByName("level")
+	fd_Identity_cccdTag = md_Identity.Fields().ByName("cccdTag")
 }
 
 var _ protoreflect.Message = (*fastReflection_Identity)(nil)
@@ -110,6 +132,72 @@ func (x *fastReflection_Identity) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.CreatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedHeight)
+		if !f(fd_Identity_createdHeight, value) {
+			return
+		}
+	}
+	if x.HashScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.HashScheme))
+		if !f(fd_Identity_hashScheme, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Identity_status, value) {
+			return
+		}
+	}
+	if x.UpdatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedAt)
+		if !f(fd_Identity_updatedAt, value) {
+			return
+		}
+	}
+	if x.UpdatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedHeight)
+		if !f(fd_Identity_updatedHeight, value) {
+			return
+		}
+	}
+	if x.StatusReason != "" {
+		value := protoreflect.ValueOfString(x.StatusReason)
+		if !f(fd_Identity_statusReason, value) {
+			return
+		}
+	}
+	if x.Verifier != "" {
+		value := protoreflect.ValueOfString(x.Verifier)
+		if !f(fd_Identity_verifier, value) {
+			return
+		}
+	}
+	if x.AttestedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AttestedAt)
+		if !f(fd_Identity_attestedAt, value) {
+			return
+		}
+	}
+	if x.AttestedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AttestedHeight)
+		if !f(fd_Identity_attestedHeight, value) {
+			return
+		}
+	}
+	if x.Level != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Level))
+		if !f(fd_Identity_level, value) {
+			return
+		}
+	}
+	if x.CccdTag != "" {
+		value := protoreflect.ValueOfString(x.CccdTag)
+		if !f(fd_Identity_cccdTag, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +219,28 @@ func (x *fastReflection_Identity) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.IdHash != ""
 	case "nexelra.identity.Identity.createdAt":
 		return x.CreatedAt != int64(0)
+	case "nexelra.identity.Identity.createdHeight":
+		return x.CreatedHeight != int64(0)
+	case "nexelra.identity.Identity.hashScheme":
+		return x.HashScheme != 0
+	case "nexelra.identity.Identity.status":
+		return x.Status != 0
+	case "nexelra.identity.Identity.updatedAt":
+		return x.UpdatedAt != int64(0)
+	case "nexelra.identity.Identity.updatedHeight":
+		return x.UpdatedHeight != int64(0)
+	case "nexelra.identity.Identity.statusReason":
+		return x.StatusReason != ""
+	case "nexelra.identity.Identity.verifier":
+		return x.Verifier != ""
+	case "nexelra.identity.Identity.attestedAt":
+		return x.AttestedAt != int64(0)
+	case "nexelra.identity.Identity.attestedHeight":
+		return x.AttestedHeight != int64(0)
+	case "nexelra.identity.Identity.level":
+		return x.Level != 0
+	case "nexelra.identity.Identity.cccdTag":
+		return x.CccdTag != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -153,6 +263,28 @@ func (x *fastReflection_Identity) Clear(fd protoreflect.FieldDescriptor) {
 		x.IdHash = ""
 	case "nexelra.identity.Identity.createdAt":
 		x.CreatedAt = int64(0)
+	case "nexelra.identity.Identity.createdHeight":
+		x.CreatedHeight = int64(0)
+	case "nexelra.identity.Identity.hashScheme":
+		x.HashScheme = 0
+	case "nexelra.identity.Identity.status":
+		x.Status = 0
+	case "nexelra.identity.Identity.updatedAt":
+		x.UpdatedAt = int64(0)
+	case "nexelra.identity.Identity.updatedHeight":
+		x.UpdatedHeight = int64(0)
+	case "nexelra.identity.Identity.statusReason":
+		x.StatusReason = ""
+	case "nexelra.identity.Identity.verifier":
+		x.Verifier = ""
+	case "nexelra.identity.Identity.attestedAt":
+		x.AttestedAt = int64(0)
+	case "nexelra.identity.Identity.attestedHeight":
+		x.AttestedHeight = int64(0)
+	case "nexelra.identity.Identity.level":
+		x.Level = 0
+	case "nexelra.identity.Identity.cccdTag":
+		x.CccdTag = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -178,6 +310,39 @@ func (x *fastReflection_Identity) Get(descriptor protoreflect.FieldDescriptor) p
 	case "nexelra.identity.Identity.createdAt":
 		value := x.CreatedAt
 		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.createdHeight":
+		value := x.CreatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.hashScheme":
+		value := x.HashScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.Identity.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.Identity.updatedAt":
+		value := x.UpdatedAt
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.updatedHeight":
+		value := x.UpdatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.statusReason":
+		value := x.StatusReason
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.Identity.verifier":
+		value := x.Verifier
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.Identity.attestedAt":
+		value := x.AttestedAt
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.attestedHeight":
+		value := x.AttestedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.Identity.level":
+		value := x.Level
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.Identity.cccdTag":
+		value := x.CccdTag
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -204,6 +369,28 @@ func (x *fastReflection_Identity) Set(fd protoreflect.FieldDescriptor, value pro
 		x.IdHash = value.Interface().(string)
 	case "nexelra.identity.Identity.createdAt":
 		x.CreatedAt = value.Int()
+	case "nexelra.identity.Identity.createdHeight":
+		x.CreatedHeight = value.Int()
+	case "nexelra.identity.Identity.hashScheme":
+		x.HashScheme = (HashScheme)(value.Enum())
+	case "nexelra.identity.Identity.status":
+		x.Status = (IdentityStatus)(value.Enum())
+	case "nexelra.identity.Identity.updatedAt":
+		x.UpdatedAt = value.Int()
+	case "nexelra.identity.Identity.updatedHeight":
+		x.UpdatedHeight = value.Int()
+	case "nexelra.identity.Identity.statusReason":
+		x.StatusReason = value.Interface().(string)
+	case "nexelra.identity.Identity.verifier":
+		x.Verifier = value.Interface().(string)
+	case "nexelra.identity.Identity.attestedAt":
+		x.AttestedAt = value.Int()
+	case "nexelra.identity.Identity.attestedHeight":
+		x.AttestedHeight = value.Int()
+	case "nexelra.identity.Identity.level":
+		x.Level = (IdentityLevel)(value.Enum())
+	case "nexelra.identity.Identity.cccdTag":
+		x.CccdTag = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -230,6 +417,28 @@ func (x *fastReflection_Identity) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field idHash of message nexelra.identity.Identity is not mutable"))
 	case "nexelra.identity.Identity.createdAt":
 		panic(fmt.Errorf("field createdAt of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.createdHeight":
+		panic(fmt.Errorf("field createdHeight of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.hashScheme":
+		panic(fmt.Errorf("field hashScheme of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.status":
+		panic(fmt.Errorf("field status of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.updatedAt":
+		panic(fmt.Errorf("field updatedAt of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.updatedHeight":
+		panic(fmt.Errorf("field updatedHeight of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.statusReason":
+		panic(fmt.Errorf("field statusReason of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.verifier":
+		panic(fmt.Errorf("field verifier of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.attestedAt":
+		panic(fmt.Errorf("field attestedAt of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.attestedHeight":
+		panic(fmt.Errorf("field attestedHeight of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.level":
+		panic(fmt.Errorf("field level of message nexelra.identity.Identity is not mutable"))
+	case "nexelra.identity.Identity.cccdTag":
+		panic(fmt.Errorf("field cccdTag of message nexelra.identity.Identity is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -249,6 +458,28 @@ func (x *fastReflection_Identity) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "nexelra.identity.Identity.createdAt":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.createdHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.hashScheme":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.Identity.status":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.Identity.updatedAt":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.updatedHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.statusReason":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.Identity.verifier":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.Identity.attestedAt":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.attestedHeight":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.Identity.level":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.Identity.cccdTag":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Identity"))
@@ -329,6 +560,42 @@ func (x *fastReflection_Identity) ProtoMethods() *protoiface.Methods {
 		if x.CreatedAt != 0 {
 			n += 1 + runtime.Sov(uint64(x.CreatedAt))
 		}
+		if x.CreatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedHeight))
+		}
+		if x.HashScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.HashScheme))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.UpdatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedAt))
+		}
+		if x.UpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedHeight))
+		}
+		l = len(x.StatusReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Verifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AttestedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttestedAt))
+		}
+		if x.AttestedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttestedHeight))
+		}
+		if x.Level != 0 {
+			n += 1 + runtime.Sov(uint64(x.Level))
+		}
+		l = len(x.CccdTag)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -358,6 +625,67 @@ func (x *fastReflection_Identity) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CccdTag) > 0 {
+			i -= len(x.CccdTag)
+			copy(dAtA[i:], x.CccdTag)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CccdTag)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.Level != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Level))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.AttestedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestedHeight))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.AttestedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestedAt))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Verifier) > 0 {
+			i -= len(x.Verifier)
+			copy(dAtA[i:], x.Verifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Verifier)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.StatusReason) > 0 {
+			i -= len(x.StatusReason)
+			copy(dAtA[i:], x.StatusReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StatusReason)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.UpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.UpdatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedAt))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.HashScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HashScheme))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CreatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedHeight))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.CreatedAt != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedAt))
 			i--
@@ -509,6 +837,254 @@ func (x *fastReflection_Identity) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				}
+				x.CreatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+				}
+				x.HashScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HashScheme |= HashScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= IdentityStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				}
+				x.UpdatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+				}
+				x.UpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StatusReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Verifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestedAt", wireType)
+				}
+				x.AttestedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttestedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+				}
+				x.AttestedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttestedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				}
+				x.Level = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Level |= IdentityLevel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CccdTag", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CccdTag = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -557,14 +1133,222 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// HashScheme identifies how an identity's idHash was derived from the CCCD ID.
+type HashScheme int32
+
+const (
+	// HASH_SCHEME_SHA256 is the legacy scheme: bare SHA-256 of the raw CCCD ID,
+	// computed on-chain from the transaction.
+	HashScheme_HASH_SCHEME_SHA256 HashScheme = 0
+	// HASH_SCHEME_HMAC_SHA256 is HMAC-SHA256 of the CCCD ID keyed by the
+	// chain-wide pepper in Params, computed client-side. The pepper is public
+	// and CCCD IDs are few enough to be enumerated, so the commitment does not
+	// hide the CCCD ID.
+	HashScheme_HASH_SCHEME_HMAC_SHA256 HashScheme = 1
+	// HASH_SCHEME_SALTED_HMAC_SHA256 is HMAC-SHA256 of a random 32-byte salt
+	// followed by the CCCD ID, keyed by the pepper, computed client-side. The
+	// holder keeps the salt, without which guessed CCCD IDs cannot be tested
+	// against the commitment. Commitments of the same CCCD ID differ, so the
+	// cccdTag attested by the verifier is what keeps a CCCD on one address.
+	HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 HashScheme = 2
+)
+
+// Enum value maps for HashScheme.
+var (
+	HashScheme_name = map[int32]string{
+		0: "HASH_SCHEME_SHA256",
+		1: "HASH_SCHEME_HMAC_SHA256",
+		2: "HASH_SCHEME_SALTED_HMAC_SHA256",
+	}
+	HashScheme_value = map[string]int32{
+		"HASH_SCHEME_SHA256":             0,
+		"HASH_SCHEME_HMAC_SHA256":        1,
+		"HASH_SCHEME_SALTED_HMAC_SHA256": 2,
+	}
+)
+
+func (x HashScheme) Enum() *HashScheme {
+	p := new(HashScheme)
+	*p = x
+	return p
+}
+
+func (x HashScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HashScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_nexelra_identity_identity_proto_enumTypes[0].Descriptor()
+}
+
+func (HashScheme) Type() protoreflect.EnumType {
+	return &file_nexelra_identity_identity_proto_enumTypes[0]
+}
+
+func (x HashScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HashScheme.Descriptor instead.
+func (HashScheme) EnumDescriptor() ([]byte, []int) {
+	return file_nexelra_identity_identity_proto_rawDescGZIP(), []int{0}
+}
+
+// IdentityStatus is the lifecycle state of an identity. Only ACTIVE identities
+// pass the ante handler's identity gate.
+type IdentityStatus int32
+
+const (
+	IdentityStatus_IDENTITY_STATUS_ACTIVE IdentityStatus = 0
+	// IDENTITY_STATUS_SUSPENDED is a temporary hold lifted by MsgReinstateIdentity.
+	IdentityStatus_IDENTITY_STATUS_SUSPENDED IdentityStatus = 1
+	// IDENTITY_STATUS_REVOKED is terminal.
+	IdentityStatus_IDENTITY_STATUS_REVOKED IdentityStatus = 2
+	// IDENTITY_STATUS_PENDING is a submitted commitment awaiting
+	// MsgAttestIdentity from a registered verifier.
+	IdentityStatus_IDENTITY_STATUS_PENDING IdentityStatus = 3
+)
+
+// Enum value maps for IdentityStatus.
+var (
+	IdentityStatus_name = map[int32]string{
+		0: "IDENTITY_STATUS_ACTIVE",
+		1: "IDENTITY_STATUS_SUSPENDED",
+		2: "IDENTITY_STATUS_REVOKED",
+		3: "IDENTITY_STATUS_PENDING",
+	}
+	IdentityStatus_value = map[string]int32{
+		"IDENTITY_STATUS_ACTIVE":    0,
+		"IDENTITY_STATUS_SUSPENDED": 1,
+		"IDENTITY_STATUS_REVOKED":   2,
+		"IDENTITY_STATUS_PENDING":   3,
+	}
+)
+
+func (x IdentityStatus) Enum() *IdentityStatus {
+	p := new(IdentityStatus)
+	*p = x
+	return p
+}
+
+func (x IdentityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nexelra_identity_identity_proto_enumTypes[1].Descriptor()
+}
+
+func (IdentityStatus) Type() protoreflect.EnumType {
+	return &file_nexelra_identity_identity_proto_enumTypes[1]
+}
+
+func (x IdentityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentityStatus.Descriptor instead.
+func (IdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nexelra_identity_identity_proto_rawDescGZIP(), []int{1}
+}
+
+// IdentityLevel is how thoroughly the holder of an identity was checked. The
+// gating policy may require a minimum level per message type and limit the
+// transfers of each level.
+type IdentityLevel int32
+
+const (
+	// IDENTITY_LEVEL_SELF_DECLARED is a commitment no verifier checked, made
+	// by the holder before attestation was required.
+	IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED IdentityLevel = 0
+	// IDENTITY_LEVEL_VERIFIED is an identity a verifier attested against the
+	// holder's CCCD.
+	IdentityLevel_IDENTITY_LEVEL_VERIFIED IdentityLevel = 1
+	// IDENTITY_LEVEL_ENHANCED is a verified identity that also passed the
+	// verifier's enhanced due diligence, such as reading the CCCD chip in
+	// person.
+	IdentityLevel_IDENTITY_LEVEL_ENHANCED IdentityLevel = 2
+)
+
+// Enum value maps for IdentityLevel.
+var (
+	IdentityLevel_name = map[int32]string{
+		0: "IDENTITY_LEVEL_SELF_DECLARED",
+		1: "IDENTITY_LEVEL_VERIFIED",
+		2: "IDENTITY_LEVEL_ENHANCED",
+	}
+	IdentityLevel_value = map[string]int32{
+		"IDENTITY_LEVEL_SELF_DECLARED": 0,
+		"IDENTITY_LEVEL_VERIFIED":      1,
+		"IDENTITY_LEVEL_ENHANCED":      2,
+	}
+)
+
+func (x IdentityLevel) Enum() *IdentityLevel {
+	p := new(IdentityLevel)
+	*p = x
+	return p
+}
+
+func (x IdentityLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdentityLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_nexelra_identity_identity_proto_enumTypes[2].Descriptor()
+}
+
+func (IdentityLevel) Type() protoreflect.EnumType {
+	return &file_nexelra_identity_identity_proto_enumTypes[2]
+}
+
+func (x IdentityLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdentityLevel.Descriptor instead.
+func (IdentityLevel) EnumDescriptor() ([]byte, []int) {
+	return file_nexelra_identity_identity_proto_rawDescGZIP(), []int{2}
+}
+
 type Identity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	IdHash    string `protobuf:"bytes,2,opt,name=idHash,proto3" json:"idHash,omitempty"`
-	CreatedAt int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IdHash  string `protobuf:"bytes,2,opt,name=idHash,proto3" json:"idHash,omitempty"`
+	// createdAt is the block time (unix seconds) of the registration block.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// createdHeight is the height of the registration block.
+	CreatedHeight int64 `protobuf:"varint,4,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	// hashScheme is the scheme idHash was derived with.
+	HashScheme HashScheme     `protobuf:"varint,5,opt,name=hashScheme,proto3,enum=nexelra.identity.HashScheme" json:"hashScheme,omitempty"`
+	Status     IdentityStatus `protobuf:"varint,6,opt,name=status,proto3,enum=nexelra.identity.IdentityStatus" json:"status,omitempty"`
+	// updatedAt is the block time (unix seconds) of the last change to the
+	// identity after its registration, zero if it never changed.
+	UpdatedAt int64 `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// updatedHeight is the height of the last change to the identity.
+	UpdatedHeight int64 `protobuf:"varint,8,opt,name=updatedHeight,proto3" json:"updatedHeight,omitempty"`
+	// statusReason is the reason given for the last suspension or revocation.
+	StatusReason string `protobuf:"bytes,9,opt,name=statusReason,proto3" json:"statusReason,omitempty"`
+	// verifier is the address of the registered verifier that attested the
+	// current commitment, empty while pending and for identities registered
+	// before attestation was required.
+	Verifier string `protobuf:"bytes,10,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// attestedAt is the block time (unix seconds) of the attestation.
+	AttestedAt int64 `protobuf:"varint,11,opt,name=attestedAt,proto3" json:"attestedAt,omitempty"`
+	// attestedHeight is the height of the attestation block.
+	AttestedHeight int64 `protobuf:"varint,12,opt,name=attestedHeight,proto3" json:"attestedHeight,omitempty"`
+	// level is set by verifiers when they attest the identity and may be
+	// changed by them later. It falls back to SELF_DECLARED when the
+	// commitment is replaced.
+	Level IdentityLevel `protobuf:"varint,13,opt,name=level,proto3,enum=nexelra.identity.IdentityLevel" json:"level,omitempty"`
+	// cccdTag is set by the verifier attesting a salted commitment: the
+	// HMAC-SHA256 of the CCCD ID keyed by a secret the verifiers share
+	// off-chain. No two identities have the same tag. It is kept while a
+	// replaced commitment waits for its attestation.
+	CccdTag string `protobuf:"bytes,14,opt,name=cccdTag,proto3" json:"cccdTag,omitempty"`
 }
 
 func (x *Identity) Reset() {
@@ -608,29 +1392,156 @@ func (x *Identity) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *Identity) GetCreatedHeight() int64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+func (x *Identity) GetHashScheme() HashScheme {
+	if x != nil {
+		return x.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_SHA256
+}
+
+func (x *Identity) GetStatus() IdentityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IdentityStatus_IDENTITY_STATUS_ACTIVE
+}
+
+func (x *Identity) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Identity) GetUpdatedHeight() int64 {
+	if x != nil {
+		return x.UpdatedHeight
+	}
+	return 0
+}
+
+func (x *Identity) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Identity) GetVerifier() string {
+	if x != nil {
+		return x.Verifier
+	}
+	return ""
+}
+
+func (x *Identity) GetAttestedAt() int64 {
+	if x != nil {
+		return x.AttestedAt
+	}
+	return 0
+}
+
+func (x *Identity) GetAttestedHeight() int64 {
+	if x != nil {
+		return x.AttestedHeight
+	}
+	return 0
+}
+
+func (x *Identity) GetLevel() IdentityLevel {
+	if x != nil {
+		return x.Level
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (x *Identity) GetCccdTag() string {
+	if x != nil {
+		return x.CccdTag
+	}
+	return ""
+}
+
 var File_nexelra_identity_identity_proto protoreflect.FileDescriptor
 
 var file_nexelra_identity_identity_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x10, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x5a, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x64, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0xa4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c,
-	0x72, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x10,
-	0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0xca, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x11, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x3a, 0x3a, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x74, 0x79, 0x22, 0x95, 0x04, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x78,
+	0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x65,
+	0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x63, 0x63, 0x64, 0x54, 0x61, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x63, 0x63, 0x64, 0x54, 0x61, 0x67, 0x2a, 0x65, 0x0a, 0x0a, 0x48,
+	0x61, 0x73, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x41, 0x53,
+	0x48, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
+	0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x41,
+	0x4c, 0x54, 0x45, 0x44, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36,
+	0x10, 0x02, 0x2a, 0x85, 0x01, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x0d, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x1c, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x53, 0x45,
+	0x4c, 0x46, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x41, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x4e, 0x48,
+	0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x42, 0xa4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x42, 0x0d, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xa2,
+	0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xca, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c,
+	0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x4e, 0x65,
+	0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4e, 0x65, 0x78,
+	0x65, 0x6c, 0x72, 0x61, 0x3a, 0x3a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -645,16 +1556,23 @@ func file_nexelra_identity_identity_proto_rawDescGZIP() []byte {
 	return file_nexelra_identity_identity_proto_rawDescData
 }
 
+var file_nexelra_identity_identity_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_nexelra_identity_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_nexelra_identity_identity_proto_goTypes = []interface{}{
-	(*Identity)(nil), // 0: nexelra.identity.Identity
+	(HashScheme)(0),     // 0: nexelra.identity.HashScheme
+	(IdentityStatus)(0), // 1: nexelra.identity.IdentityStatus
+	(IdentityLevel)(0),  // 2: nexelra.identity.IdentityLevel
+	(*Identity)(nil),    // 3: nexelra.identity.Identity
 }
 var file_nexelra_identity_identity_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: nexelra.identity.Identity.hashScheme:type_name -> nexelra.identity.HashScheme
+	1, // 1: nexelra.identity.Identity.status:type_name -> nexelra.identity.IdentityStatus
+	2, // 2: nexelra.identity.Identity.level:type_name -> nexelra.identity.IdentityLevel
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_nexelra_identity_identity_proto_init() }
@@ -681,13 +1599,14 @@ func file_nexelra_identity_identity_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nexelra_identity_identity_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      3,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nexelra_identity_identity_proto_goTypes,
 		DependencyIndexes: file_nexelra_identity_identity_proto_depIdxs,
+		EnumInfos:         file_nexelra_identity_identity_proto_enumTypes,
 		MessageInfos:      file_nexelra_identity_identity_proto_msgTypes,
 	}.Build()
 	File_nexelra_identity_identity_proto = out.File
@@ -3,7 +3,9 @@ package identity
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,13 +16,73 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]string
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Verifiers as it is not of Message kind"))
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                  protoreflect.MessageDescriptor
+	fd_Params_pepper           protoreflect.FieldDescriptor
+	fd_Params_hashScheme       protoreflect.FieldDescriptor
+	fd_Params_verifiers        protoreflect.FieldDescriptor
+	fd_Params_gatingPolicy     protoreflect.FieldDescriptor
+	fd_Params_ibcReceivePolicy protoreflect.FieldDescriptor
+	fd_Params_validatorLevel   protoreflect.FieldDescriptor
+	fd_Params_spendPolicy      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nexelra_identity_params_proto_init()
 	md_Params = File_nexelra_identity_params_proto.Messages().ByName("Params")
+	fd_Params_pepper = md_Params.Fields().ByName("pepper")
+	fd_Params_hashScheme = md_Params.Fields().ByName("hashScheme")
+	fd_Params_verifiers = md_Params.Fields().ByName("verifiers")
+	fd_Params_gatingPolicy = md_Params.Fields().ByName("gatingPolicy")
+	fd_Params_ibcReceivePolicy = md_Params.Fields().ByName("ibcReceivePolicy")
+	fd_Params_validatorLevel = md_Params.Fields().ByName("validatorLevel")
+	fd_Params_spendPolicy = md_Params.Fields().ByName("spendPolicy")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +150,48 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pepper != "" {
+		value := protoreflect.ValueOfString(x.Pepper)
+		if !f(fd_Params_pepper, value) {
+			return
+		}
+	}
+	if x.HashScheme != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.HashScheme))
+		if !f(fd_Params_hashScheme, value) {
+			return
+		}
+	}
+	if len(x.Verifiers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.Verifiers})
+		if !f(fd_Params_verifiers, value) {
+			return
+		}
+	}
+	if x.GatingPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.GatingPolicy.ProtoReflect())
+		if !f(fd_Params_gatingPolicy, value) {
+			return
+		}
+	}
+	if x.IbcReceivePolicy != nil {
+		value := protoreflect.ValueOfMessage(x.IbcReceivePolicy.ProtoReflect())
+		if !f(fd_Params_ibcReceivePolicy, value) {
+			return
+		}
+	}
+	if x.ValidatorLevel != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ValidatorLevel))
+		if !f(fd_Params_validatorLevel, value) {
+			return
+		}
+	}
+	if x.SpendPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.SpendPolicy.ProtoReflect())
+		if !f(fd_Params_spendPolicy, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +207,20 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "nexelra.identity.Params.pepper":
+		return x.Pepper != ""
+	case "nexelra.identity.Params.hashScheme":
+		return x.HashScheme != 0
+	case "nexelra.identity.Params.verifiers":
+		return len(x.Verifiers) != 0
+	case "nexelra.identity.Params.gatingPolicy":
+		return x.GatingPolicy != nil
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		return x.IbcReceivePolicy != nil
+	case "nexelra.identity.Params.validatorLevel":
+		return x.ValidatorLevel != 0
+	case "nexelra.identity.Params.spendPolicy":
+		return x.SpendPolicy != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -119,6 +237,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "nexelra.identity.Params.pepper":
+		x.Pepper = ""
+	case "nexelra.identity.Params.hashScheme":
+		x.HashScheme = 0
+	case "nexelra.identity.Params.verifiers":
+		x.Verifiers = nil
+	case "nexelra.identity.Params.gatingPolicy":
+		x.GatingPolicy = nil
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		x.IbcReceivePolicy = nil
+	case "nexelra.identity.Params.validatorLevel":
+		x.ValidatorLevel = 0
+	case "nexelra.identity.Params.spendPolicy":
+		x.SpendPolicy = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -135,6 +267,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "nexelra.identity.Params.pepper":
+		value := x.Pepper
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.Params.hashScheme":
+		value := x.HashScheme
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.Params.verifiers":
+		if len(x.Verifiers) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.Verifiers}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.Params.gatingPolicy":
+		value := x.GatingPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		value := x.IbcReceivePolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nexelra.identity.Params.validatorLevel":
+		value := x.ValidatorLevel
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.Params.spendPolicy":
+		value := x.SpendPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -155,6 +311,22 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "nexelra.identity.Params.pepper":
+		x.Pepper = value.Interface().(string)
+	case "nexelra.identity.Params.hashScheme":
+		x.HashScheme = (HashScheme)(value.Enum())
+	case "nexelra.identity.Params.verifiers":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.Verifiers = *clv.list
+	case "nexelra.identity.Params.gatingPolicy":
+		x.GatingPolicy = value.Message().Interface().(*GatingPolicy)
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		x.IbcReceivePolicy = value.Message().Interface().(*IbcReceivePolicy)
+	case "nexelra.identity.Params.validatorLevel":
+		x.ValidatorLevel = (IdentityLevel)(value.Enum())
+	case "nexelra.identity.Params.spendPolicy":
+		x.SpendPolicy = value.Message().Interface().(*SpendPolicy)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -175,6 +347,33 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nexelra.identity.Params.verifiers":
+		if x.Verifiers == nil {
+			x.Verifiers = []string{}
+		}
+		value := &_Params_3_list{list: &x.Verifiers}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.Params.gatingPolicy":
+		if x.GatingPolicy == nil {
+			x.GatingPolicy = new(GatingPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.GatingPolicy.ProtoReflect())
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		if x.IbcReceivePolicy == nil {
+			x.IbcReceivePolicy = new(IbcReceivePolicy)
+		}
+		return protoreflect.ValueOfMessage(x.IbcReceivePolicy.ProtoReflect())
+	case "nexelra.identity.Params.spendPolicy":
+		if x.SpendPolicy == nil {
+			x.SpendPolicy = new(SpendPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.SpendPolicy.ProtoReflect())
+	case "nexelra.identity.Params.pepper":
+		panic(fmt.Errorf("field pepper of message nexelra.identity.Params is not mutable"))
+	case "nexelra.identity.Params.hashScheme":
+		panic(fmt.Errorf("field hashScheme of message nexelra.identity.Params is not mutable"))
+	case "nexelra.identity.Params.validatorLevel":
+		panic(fmt.Errorf("field validatorLevel of message nexelra.identity.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -188,6 +387,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nexelra.identity.Params.pepper":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.Params.hashScheme":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.Params.verifiers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "nexelra.identity.Params.gatingPolicy":
+		m := new(GatingPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nexelra.identity.Params.ibcReceivePolicy":
+		m := new(IbcReceivePolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nexelra.identity.Params.validatorLevel":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.Params.spendPolicy":
+		m := new(SpendPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.Params"))
@@ -257,6 +474,34 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Pepper)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HashScheme != 0 {
+			n += 1 + runtime.Sov(uint64(x.HashScheme))
+		}
+		if len(x.Verifiers) > 0 {
+			for _, s := range x.Verifiers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GatingPolicy != nil {
+			l = options.Size(x.GatingPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IbcReceivePolicy != nil {
+			l = options.Size(x.IbcReceivePolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorLevel != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorLevel))
+		}
+		if x.SpendPolicy != nil {
+			l = options.Size(x.SpendPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +531,74 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SpendPolicy != nil {
+			encoded, err := options.Marshal(x.SpendPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ValidatorLevel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorLevel))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.IbcReceivePolicy != nil {
+			encoded, err := options.Marshal(x.IbcReceivePolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.GatingPolicy != nil {
+			encoded, err := options.Marshal(x.GatingPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Verifiers) > 0 {
+			for iNdEx := len(x.Verifiers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Verifiers[iNdEx])
+				copy(dAtA[i:], x.Verifiers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Verifiers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.HashScheme != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HashScheme))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Pepper) > 0 {
+			i -= len(x.Pepper)
+			copy(dAtA[i:], x.Pepper)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pepper)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +648,216 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pepper", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pepper = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+				}
+				x.HashScheme = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HashScheme |= HashScheme(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Verifiers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Verifiers = append(x.Verifiers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GatingPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GatingPolicy == nil {
+					x.GatingPolicy = &GatingPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GatingPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcReceivePolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IbcReceivePolicy == nil {
+					x.IbcReceivePolicy = &IbcReceivePolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcReceivePolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorLevel", wireType)
+				}
+				x.ValidatorLevel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorLevel |= IdentityLevel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpendPolicy == nil {
+					x.SpendPolicy = &SpendPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -370,103 +893,5735 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nexelra/identity/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgGatingRule            protoreflect.MessageDescriptor
+	fd_MsgGatingRule_msgTypeUrl protoreflect.FieldDescriptor
+	fd_MsgGatingRule_rule       protoreflect.FieldDescriptor
 )
 
-// Params defines the parameters for the module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_MsgGatingRule = File_nexelra_identity_params_proto.Messages().ByName("MsgGatingRule")
+	fd_MsgGatingRule_msgTypeUrl = md_MsgGatingRule.Fields().ByName("msgTypeUrl")
+	fd_MsgGatingRule_rule = md_MsgGatingRule.Fields().ByName("rule")
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nexelra_identity_params_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_MsgGatingRule)(nil)
+
+type fastReflection_MsgGatingRule MsgGatingRule
+
+func (x *MsgGatingRule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGatingRule)(x)
+}
+
+func (x *MsgGatingRule) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_MsgGatingRule_messageType fastReflection_MsgGatingRule_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGatingRule_messageType{}
+
+type fastReflection_MsgGatingRule_messageType struct{}
+
+func (x fastReflection_MsgGatingRule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGatingRule)(nil)
+}
+func (x fastReflection_MsgGatingRule_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGatingRule)
+}
+func (x fastReflection_MsgGatingRule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGatingRule
 }
 
-func (*Params) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgGatingRule) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGatingRule
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgGatingRule) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGatingRule_messageType
 }
 
-var File_nexelra_identity_params_proto protoreflect.FileDescriptor
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgGatingRule) New() protoreflect.Message {
+	return new(fastReflection_MsgGatingRule)
+}
 
-var file_nexelra_identity_params_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x10, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2c, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6e,
-	0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x78, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65,
-	0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xa2, 0x02,
-	0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xca, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72,
-	0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x4e, 0x65, 0x78,
-	0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4e, 0x65, 0x78, 0x65,
-	0x6c, 0x72, 0x61, 0x3a, 0x3a, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgGatingRule) Interface() protoreflect.ProtoMessage {
+	return (*MsgGatingRule)(x)
 }
 
-var (
-	file_nexelra_identity_params_proto_rawDescOnce sync.Once
-	file_nexelra_identity_params_proto_rawDescData = file_nexelra_identity_params_proto_rawDesc
-)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgGatingRule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgGatingRule_msgTypeUrl, value) {
+			return
+		}
+	}
+	if x.Rule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Rule))
+		if !f(fd_MsgGatingRule_rule, value) {
+			return
+		}
+	}
+}
 
-func file_nexelra_identity_params_proto_rawDescGZIP() []byte {
-	file_nexelra_identity_params_proto_rawDescOnce.Do(func() {
-		file_nexelra_identity_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_nexelra_identity_params_proto_rawDescData)
-	})
-	return file_nexelra_identity_params_proto_rawDescData
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgGatingRule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		return x.MsgTypeUrl != ""
+	case "nexelra.identity.MsgGatingRule.rule":
+		return x.Rule != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_nexelra_identity_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_nexelra_identity_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: nexelra.identity.Params
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGatingRule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		x.MsgTypeUrl = ""
+	case "nexelra.identity.MsgGatingRule.rule":
+		x.Rule = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", fd.FullName()))
+	}
 }
-var file_nexelra_identity_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgGatingRule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.MsgGatingRule.rule":
+		value := x.Rule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", descriptor.FullName()))
+	}
 }
 
-func init() { file_nexelra_identity_params_proto_init() }
-func file_nexelra_identity_params_proto_init() {
-	if File_nexelra_identity_params_proto != nil {
-		return
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGatingRule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "nexelra.identity.MsgGatingRule.rule":
+		x.Rule = (GatingRule)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", fd.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_nexelra_identity_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGatingRule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		panic(fmt.Errorf("field msgTypeUrl of message nexelra.identity.MsgGatingRule is not mutable"))
+	case "nexelra.identity.MsgGatingRule.rule":
+		panic(fmt.Errorf("field rule of message nexelra.identity.MsgGatingRule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgGatingRule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgGatingRule.msgTypeUrl":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.MsgGatingRule.rule":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgGatingRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgGatingRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgGatingRule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.MsgGatingRule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgGatingRule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGatingRule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgGatingRule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgGatingRule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgGatingRule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rule != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rule))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGatingRule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rule))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGatingRule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGatingRule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGatingRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+				}
+				x.Rule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rule |= GatingRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GatingPolicy_2_list)(nil)
+
+type _GatingPolicy_2_list struct {
+	list *[]*MsgGatingRule
+}
+
+func (x *_GatingPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GatingPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GatingPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgGatingRule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GatingPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgGatingRule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GatingPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(MsgGatingRule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GatingPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(MsgGatingRule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GatingPolicy_4_list)(nil)
+
+type _GatingPolicy_4_list struct {
+	list *[]string
+}
+
+func (x *_GatingPolicy_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GatingPolicy_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GatingPolicy_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GatingPolicy_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GatingPolicy_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GatingPolicy at list field ExemptAddresses as it is not of Message kind"))
+}
+
+func (x *_GatingPolicy_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GatingPolicy_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GatingPolicy_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GatingPolicy_5_list)(nil)
+
+type _GatingPolicy_5_list struct {
+	list *[]*MsgAttributeRule
+}
+
+func (x *_GatingPolicy_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GatingPolicy_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GatingPolicy_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgAttributeRule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GatingPolicy_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgAttributeRule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GatingPolicy_5_list) AppendMutable() protoreflect.Value {
+	v := new(MsgAttributeRule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GatingPolicy_5_list) NewElement() protoreflect.Value {
+	v := new(MsgAttributeRule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GatingPolicy_6_list)(nil)
+
+type _GatingPolicy_6_list struct {
+	list *[]*MsgLevelRule
+}
+
+func (x *_GatingPolicy_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GatingPolicy_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GatingPolicy_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgLevelRule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GatingPolicy_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgLevelRule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GatingPolicy_6_list) AppendMutable() protoreflect.Value {
+	v := new(MsgLevelRule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GatingPolicy_6_list) NewElement() protoreflect.Value {
+	v := new(MsgLevelRule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GatingPolicy_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GatingPolicy                      protoreflect.MessageDescriptor
+	fd_GatingPolicy_defaultRule          protoreflect.FieldDescriptor
+	fd_GatingPolicy_msgRules             protoreflect.FieldDescriptor
+	fd_GatingPolicy_exemptModuleAccounts protoreflect.FieldDescriptor
+	fd_GatingPolicy_exemptAddresses      protoreflect.FieldDescriptor
+	fd_GatingPolicy_attributeRules       protoreflect.FieldDescriptor
+	fd_GatingPolicy_levelRules           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_GatingPolicy = File_nexelra_identity_params_proto.Messages().ByName("GatingPolicy")
+	fd_GatingPolicy_defaultRule = md_GatingPolicy.Fields().ByName("defaultRule")
+	fd_GatingPolicy_msgRules = md_GatingPolicy.Fields().ByName("msgRules")
+	fd_GatingPolicy_exemptModuleAccounts = md_GatingPolicy.Fields().ByName("exemptModuleAccounts")
+	fd_GatingPolicy_exemptAddresses = md_GatingPolicy.Fields().ByName("exemptAddresses")
+	fd_GatingPolicy_attributeRules = md_GatingPolicy.Fields().ByName("attributeRules")
+	fd_GatingPolicy_levelRules = md_GatingPolicy.Fields().ByName("levelRules")
+}
+
+var _ protoreflect.Message = (*fastReflection_GatingPolicy)(nil)
+
+type fastReflection_GatingPolicy GatingPolicy
+
+func (x *GatingPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GatingPolicy)(x)
+}
+
+func (x *GatingPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GatingPolicy_messageType fastReflection_GatingPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_GatingPolicy_messageType{}
+
+type fastReflection_GatingPolicy_messageType struct{}
+
+func (x fastReflection_GatingPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GatingPolicy)(nil)
+}
+func (x fastReflection_GatingPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_GatingPolicy)
+}
+func (x fastReflection_GatingPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GatingPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GatingPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_GatingPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GatingPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_GatingPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GatingPolicy) New() protoreflect.Message {
+	return new(fastReflection_GatingPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GatingPolicy) Interface() protoreflect.ProtoMessage {
+	return (*GatingPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GatingPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DefaultRule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DefaultRule))
+		if !f(fd_GatingPolicy_defaultRule, value) {
+			return
+		}
+	}
+	if len(x.MsgRules) != 0 {
+		value := protoreflect.ValueOfList(&_GatingPolicy_2_list{list: &x.MsgRules})
+		if !f(fd_GatingPolicy_msgRules, value) {
+			return
+		}
+	}
+	if x.ExemptModuleAccounts != false {
+		value := protoreflect.ValueOfBool(x.ExemptModuleAccounts)
+		if !f(fd_GatingPolicy_exemptModuleAccounts, value) {
+			return
+		}
+	}
+	if len(x.ExemptAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GatingPolicy_4_list{list: &x.ExemptAddresses})
+		if !f(fd_GatingPolicy_exemptAddresses, value) {
+			return
+		}
+	}
+	if len(x.AttributeRules) != 0 {
+		value := protoreflect.ValueOfList(&_GatingPolicy_5_list{list: &x.AttributeRules})
+		if !f(fd_GatingPolicy_attributeRules, value) {
+			return
+		}
+	}
+	if len(x.LevelRules) != 0 {
+		value := protoreflect.ValueOfList(&_GatingPolicy_6_list{list: &x.LevelRules})
+		if !f(fd_GatingPolicy_levelRules, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GatingPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		return x.DefaultRule != 0
+	case "nexelra.identity.GatingPolicy.msgRules":
+		return len(x.MsgRules) != 0
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		return x.ExemptModuleAccounts != false
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		return len(x.ExemptAddresses) != 0
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		return len(x.AttributeRules) != 0
+	case "nexelra.identity.GatingPolicy.levelRules":
+		return len(x.LevelRules) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GatingPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		x.DefaultRule = 0
+	case "nexelra.identity.GatingPolicy.msgRules":
+		x.MsgRules = nil
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		x.ExemptModuleAccounts = false
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		x.ExemptAddresses = nil
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		x.AttributeRules = nil
+	case "nexelra.identity.GatingPolicy.levelRules":
+		x.LevelRules = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GatingPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		value := x.DefaultRule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.GatingPolicy.msgRules":
+		if len(x.MsgRules) == 0 {
+			return protoreflect.ValueOfList(&_GatingPolicy_2_list{})
+		}
+		listValue := &_GatingPolicy_2_list{list: &x.MsgRules}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		value := x.ExemptModuleAccounts
+		return protoreflect.ValueOfBool(value)
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		if len(x.ExemptAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GatingPolicy_4_list{})
+		}
+		listValue := &_GatingPolicy_4_list{list: &x.ExemptAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		if len(x.AttributeRules) == 0 {
+			return protoreflect.ValueOfList(&_GatingPolicy_5_list{})
+		}
+		listValue := &_GatingPolicy_5_list{list: &x.AttributeRules}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.GatingPolicy.levelRules":
+		if len(x.LevelRules) == 0 {
+			return protoreflect.ValueOfList(&_GatingPolicy_6_list{})
+		}
+		listValue := &_GatingPolicy_6_list{list: &x.LevelRules}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GatingPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		x.DefaultRule = (GatingRule)(value.Enum())
+	case "nexelra.identity.GatingPolicy.msgRules":
+		lv := value.List()
+		clv := lv.(*_GatingPolicy_2_list)
+		x.MsgRules = *clv.list
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		x.ExemptModuleAccounts = value.Bool()
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		lv := value.List()
+		clv := lv.(*_GatingPolicy_4_list)
+		x.ExemptAddresses = *clv.list
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		lv := value.List()
+		clv := lv.(*_GatingPolicy_5_list)
+		x.AttributeRules = *clv.list
+	case "nexelra.identity.GatingPolicy.levelRules":
+		lv := value.List()
+		clv := lv.(*_GatingPolicy_6_list)
+		x.LevelRules = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GatingPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.GatingPolicy.msgRules":
+		if x.MsgRules == nil {
+			x.MsgRules = []*MsgGatingRule{}
+		}
+		value := &_GatingPolicy_2_list{list: &x.MsgRules}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		if x.ExemptAddresses == nil {
+			x.ExemptAddresses = []string{}
+		}
+		value := &_GatingPolicy_4_list{list: &x.ExemptAddresses}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		if x.AttributeRules == nil {
+			x.AttributeRules = []*MsgAttributeRule{}
+		}
+		value := &_GatingPolicy_5_list{list: &x.AttributeRules}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.GatingPolicy.levelRules":
+		if x.LevelRules == nil {
+			x.LevelRules = []*MsgLevelRule{}
+		}
+		value := &_GatingPolicy_6_list{list: &x.LevelRules}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		panic(fmt.Errorf("field defaultRule of message nexelra.identity.GatingPolicy is not mutable"))
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		panic(fmt.Errorf("field exemptModuleAccounts of message nexelra.identity.GatingPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GatingPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.GatingPolicy.defaultRule":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.GatingPolicy.msgRules":
+		list := []*MsgGatingRule{}
+		return protoreflect.ValueOfList(&_GatingPolicy_2_list{list: &list})
+	case "nexelra.identity.GatingPolicy.exemptModuleAccounts":
+		return protoreflect.ValueOfBool(false)
+	case "nexelra.identity.GatingPolicy.exemptAddresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GatingPolicy_4_list{list: &list})
+	case "nexelra.identity.GatingPolicy.attributeRules":
+		list := []*MsgAttributeRule{}
+		return protoreflect.ValueOfList(&_GatingPolicy_5_list{list: &list})
+	case "nexelra.identity.GatingPolicy.levelRules":
+		list := []*MsgLevelRule{}
+		return protoreflect.ValueOfList(&_GatingPolicy_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.GatingPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.GatingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GatingPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.GatingPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GatingPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GatingPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GatingPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GatingPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GatingPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DefaultRule != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultRule))
+		}
+		if len(x.MsgRules) > 0 {
+			for _, e := range x.MsgRules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExemptModuleAccounts {
+			n += 2
+		}
+		if len(x.ExemptAddresses) > 0 {
+			for _, s := range x.ExemptAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AttributeRules) > 0 {
+			for _, e := range x.AttributeRules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LevelRules) > 0 {
+			for _, e := range x.LevelRules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GatingPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LevelRules) > 0 {
+			for iNdEx := len(x.LevelRules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LevelRules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.AttributeRules) > 0 {
+			for iNdEx := len(x.AttributeRules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AttributeRules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ExemptAddresses) > 0 {
+			for iNdEx := len(x.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExemptAddresses[iNdEx])
+				copy(dAtA[i:], x.ExemptAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.ExemptModuleAccounts {
+			i--
+			if x.ExemptModuleAccounts {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MsgRules) > 0 {
+			for iNdEx := len(x.MsgRules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgRules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.DefaultRule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultRule))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GatingPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GatingPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GatingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultRule", wireType)
+				}
+				x.DefaultRule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultRule |= GatingRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgRules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgRules = append(x.MsgRules, &MsgGatingRule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgRules[len(x.MsgRules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptModuleAccounts", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ExemptModuleAccounts = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExemptAddresses = append(x.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttributeRules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AttributeRules = append(x.AttributeRules, &MsgAttributeRule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AttributeRules[len(x.AttributeRules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LevelRules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LevelRules = append(x.LevelRules, &MsgLevelRule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LevelRules[len(x.LevelRules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgLevelRule            protoreflect.MessageDescriptor
+	fd_MsgLevelRule_msgTypeUrl protoreflect.FieldDescriptor
+	fd_MsgLevelRule_minLevel   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_MsgLevelRule = File_nexelra_identity_params_proto.Messages().ByName("MsgLevelRule")
+	fd_MsgLevelRule_msgTypeUrl = md_MsgLevelRule.Fields().ByName("msgTypeUrl")
+	fd_MsgLevelRule_minLevel = md_MsgLevelRule.Fields().ByName("minLevel")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgLevelRule)(nil)
+
+type fastReflection_MsgLevelRule MsgLevelRule
+
+func (x *MsgLevelRule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLevelRule)(x)
+}
+
+func (x *MsgLevelRule) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgLevelRule_messageType fastReflection_MsgLevelRule_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLevelRule_messageType{}
+
+type fastReflection_MsgLevelRule_messageType struct{}
+
+func (x fastReflection_MsgLevelRule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLevelRule)(nil)
+}
+func (x fastReflection_MsgLevelRule_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLevelRule)
+}
+func (x fastReflection_MsgLevelRule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLevelRule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgLevelRule) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLevelRule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgLevelRule) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLevelRule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgLevelRule) New() protoreflect.Message {
+	return new(fastReflection_MsgLevelRule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgLevelRule) Interface() protoreflect.ProtoMessage {
+	return (*MsgLevelRule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgLevelRule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgLevelRule_msgTypeUrl, value) {
+			return
+		}
+	}
+	if x.MinLevel != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.MinLevel))
+		if !f(fd_MsgLevelRule_minLevel, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgLevelRule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		return x.MsgTypeUrl != ""
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		return x.MinLevel != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLevelRule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		x.MsgTypeUrl = ""
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		x.MinLevel = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgLevelRule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		value := x.MinLevel
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLevelRule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		x.MinLevel = (IdentityLevel)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLevelRule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		panic(fmt.Errorf("field msgTypeUrl of message nexelra.identity.MsgLevelRule is not mutable"))
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		panic(fmt.Errorf("field minLevel of message nexelra.identity.MsgLevelRule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgLevelRule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgLevelRule.msgTypeUrl":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.MsgLevelRule.minLevel":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgLevelRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgLevelRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgLevelRule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.MsgLevelRule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgLevelRule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgLevelRule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgLevelRule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgLevelRule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgLevelRule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinLevel != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinLevel))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLevelRule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinLevel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinLevel))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgLevelRule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLevelRule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLevelRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLevel", wireType)
+				}
+				x.MinLevel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinLevel |= IdentityLevel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SpendPolicy_2_list)(nil)
+
+type _SpendPolicy_2_list struct {
+	list *[]*LevelSpendLimit
+}
+
+func (x *_SpendPolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SpendPolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SpendPolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LevelSpendLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SpendPolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LevelSpendLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SpendPolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(LevelSpendLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendPolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SpendPolicy_2_list) NewElement() protoreflect.Value {
+	v := new(LevelSpendLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SpendPolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SpendPolicy        protoreflect.MessageDescriptor
+	fd_SpendPolicy_window protoreflect.FieldDescriptor
+	fd_SpendPolicy_limits protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_SpendPolicy = File_nexelra_identity_params_proto.Messages().ByName("SpendPolicy")
+	fd_SpendPolicy_window = md_SpendPolicy.Fields().ByName("window")
+	fd_SpendPolicy_limits = md_SpendPolicy.Fields().ByName("limits")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpendPolicy)(nil)
+
+type fastReflection_SpendPolicy SpendPolicy
+
+func (x *SpendPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpendPolicy)(x)
+}
+
+func (x *SpendPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpendPolicy_messageType fastReflection_SpendPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_SpendPolicy_messageType{}
+
+type fastReflection_SpendPolicy_messageType struct{}
+
+func (x fastReflection_SpendPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpendPolicy)(nil)
+}
+func (x fastReflection_SpendPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpendPolicy)
+}
+func (x fastReflection_SpendPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpendPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpendPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpendPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_SpendPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpendPolicy) New() protoreflect.Message {
+	return new(fastReflection_SpendPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpendPolicy) Interface() protoreflect.ProtoMessage {
+	return (*SpendPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpendPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Window)
+		if !f(fd_SpendPolicy_window, value) {
+			return
+		}
+	}
+	if len(x.Limits) != 0 {
+		value := protoreflect.ValueOfList(&_SpendPolicy_2_list{list: &x.Limits})
+		if !f(fd_SpendPolicy_limits, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpendPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.SpendPolicy.window":
+		return x.Window != int64(0)
+	case "nexelra.identity.SpendPolicy.limits":
+		return len(x.Limits) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.SpendPolicy.window":
+		x.Window = int64(0)
+	case "nexelra.identity.SpendPolicy.limits":
+		x.Limits = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpendPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.SpendPolicy.window":
+		value := x.Window
+		return protoreflect.ValueOfInt64(value)
+	case "nexelra.identity.SpendPolicy.limits":
+		if len(x.Limits) == 0 {
+			return protoreflect.ValueOfList(&_SpendPolicy_2_list{})
+		}
+		listValue := &_SpendPolicy_2_list{list: &x.Limits}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.SpendPolicy.window":
+		x.Window = value.Int()
+	case "nexelra.identity.SpendPolicy.limits":
+		lv := value.List()
+		clv := lv.(*_SpendPolicy_2_list)
+		x.Limits = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.SpendPolicy.limits":
+		if x.Limits == nil {
+			x.Limits = []*LevelSpendLimit{}
+		}
+		value := &_SpendPolicy_2_list{list: &x.Limits}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.SpendPolicy.window":
+		panic(fmt.Errorf("field window of message nexelra.identity.SpendPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpendPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.SpendPolicy.window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "nexelra.identity.SpendPolicy.limits":
+		list := []*LevelSpendLimit{}
+		return protoreflect.ValueOfList(&_SpendPolicy_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.SpendPolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.SpendPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpendPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.SpendPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpendPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpendPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpendPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpendPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpendPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if len(x.Limits) > 0 {
+			for _, e := range x.Limits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpendPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Limits) > 0 {
+			for iNdEx := len(x.Limits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Limits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpendPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Limits = append(x.Limits, &LevelSpendLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Limits[len(x.Limits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LevelSpendLimit_2_list)(nil)
+
+type _LevelSpendLimit_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LevelSpendLimit_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LevelSpendLimit_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LevelSpendLimit_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LevelSpendLimit_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LevelSpendLimit_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_LevelSpendLimit_4_list)(nil)
+
+type _LevelSpendLimit_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LevelSpendLimit_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LevelSpendLimit_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LevelSpendLimit_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LevelSpendLimit_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LevelSpendLimit_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LevelSpendLimit_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LevelSpendLimit             protoreflect.MessageDescriptor
+	fd_LevelSpendLimit_level       protoreflect.FieldDescriptor
+	fd_LevelSpendLimit_maxAmount   protoreflect.FieldDescriptor
+	fd_LevelSpendLimit_maxTxCount  protoreflect.FieldDescriptor
+	fd_LevelSpendLimit_maxTxAmount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_LevelSpendLimit = File_nexelra_identity_params_proto.Messages().ByName("LevelSpendLimit")
+	fd_LevelSpendLimit_level = md_LevelSpendLimit.Fields().ByName("level")
+	fd_LevelSpendLimit_maxAmount = md_LevelSpendLimit.Fields().ByName("maxAmount")
+	fd_LevelSpendLimit_maxTxCount = md_LevelSpendLimit.Fields().ByName("maxTxCount")
+	fd_LevelSpendLimit_maxTxAmount = md_LevelSpendLimit.Fields().ByName("maxTxAmount")
+}
+
+var _ protoreflect.Message = (*fastReflection_LevelSpendLimit)(nil)
+
+type fastReflection_LevelSpendLimit LevelSpendLimit
+
+func (x *LevelSpendLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LevelSpendLimit)(x)
+}
+
+func (x *LevelSpendLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LevelSpendLimit_messageType fastReflection_LevelSpendLimit_messageType
+var _ protoreflect.MessageType = fastReflection_LevelSpendLimit_messageType{}
+
+type fastReflection_LevelSpendLimit_messageType struct{}
+
+func (x fastReflection_LevelSpendLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LevelSpendLimit)(nil)
+}
+func (x fastReflection_LevelSpendLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_LevelSpendLimit)
+}
+func (x fastReflection_LevelSpendLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LevelSpendLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LevelSpendLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_LevelSpendLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LevelSpendLimit) Type() protoreflect.MessageType {
+	return _fastReflection_LevelSpendLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LevelSpendLimit) New() protoreflect.Message {
+	return new(fastReflection_LevelSpendLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LevelSpendLimit) Interface() protoreflect.ProtoMessage {
+	return (*LevelSpendLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LevelSpendLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Level != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Level))
+		if !f(fd_LevelSpendLimit_level, value) {
+			return
+		}
+	}
+	if len(x.MaxAmount) != 0 {
+		value := protoreflect.ValueOfList(&_LevelSpendLimit_2_list{list: &x.MaxAmount})
+		if !f(fd_LevelSpendLimit_maxAmount, value) {
+			return
+		}
+	}
+	if x.MaxTxCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxCount)
+		if !f(fd_LevelSpendLimit_maxTxCount, value) {
+			return
+		}
+	}
+	if len(x.MaxTxAmount) != 0 {
+		value := protoreflect.ValueOfList(&_LevelSpendLimit_4_list{list: &x.MaxTxAmount})
+		if !f(fd_LevelSpendLimit_maxTxAmount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LevelSpendLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.LevelSpendLimit.level":
+		return x.Level != 0
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		return len(x.MaxAmount) != 0
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		return x.MaxTxCount != uint64(0)
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		return len(x.MaxTxAmount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LevelSpendLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.LevelSpendLimit.level":
+		x.Level = 0
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		x.MaxAmount = nil
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		x.MaxTxCount = uint64(0)
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		x.MaxTxAmount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LevelSpendLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.LevelSpendLimit.level":
+		value := x.Level
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		if len(x.MaxAmount) == 0 {
+			return protoreflect.ValueOfList(&_LevelSpendLimit_2_list{})
+		}
+		listValue := &_LevelSpendLimit_2_list{list: &x.MaxAmount}
+		return protoreflect.ValueOfList(listValue)
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		value := x.MaxTxCount
+		return protoreflect.ValueOfUint64(value)
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		if len(x.MaxTxAmount) == 0 {
+			return protoreflect.ValueOfList(&_LevelSpendLimit_4_list{})
+		}
+		listValue := &_LevelSpendLimit_4_list{list: &x.MaxTxAmount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LevelSpendLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.LevelSpendLimit.level":
+		x.Level = (IdentityLevel)(value.Enum())
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		lv := value.List()
+		clv := lv.(*_LevelSpendLimit_2_list)
+		x.MaxAmount = *clv.list
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		x.MaxTxCount = value.Uint()
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		lv := value.List()
+		clv := lv.(*_LevelSpendLimit_4_list)
+		x.MaxTxAmount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LevelSpendLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		if x.MaxAmount == nil {
+			x.MaxAmount = []*v1beta1.Coin{}
+		}
+		value := &_LevelSpendLimit_2_list{list: &x.MaxAmount}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		if x.MaxTxAmount == nil {
+			x.MaxTxAmount = []*v1beta1.Coin{}
+		}
+		value := &_LevelSpendLimit_4_list{list: &x.MaxTxAmount}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.LevelSpendLimit.level":
+		panic(fmt.Errorf("field level of message nexelra.identity.LevelSpendLimit is not mutable"))
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		panic(fmt.Errorf("field maxTxCount of message nexelra.identity.LevelSpendLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LevelSpendLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.LevelSpendLimit.level":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.LevelSpendLimit.maxAmount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LevelSpendLimit_2_list{list: &list})
+	case "nexelra.identity.LevelSpendLimit.maxTxCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nexelra.identity.LevelSpendLimit.maxTxAmount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LevelSpendLimit_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.LevelSpendLimit"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.LevelSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LevelSpendLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.LevelSpendLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LevelSpendLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LevelSpendLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LevelSpendLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LevelSpendLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LevelSpendLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Level != 0 {
+			n += 1 + runtime.Sov(uint64(x.Level))
+		}
+		if len(x.MaxAmount) > 0 {
+			for _, e := range x.MaxAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxTxCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxCount))
+		}
+		if len(x.MaxTxAmount) > 0 {
+			for _, e := range x.MaxTxAmount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LevelSpendLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxTxAmount) > 0 {
+			for iNdEx := len(x.MaxTxAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxTxAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaxTxCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MaxAmount) > 0 {
+			for iNdEx := len(x.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxAmount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Level != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Level))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LevelSpendLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LevelSpendLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LevelSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				}
+				x.Level = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Level |= IdentityLevel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxAmount = append(x.MaxAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxAmount[len(x.MaxAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxCount", wireType)
+				}
+				x.MaxTxCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTxCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTxAmount = append(x.MaxTxAmount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxTxAmount[len(x.MaxTxAmount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgAttributeRule_2_list)(nil)
+
+type _MsgAttributeRule_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgAttributeRule_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAttributeRule_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAttributeRule_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAttributeRule_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAttributeRule_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAttributeRule at list field Attributes as it is not of Message kind"))
+}
+
+func (x *_MsgAttributeRule_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAttributeRule_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAttributeRule_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgAttributeRule            protoreflect.MessageDescriptor
+	fd_MsgAttributeRule_msgTypeUrl protoreflect.FieldDescriptor
+	fd_MsgAttributeRule_attributes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_MsgAttributeRule = File_nexelra_identity_params_proto.Messages().ByName("MsgAttributeRule")
+	fd_MsgAttributeRule_msgTypeUrl = md_MsgAttributeRule.Fields().ByName("msgTypeUrl")
+	fd_MsgAttributeRule_attributes = md_MsgAttributeRule.Fields().ByName("attributes")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAttributeRule)(nil)
+
+type fastReflection_MsgAttributeRule MsgAttributeRule
+
+func (x *MsgAttributeRule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAttributeRule)(x)
+}
+
+func (x *MsgAttributeRule) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAttributeRule_messageType fastReflection_MsgAttributeRule_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAttributeRule_messageType{}
+
+type fastReflection_MsgAttributeRule_messageType struct{}
+
+func (x fastReflection_MsgAttributeRule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAttributeRule)(nil)
+}
+func (x fastReflection_MsgAttributeRule_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAttributeRule)
+}
+func (x fastReflection_MsgAttributeRule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttributeRule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAttributeRule) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAttributeRule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAttributeRule) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAttributeRule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAttributeRule) New() protoreflect.Message {
+	return new(fastReflection_MsgAttributeRule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAttributeRule) Interface() protoreflect.ProtoMessage {
+	return (*MsgAttributeRule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAttributeRule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgAttributeRule_msgTypeUrl, value) {
+			return
+		}
+	}
+	if len(x.Attributes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAttributeRule_2_list{list: &x.Attributes})
+		if !f(fd_MsgAttributeRule_attributes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAttributeRule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		return x.MsgTypeUrl != ""
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		return len(x.Attributes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttributeRule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		x.MsgTypeUrl = ""
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		x.Attributes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAttributeRule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		if len(x.Attributes) == 0 {
+			return protoreflect.ValueOfList(&_MsgAttributeRule_2_list{})
+		}
+		listValue := &_MsgAttributeRule_2_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttributeRule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		lv := value.List()
+		clv := lv.(*_MsgAttributeRule_2_list)
+		x.Attributes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttributeRule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		if x.Attributes == nil {
+			x.Attributes = []string{}
+		}
+		value := &_MsgAttributeRule_2_list{list: &x.Attributes}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		panic(fmt.Errorf("field msgTypeUrl of message nexelra.identity.MsgAttributeRule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAttributeRule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.MsgAttributeRule.msgTypeUrl":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.MsgAttributeRule.attributes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAttributeRule_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.MsgAttributeRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.MsgAttributeRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAttributeRule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.MsgAttributeRule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAttributeRule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAttributeRule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAttributeRule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAttributeRule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAttributeRule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Attributes) > 0 {
+			for _, s := range x.Attributes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttributeRule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Attributes) > 0 {
+			for iNdEx := len(x.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attributes[iNdEx])
+				copy(dAtA[i:], x.Attributes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attributes[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAttributeRule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttributeRule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAttributeRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attributes = append(x.Attributes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChannelReceiveRule           protoreflect.MessageDescriptor
+	fd_ChannelReceiveRule_channelId protoreflect.FieldDescriptor
+	fd_ChannelReceiveRule_rule      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_ChannelReceiveRule = File_nexelra_identity_params_proto.Messages().ByName("ChannelReceiveRule")
+	fd_ChannelReceiveRule_channelId = md_ChannelReceiveRule.Fields().ByName("channelId")
+	fd_ChannelReceiveRule_rule = md_ChannelReceiveRule.Fields().ByName("rule")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChannelReceiveRule)(nil)
+
+type fastReflection_ChannelReceiveRule ChannelReceiveRule
+
+func (x *ChannelReceiveRule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChannelReceiveRule)(x)
+}
+
+func (x *ChannelReceiveRule) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChannelReceiveRule_messageType fastReflection_ChannelReceiveRule_messageType
+var _ protoreflect.MessageType = fastReflection_ChannelReceiveRule_messageType{}
+
+type fastReflection_ChannelReceiveRule_messageType struct{}
+
+func (x fastReflection_ChannelReceiveRule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChannelReceiveRule)(nil)
+}
+func (x fastReflection_ChannelReceiveRule_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChannelReceiveRule)
+}
+func (x fastReflection_ChannelReceiveRule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelReceiveRule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChannelReceiveRule) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChannelReceiveRule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChannelReceiveRule) Type() protoreflect.MessageType {
+	return _fastReflection_ChannelReceiveRule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChannelReceiveRule) New() protoreflect.Message {
+	return new(fastReflection_ChannelReceiveRule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChannelReceiveRule) Interface() protoreflect.ProtoMessage {
+	return (*ChannelReceiveRule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChannelReceiveRule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_ChannelReceiveRule_channelId, value) {
+			return
+		}
+	}
+	if x.Rule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Rule))
+		if !f(fd_ChannelReceiveRule_rule, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChannelReceiveRule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		return x.ChannelId != ""
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		return x.Rule != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelReceiveRule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		x.ChannelId = ""
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		x.Rule = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChannelReceiveRule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		value := x.Rule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelReceiveRule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		x.ChannelId = value.Interface().(string)
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		x.Rule = (IbcReceiveRule)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelReceiveRule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		panic(fmt.Errorf("field channelId of message nexelra.identity.ChannelReceiveRule is not mutable"))
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		panic(fmt.Errorf("field rule of message nexelra.identity.ChannelReceiveRule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChannelReceiveRule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.ChannelReceiveRule.channelId":
+		return protoreflect.ValueOfString("")
+	case "nexelra.identity.ChannelReceiveRule.rule":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.ChannelReceiveRule"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.ChannelReceiveRule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChannelReceiveRule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.ChannelReceiveRule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChannelReceiveRule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChannelReceiveRule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChannelReceiveRule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChannelReceiveRule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChannelReceiveRule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rule != 0 {
+			n += 1 + runtime.Sov(uint64(x.Rule))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelReceiveRule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Rule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Rule))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChannelReceiveRule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelReceiveRule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChannelReceiveRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+				}
+				x.Rule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Rule |= IbcReceiveRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_IbcReceivePolicy_2_list)(nil)
+
+type _IbcReceivePolicy_2_list struct {
+	list *[]*ChannelReceiveRule
+}
+
+func (x *_IbcReceivePolicy_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_IbcReceivePolicy_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_IbcReceivePolicy_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelReceiveRule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_IbcReceivePolicy_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ChannelReceiveRule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_IbcReceivePolicy_2_list) AppendMutable() protoreflect.Value {
+	v := new(ChannelReceiveRule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_IbcReceivePolicy_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_IbcReceivePolicy_2_list) NewElement() protoreflect.Value {
+	v := new(ChannelReceiveRule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_IbcReceivePolicy_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_IbcReceivePolicy              protoreflect.MessageDescriptor
+	fd_IbcReceivePolicy_defaultRule  protoreflect.FieldDescriptor
+	fd_IbcReceivePolicy_channelRules protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nexelra_identity_params_proto_init()
+	md_IbcReceivePolicy = File_nexelra_identity_params_proto.Messages().ByName("IbcReceivePolicy")
+	fd_IbcReceivePolicy_defaultRule = md_IbcReceivePolicy.Fields().ByName("defaultRule")
+	fd_IbcReceivePolicy_channelRules = md_IbcReceivePolicy.Fields().ByName("channelRules")
+}
+
+var _ protoreflect.Message = (*fastReflection_IbcReceivePolicy)(nil)
+
+type fastReflection_IbcReceivePolicy IbcReceivePolicy
+
+func (x *IbcReceivePolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IbcReceivePolicy)(x)
+}
+
+func (x *IbcReceivePolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_nexelra_identity_params_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IbcReceivePolicy_messageType fastReflection_IbcReceivePolicy_messageType
+var _ protoreflect.MessageType = fastReflection_IbcReceivePolicy_messageType{}
+
+type fastReflection_IbcReceivePolicy_messageType struct{}
+
+func (x fastReflection_IbcReceivePolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IbcReceivePolicy)(nil)
+}
+func (x fastReflection_IbcReceivePolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_IbcReceivePolicy)
+}
+func (x fastReflection_IbcReceivePolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcReceivePolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IbcReceivePolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_IbcReceivePolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IbcReceivePolicy) Type() protoreflect.MessageType {
+	return _fastReflection_IbcReceivePolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IbcReceivePolicy) New() protoreflect.Message {
+	return new(fastReflection_IbcReceivePolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IbcReceivePolicy) Interface() protoreflect.ProtoMessage {
+	return (*IbcReceivePolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IbcReceivePolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DefaultRule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DefaultRule))
+		if !f(fd_IbcReceivePolicy_defaultRule, value) {
+			return
+		}
+	}
+	if len(x.ChannelRules) != 0 {
+		value := protoreflect.ValueOfList(&_IbcReceivePolicy_2_list{list: &x.ChannelRules})
+		if !f(fd_IbcReceivePolicy_channelRules, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IbcReceivePolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		return x.DefaultRule != 0
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		return len(x.ChannelRules) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcReceivePolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		x.DefaultRule = 0
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		x.ChannelRules = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IbcReceivePolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		value := x.DefaultRule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		if len(x.ChannelRules) == 0 {
+			return protoreflect.ValueOfList(&_IbcReceivePolicy_2_list{})
+		}
+		listValue := &_IbcReceivePolicy_2_list{list: &x.ChannelRules}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcReceivePolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		x.DefaultRule = (IbcReceiveRule)(value.Enum())
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		lv := value.List()
+		clv := lv.(*_IbcReceivePolicy_2_list)
+		x.ChannelRules = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcReceivePolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		if x.ChannelRules == nil {
+			x.ChannelRules = []*ChannelReceiveRule{}
+		}
+		value := &_IbcReceivePolicy_2_list{list: &x.ChannelRules}
+		return protoreflect.ValueOfList(value)
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		panic(fmt.Errorf("field defaultRule of message nexelra.identity.IbcReceivePolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IbcReceivePolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nexelra.identity.IbcReceivePolicy.defaultRule":
+		return protoreflect.ValueOfEnum(0)
+	case "nexelra.identity.IbcReceivePolicy.channelRules":
+		list := []*ChannelReceiveRule{}
+		return protoreflect.ValueOfList(&_IbcReceivePolicy_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nexelra.identity.IbcReceivePolicy"))
+		}
+		panic(fmt.Errorf("message nexelra.identity.IbcReceivePolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IbcReceivePolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nexelra.identity.IbcReceivePolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IbcReceivePolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IbcReceivePolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IbcReceivePolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IbcReceivePolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IbcReceivePolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DefaultRule != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultRule))
+		}
+		if len(x.ChannelRules) > 0 {
+			for _, e := range x.ChannelRules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IbcReceivePolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ChannelRules) > 0 {
+			for iNdEx := len(x.ChannelRules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChannelRules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.DefaultRule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultRule))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IbcReceivePolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcReceivePolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IbcReceivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultRule", wireType)
+				}
+				x.DefaultRule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultRule |= IbcReceiveRule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelRules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelRules = append(x.ChannelRules, &ChannelReceiveRule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChannelRules[len(x.ChannelRules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nexelra/identity/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GatingRule is the identity check applied to a message.
+type GatingRule int32
+
+const (
+	// GATING_RULE_SIGNER_AND_RECIPIENT requires an active identity for every
+	// signer and every recipient of the message.
+	GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT GatingRule = 0
+	// GATING_RULE_SIGNER_ONLY requires an active identity for every signer.
+	GatingRule_GATING_RULE_SIGNER_ONLY GatingRule = 1
+	// GATING_RULE_EXEMPT skips the identity check.
+	GatingRule_GATING_RULE_EXEMPT GatingRule = 2
+)
+
+// Enum value maps for GatingRule.
+var (
+	GatingRule_name = map[int32]string{
+		0: "GATING_RULE_SIGNER_AND_RECIPIENT",
+		1: "GATING_RULE_SIGNER_ONLY",
+		2: "GATING_RULE_EXEMPT",
+	}
+	GatingRule_value = map[string]int32{
+		"GATING_RULE_SIGNER_AND_RECIPIENT": 0,
+		"GATING_RULE_SIGNER_ONLY":          1,
+		"GATING_RULE_EXEMPT":               2,
+	}
+)
+
+func (x GatingRule) Enum() *GatingRule {
+	p := new(GatingRule)
+	*p = x
+	return p
+}
+
+func (x GatingRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatingRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_nexelra_identity_params_proto_enumTypes[0].Descriptor()
+}
+
+func (GatingRule) Type() protoreflect.EnumType {
+	return &file_nexelra_identity_params_proto_enumTypes[0]
+}
+
+func (x GatingRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatingRule.Descriptor instead.
+func (GatingRule) EnumDescriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{0}
+}
+
+// IbcReceiveRule is the identity check applied to incoming ICS-20 transfers.
+type IbcReceiveRule int32
+
+const (
+	// IBC_RECEIVE_RULE_REQUIRE_IDENTITY acknowledges transfers to receivers
+	// without an active identity with an error, refunding the sender.
+	IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY IbcReceiveRule = 0
+	// IBC_RECEIVE_RULE_ALLOW accepts transfers to any receiver.
+	IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW IbcReceiveRule = 1
+)
+
+// Enum value maps for IbcReceiveRule.
+var (
+	IbcReceiveRule_name = map[int32]string{
+		0: "IBC_RECEIVE_RULE_REQUIRE_IDENTITY",
+		1: "IBC_RECEIVE_RULE_ALLOW",
+	}
+	IbcReceiveRule_value = map[string]int32{
+		"IBC_RECEIVE_RULE_REQUIRE_IDENTITY": 0,
+		"IBC_RECEIVE_RULE_ALLOW":            1,
+	}
+)
+
+func (x IbcReceiveRule) Enum() *IbcReceiveRule {
+	p := new(IbcReceiveRule)
+	*p = x
+	return p
+}
+
+func (x IbcReceiveRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IbcReceiveRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_nexelra_identity_params_proto_enumTypes[1].Descriptor()
+}
+
+func (IbcReceiveRule) Type() protoreflect.EnumType {
+	return &file_nexelra_identity_params_proto_enumTypes[1]
+}
+
+func (x IbcReceiveRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IbcReceiveRule.Descriptor instead.
+func (IbcReceiveRule) EnumDescriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pepper is the chain-wide HMAC key clients use to derive CCCD commitments.
+	// It is public, the salt of salted commitments is what hides the CCCD ID.
+	// Unsalted commitments made under a previous pepper do not collide with new
+	// ones, so rotating it weakens the one-CCCD-one-address guarantee for
+	// their records.
+	Pepper string `protobuf:"bytes,1,opt,name=pepper,proto3" json:"pepper,omitempty"`
+	// hashScheme is the scheme new registrations must use.
+	HashScheme HashScheme `protobuf:"varint,2,opt,name=hashScheme,proto3,enum=nexelra.identity.HashScheme" json:"hashScheme,omitempty"`
+	// verifiers are the KYC providers allowed to attest identities. Removing a
+	// verifier does not undo attestations it already made.
+	Verifiers []string `protobuf:"bytes,3,rep,name=verifiers,proto3" json:"verifiers,omitempty"`
+	// gatingPolicy decides which transactions the ante handler's identity gate
+	// applies to.
+	GatingPolicy *GatingPolicy `protobuf:"bytes,4,opt,name=gatingPolicy,proto3" json:"gatingPolicy,omitempty"`
+	// ibcReceivePolicy decides which incoming ICS-20 transfers need a receiver
+	// with an active identity.
+	IbcReceivePolicy *IbcReceivePolicy `protobuf:"bytes,5,opt,name=ibcReceivePolicy,proto3" json:"ibcReceivePolicy,omitempty"`
+	// validatorLevel is the lowest level the active identity of a validator
+	// operator may have, checked when the validator is created or edited.
+	ValidatorLevel IdentityLevel `protobuf:"varint,6,opt,name=validatorLevel,proto3,enum=nexelra.identity.IdentityLevel" json:"validatorLevel,omitempty"`
+	// spendPolicy caps what each identity sends over a rolling window, across
+	// all the addresses it controls.
+	SpendPolicy *SpendPolicy `protobuf:"bytes,7,opt,name=spendPolicy,proto3" json:"spendPolicy,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetPepper() string {
+	if x != nil {
+		return x.Pepper
+	}
+	return ""
+}
+
+func (x *Params) GetHashScheme() HashScheme {
+	if x != nil {
+		return x.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_SHA256
+}
+
+func (x *Params) GetVerifiers() []string {
+	if x != nil {
+		return x.Verifiers
+	}
+	return nil
+}
+
+func (x *Params) GetGatingPolicy() *GatingPolicy {
+	if x != nil {
+		return x.GatingPolicy
+	}
+	return nil
+}
+
+func (x *Params) GetIbcReceivePolicy() *IbcReceivePolicy {
+	if x != nil {
+		return x.IbcReceivePolicy
+	}
+	return nil
+}
+
+func (x *Params) GetValidatorLevel() IdentityLevel {
+	if x != nil {
+		return x.ValidatorLevel
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (x *Params) GetSpendPolicy() *SpendPolicy {
+	if x != nil {
+		return x.SpendPolicy
+	}
+	return nil
+}
+
+// MsgGatingRule overrides the default rule for one message type.
+type MsgGatingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string     `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	Rule       GatingRule `protobuf:"varint,2,opt,name=rule,proto3,enum=nexelra.identity.GatingRule" json:"rule,omitempty"`
+}
+
+func (x *MsgGatingRule) Reset() {
+	*x = MsgGatingRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgGatingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgGatingRule) ProtoMessage() {}
+
+// Deprecated: Use MsgGatingRule.ProtoReflect.Descriptor instead.
+func (*MsgGatingRule) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgGatingRule) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MsgGatingRule) GetRule() GatingRule {
+	if x != nil {
+		return x.Rule
+	}
+	return GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
+}
+
+// GatingPolicy is the governance-controlled configuration of the ante
+// handler's identity gate.
+type GatingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaultRule applies to messages without an entry in msgRules.
+	DefaultRule GatingRule       `protobuf:"varint,1,opt,name=defaultRule,proto3,enum=nexelra.identity.GatingRule" json:"defaultRule,omitempty"`
+	MsgRules    []*MsgGatingRule `protobuf:"bytes,2,rep,name=msgRules,proto3" json:"msgRules,omitempty"`
+	// exemptModuleAccounts skips the identity check for the app's module
+	// accounts, as listed by BlockedAddresses().
+	ExemptModuleAccounts bool `protobuf:"varint,3,opt,name=exemptModuleAccounts,proto3" json:"exemptModuleAccounts,omitempty"`
+	// exemptAddresses are further addresses that never need an identity.
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exemptAddresses,proto3" json:"exemptAddresses,omitempty"`
+	// attributeRules require the signers of some messages to hold attribute
+	// flags on top of an active identity.
+	AttributeRules []*MsgAttributeRule `protobuf:"bytes,5,rep,name=attributeRules,proto3" json:"attributeRules,omitempty"`
+	// levelRules require the signers of some messages to hold an identity of
+	// a minimum level.
+	LevelRules []*MsgLevelRule `protobuf:"bytes,6,rep,name=levelRules,proto3" json:"levelRules,omitempty"`
+}
+
+func (x *GatingPolicy) Reset() {
+	*x = GatingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatingPolicy) ProtoMessage() {}
+
+// Deprecated: Use GatingPolicy.ProtoReflect.Descriptor instead.
+func (*GatingPolicy) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatingPolicy) GetDefaultRule() GatingRule {
+	if x != nil {
+		return x.DefaultRule
+	}
+	return GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
+}
+
+func (x *GatingPolicy) GetMsgRules() []*MsgGatingRule {
+	if x != nil {
+		return x.MsgRules
+	}
+	return nil
+}
+
+func (x *GatingPolicy) GetExemptModuleAccounts() bool {
+	if x != nil {
+		return x.ExemptModuleAccounts
+	}
+	return false
+}
+
+func (x *GatingPolicy) GetExemptAddresses() []string {
+	if x != nil {
+		return x.ExemptAddresses
+	}
+	return nil
+}
+
+func (x *GatingPolicy) GetAttributeRules() []*MsgAttributeRule {
+	if x != nil {
+		return x.AttributeRules
+	}
+	return nil
+}
+
+func (x *GatingPolicy) GetLevelRules() []*MsgLevelRule {
+	if x != nil {
+		return x.LevelRules
+	}
+	return nil
+}
+
+// MsgLevelRule is the lowest identity level the signers of a message type
+// may have. Exempt messages and exempt signers are not checked.
+type MsgLevelRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string        `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	MinLevel   IdentityLevel `protobuf:"varint,2,opt,name=minLevel,proto3,enum=nexelra.identity.IdentityLevel" json:"minLevel,omitempty"`
+}
+
+func (x *MsgLevelRule) Reset() {
+	*x = MsgLevelRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgLevelRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgLevelRule) ProtoMessage() {}
+
+// Deprecated: Use MsgLevelRule.ProtoReflect.Descriptor instead.
+func (*MsgLevelRule) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgLevelRule) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MsgLevelRule) GetMinLevel() IdentityLevel {
+	if x != nil {
+		return x.MinLevel
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+// SpendPolicy is the governance-controlled configuration of the per-identity
+// spend limits. The ante handler adds up the bank and ICS-20 transfers each
+// identity sent over the last window seconds, keyed by its commitment, and
+// rejects transactions that would take it over the limit of its level, or
+// that send more than the per-transaction cap of its level on their own.
+type SpendPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the length (seconds) of the rolling window, one day by
+	// default. It may only be zero while there are no limits.
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// limits are the limits of each identity level. Levels without an entry
+	// are not limited.
+	Limits []*LevelSpendLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *SpendPolicy) Reset() {
+	*x = SpendPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendPolicy) ProtoMessage() {}
+
+// Deprecated: Use SpendPolicy.ProtoReflect.Descriptor instead.
+func (*SpendPolicy) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SpendPolicy) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *SpendPolicy) GetLimits() []*LevelSpendLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// LevelSpendLimit caps what the identities of a level may send within the
+// window of the spend policy and in a single transaction.
+type LevelSpendLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level IdentityLevel `protobuf:"varint,1,opt,name=level,proto3,enum=nexelra.identity.IdentityLevel" json:"level,omitempty"`
+	// maxAmount caps the funds sent, per denom. Denoms missing from it are not
+	// limited.
+	MaxAmount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=maxAmount,proto3" json:"maxAmount,omitempty"`
+	// maxTxCount caps the transactions sending funds, zero for no cap.
+	MaxTxCount uint64 `protobuf:"varint,3,opt,name=maxTxCount,proto3" json:"maxTxCount,omitempty"`
+	// maxTxAmount caps the funds one transaction sends, per denom. Denoms
+	// missing from it are not limited. Unlike the window limits it also holds
+	// senders without an identity, to the SELF_DECLARED cap.
+	MaxTxAmount []*v1beta1.Coin `protobuf:"bytes,4,rep,name=maxTxAmount,proto3" json:"maxTxAmount,omitempty"`
+}
+
+func (x *LevelSpendLimit) Reset() {
+	*x = LevelSpendLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LevelSpendLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelSpendLimit) ProtoMessage() {}
+
+// Deprecated: Use LevelSpendLimit.ProtoReflect.Descriptor instead.
+func (*LevelSpendLimit) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LevelSpendLimit) GetLevel() IdentityLevel {
+	if x != nil {
+		return x.Level
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (x *LevelSpendLimit) GetMaxAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return nil
+}
+
+func (x *LevelSpendLimit) GetMaxTxCount() uint64 {
+	if x != nil {
+		return x.MaxTxCount
+	}
+	return 0
+}
+
+func (x *LevelSpendLimit) GetMaxTxAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.MaxTxAmount
+	}
+	return nil
+}
+
+// MsgAttributeRule lists the attributes the signers of a message type must
+// have proven. Exempt messages and exempt signers are not checked.
+type MsgAttributeRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string   `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	Attributes []string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *MsgAttributeRule) Reset() {
+	*x = MsgAttributeRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAttributeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAttributeRule) ProtoMessage() {}
+
+// Deprecated: Use MsgAttributeRule.ProtoReflect.Descriptor instead.
+func (*MsgAttributeRule) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgAttributeRule) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MsgAttributeRule) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ChannelReceiveRule overrides the default rule for one channel.
+type ChannelReceiveRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channelId is the channel on this chain the packets arrive on.
+	ChannelId string         `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Rule      IbcReceiveRule `protobuf:"varint,2,opt,name=rule,proto3,enum=nexelra.identity.IbcReceiveRule" json:"rule,omitempty"`
+}
+
+func (x *ChannelReceiveRule) Reset() {
+	*x = ChannelReceiveRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelReceiveRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelReceiveRule) ProtoMessage() {}
+
+// Deprecated: Use ChannelReceiveRule.ProtoReflect.Descriptor instead.
+func (*ChannelReceiveRule) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChannelReceiveRule) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelReceiveRule) GetRule() IbcReceiveRule {
+	if x != nil {
+		return x.Rule
+	}
+	return IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY
+}
+
+// IbcReceivePolicy is the governance-controlled configuration of the ICS-20
+// identity middleware.
+type IbcReceivePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaultRule applies to channels without an entry in channelRules.
+	DefaultRule  IbcReceiveRule        `protobuf:"varint,1,opt,name=defaultRule,proto3,enum=nexelra.identity.IbcReceiveRule" json:"defaultRule,omitempty"`
+	ChannelRules []*ChannelReceiveRule `protobuf:"bytes,2,rep,name=channelRules,proto3" json:"channelRules,omitempty"`
+}
+
+func (x *IbcReceivePolicy) Reset() {
+	*x = IbcReceivePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nexelra_identity_params_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IbcReceivePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IbcReceivePolicy) ProtoMessage() {}
+
+// Deprecated: Use IbcReceivePolicy.ProtoReflect.Descriptor instead.
+func (*IbcReceivePolicy) Descriptor() ([]byte, []int) {
+	return file_nexelra_identity_params_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IbcReceivePolicy) GetDefaultRule() IbcReceiveRule {
+	if x != nil {
+		return x.DefaultRule
+	}
+	return IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY
+}
+
+func (x *IbcReceivePolicy) GetChannelRules() []*ChannelReceiveRule {
+	if x != nil {
+		return x.ChannelRules
+	}
+	return nil
+}
+
+var File_nexelra_identity_params_proto protoreflect.FileDescriptor
+
+var file_nexelra_identity_params_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x10, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x70, 0x70, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x70, 0x70, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x68, 0x61, 0x73,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x0a, 0x68, 0x61, 0x73,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x4d, 0x0a, 0x0c, 0x67, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x67, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x59,
+	0x0a, 0x10, 0x69, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c,
+	0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x62, 0x63, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x69, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72,
+	0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x22,
+	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61,
+	0x2f, 0x78, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xa7, 0x03, 0x0a, 0x0c,
+	0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x0b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x08,
+	0x6d, 0x73, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x71, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72,
+	0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x6c, 0x0a, 0x0b, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x3f, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd2, 0x02, 0x0a, 0x0f, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x65,
+	0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x6e, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x72, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x58, 0x0a, 0x10, 0x4d,
+	0x73, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x6e, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72,
+	0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x62, 0x63, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x49, 0x62, 0x63, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x49, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4e,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x04,
+	0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x67, 0x0a, 0x0a, 0x47, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x47, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c,
+	0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43,
+	0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x41, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x4d, 0x50, 0x54, 0x10, 0x02, 0x2a, 0x53, 0x0a,
+	0x0e, 0x49, 0x62, 0x63, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x25, 0x0a, 0x21, 0x49, 0x42, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x5f, 0x52,
+	0x55, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x5f, 0x49, 0x44, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x42, 0x43, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x10, 0x01, 0x42, 0xa2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x65, 0x78, 0x65, 0x6c,
+	0x72, 0x61, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x4e, 0x65, 0x78, 0x65,
+	0x6c, 0x72, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02,
+	0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0xca, 0x02, 0x10, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x5c, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4e, 0x65, 0x78, 0x65, 0x6c, 0x72, 0x61, 0x3a, 0x3a, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nexelra_identity_params_proto_rawDescOnce sync.Once
+	file_nexelra_identity_params_proto_rawDescData = file_nexelra_identity_params_proto_rawDesc
+)
+
+func file_nexelra_identity_params_proto_rawDescGZIP() []byte {
+	file_nexelra_identity_params_proto_rawDescOnce.Do(func() {
+		file_nexelra_identity_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_nexelra_identity_params_proto_rawDescData)
+	})
+	return file_nexelra_identity_params_proto_rawDescData
+}
+
+var file_nexelra_identity_params_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_nexelra_identity_params_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_nexelra_identity_params_proto_goTypes = []interface{}{
+	(GatingRule)(0),            // 0: nexelra.identity.GatingRule
+	(IbcReceiveRule)(0),        // 1: nexelra.identity.IbcReceiveRule
+	(*Params)(nil),             // 2: nexelra.identity.Params
+	(*MsgGatingRule)(nil),      // 3: nexelra.identity.MsgGatingRule
+	(*GatingPolicy)(nil),       // 4: nexelra.identity.GatingPolicy
+	(*MsgLevelRule)(nil),       // 5: nexelra.identity.MsgLevelRule
+	(*SpendPolicy)(nil),        // 6: nexelra.identity.SpendPolicy
+	(*LevelSpendLimit)(nil),    // 7: nexelra.identity.LevelSpendLimit
+	(*MsgAttributeRule)(nil),   // 8: nexelra.identity.MsgAttributeRule
+	(*ChannelReceiveRule)(nil), // 9: nexelra.identity.ChannelReceiveRule
+	(*IbcReceivePolicy)(nil),   // 10: nexelra.identity.IbcReceivePolicy
+	(HashScheme)(0),            // 11: nexelra.identity.HashScheme
+	(IdentityLevel)(0),         // 12: nexelra.identity.IdentityLevel
+	(*v1beta1.Coin)(nil),       // 13: cosmos.base.v1beta1.Coin
+}
+var file_nexelra_identity_params_proto_depIdxs = []int32{
+	11, // 0: nexelra.identity.Params.hashScheme:type_name -> nexelra.identity.HashScheme
+	4,  // 1: nexelra.identity.Params.gatingPolicy:type_name -> nexelra.identity.GatingPolicy
+	10, // 2: nexelra.identity.Params.ibcReceivePolicy:type_name -> nexelra.identity.IbcReceivePolicy
+	12, // 3: nexelra.identity.Params.validatorLevel:type_name -> nexelra.identity.IdentityLevel
+	6,  // 4: nexelra.identity.Params.spendPolicy:type_name -> nexelra.identity.SpendPolicy
+	0,  // 5: nexelra.identity.MsgGatingRule.rule:type_name -> nexelra.identity.GatingRule
+	0,  // 6: nexelra.identity.GatingPolicy.defaultRule:type_name -> nexelra.identity.GatingRule
+	3,  // 7: nexelra.identity.GatingPolicy.msgRules:type_name -> nexelra.identity.MsgGatingRule
+	8,  // 8: nexelra.identity.GatingPolicy.attributeRules:type_name -> nexelra.identity.MsgAttributeRule
+	5,  // 9: nexelra.identity.GatingPolicy.levelRules:type_name -> nexelra.identity.MsgLevelRule
+	12, // 10: nexelra.identity.MsgLevelRule.minLevel:type_name -> nexelra.identity.IdentityLevel
+	7,  // 11: nexelra.identity.SpendPolicy.limits:type_name -> nexelra.identity.LevelSpendLimit
+	12, // 12: nexelra.identity.LevelSpendLimit.level:type_name -> nexelra.identity.IdentityLevel
+	13, // 13: nexelra.identity.LevelSpendLimit.maxAmount:type_name -> cosmos.base.v1beta1.Coin
+	13, // 14: nexelra.identity.LevelSpendLimit.maxTxAmount:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 15: nexelra.identity.ChannelReceiveRule.rule:type_name -> nexelra.identity.IbcReceiveRule
+	1,  // 16: nexelra.identity.IbcReceivePolicy.defaultRule:type_name -> nexelra.identity.IbcReceiveRule
+	9,  // 17: nexelra.identity.IbcReceivePolicy.channelRules:type_name -> nexelra.identity.ChannelReceiveRule
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_nexelra_identity_params_proto_init() }
+func file_nexelra_identity_params_proto_init() {
+	if File_nexelra_identity_params_proto != nil {
+		return
+	}
+	file_nexelra_identity_identity_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_nexelra_identity_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgGatingRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLevelRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LevelSpendLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAttributeRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelReceiveRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nexelra_identity_params_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IbcReceivePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -483,13 +6638,14 @@ func file_nexelra_identity_params_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nexelra_identity_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_nexelra_identity_params_proto_goTypes,
 		DependencyIndexes: file_nexelra_identity_params_proto_depIdxs,
+		EnumInfos:         file_nexelra_identity_params_proto_enumTypes,
 		MessageInfos:      file_nexelra_identity_params_proto_msgTypes,
 	}.Build()
 	File_nexelra_identity_params_proto = out.File
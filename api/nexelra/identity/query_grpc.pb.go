@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName           = "/nexelra.identity.Query/Params"
-	Query_Identity_FullMethodName         = "/nexelra.identity.Query/Identity"
-	Query_IdentityAll_FullMethodName      = "/nexelra.identity.Query/IdentityAll"
-	Query_IdentityByCccdId_FullMethodName = "/nexelra.identity.Query/IdentityByCccdId"
+	Query_Params_FullMethodName               = "/nexelra.identity.Query/Params"
+	Query_Identity_FullMethodName             = "/nexelra.identity.Query/Identity"
+	Query_IdentityAll_FullMethodName          = "/nexelra.identity.Query/IdentityAll"
+	Query_IdentityByCccdId_FullMethodName     = "/nexelra.identity.Query/IdentityByCccdId"
+	Query_IdentitiesByVerifier_FullMethodName = "/nexelra.identity.Query/IdentitiesByVerifier"
+	Query_GatingPolicy_FullMethodName         = "/nexelra.identity.Query/GatingPolicy"
+	Query_MsgRequirements_FullMethodName      = "/nexelra.identity.Query/MsgRequirements"
+	Query_ResolveDid_FullMethodName           = "/nexelra.identity.Query/ResolveDid"
+	Query_Issuer_FullMethodName               = "/nexelra.identity.Query/Issuer"
+	Query_IssuerAll_FullMethodName            = "/nexelra.identity.Query/IssuerAll"
+	Query_CredentialSchema_FullMethodName     = "/nexelra.identity.Query/CredentialSchema"
+	Query_CredentialSchemaAll_FullMethodName  = "/nexelra.identity.Query/CredentialSchemaAll"
+	Query_Credential_FullMethodName           = "/nexelra.identity.Query/Credential"
+	Query_CredentialsByHolder_FullMethodName  = "/nexelra.identity.Query/CredentialsByHolder"
+	Query_CredentialStatusList_FullMethodName = "/nexelra.identity.Query/CredentialStatusList"
+	Query_AttributeCircuit_FullMethodName     = "/nexelra.identity.Query/AttributeCircuit"
+	Query_AttributeCircuitAll_FullMethodName  = "/nexelra.identity.Query/AttributeCircuitAll"
+	Query_AttributeFlag_FullMethodName        = "/nexelra.identity.Query/AttributeFlag"
+	Query_AttributeFlags_FullMethodName       = "/nexelra.identity.Query/AttributeFlags"
+	Query_SpendAllowance_FullMethodName       = "/nexelra.identity.Query/SpendAllowance"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +53,39 @@ type QueryClient interface {
 	IdentityAll(ctx context.Context, in *QueryAllIdentityRequest, opts ...grpc.CallOption) (*QueryAllIdentityResponse, error)
 	// Queries Identity by CCCD ID hash
 	IdentityByCccdId(ctx context.Context, in *QueryIdentityByCccdIdRequest, opts ...grpc.CallOption) (*QueryIdentityByCccdIdResponse, error)
+	// Queries the identities attested by a verifier.
+	IdentitiesByVerifier(ctx context.Context, in *QueryIdentitiesByVerifierRequest, opts ...grpc.CallOption) (*QueryIdentitiesByVerifierResponse, error)
+	// Queries the identity gating policy enforced by the ante handler.
+	GatingPolicy(ctx context.Context, in *QueryGatingPolicyRequest, opts ...grpc.CallOption) (*QueryGatingPolicyResponse, error)
+	// Explains what the identity gate requires of the signers of a message type.
+	MsgRequirements(ctx context.Context, in *QueryMsgRequirementsRequest, opts ...grpc.CallOption) (*QueryMsgRequirementsResponse, error)
+	// Resolves a did:nexelra DID to its DID document.
+	ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error)
+	// Queries a credential issuer by address.
+	Issuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error)
+	// Queries a list of credential issuers.
+	IssuerAll(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error)
+	// Queries a credential schema by id.
+	CredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error)
+	// Queries a list of credential schemas.
+	CredentialSchemaAll(ctx context.Context, in *QueryAllCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryAllCredentialSchemaResponse, error)
+	// Queries a credential and its current status by id.
+	Credential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error)
+	// Queries the credentials issued to a holder.
+	CredentialsByHolder(ctx context.Context, in *QueryCredentialsByHolderRequest, opts ...grpc.CallOption) (*QueryCredentialsByHolderResponse, error)
+	// Queries the revocation status list of an issuer.
+	CredentialStatusList(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error)
+	// Queries the circuit proving an attribute.
+	AttributeCircuit(ctx context.Context, in *QueryGetAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryGetAttributeCircuitResponse, error)
+	// Queries all attribute circuits.
+	AttributeCircuitAll(ctx context.Context, in *QueryAllAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryAllAttributeCircuitResponse, error)
+	// Queries the flag of an attribute on an identity.
+	AttributeFlag(ctx context.Context, in *QueryGetAttributeFlagRequest, opts ...grpc.CallOption) (*QueryGetAttributeFlagResponse, error)
+	// Queries the attribute flags of an identity.
+	AttributeFlags(ctx context.Context, in *QueryAttributeFlagsRequest, opts ...grpc.CallOption) (*QueryAttributeFlagsResponse, error)
+	// Queries what the identity of an address may still send within the
+	// window of the spend policy.
+	SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +132,150 @@ func (c *queryClient) IdentityByCccdId(ctx context.Context, in *QueryIdentityByC
 	return out, nil
 }
 
+func (c *queryClient) IdentitiesByVerifier(ctx context.Context, in *QueryIdentitiesByVerifierRequest, opts ...grpc.CallOption) (*QueryIdentitiesByVerifierResponse, error) {
+	out := new(QueryIdentitiesByVerifierResponse)
+	err := c.cc.Invoke(ctx, Query_IdentitiesByVerifier_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GatingPolicy(ctx context.Context, in *QueryGatingPolicyRequest, opts ...grpc.CallOption) (*QueryGatingPolicyResponse, error) {
+	out := new(QueryGatingPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_GatingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgRequirements(ctx context.Context, in *QueryMsgRequirementsRequest, opts ...grpc.CallOption) (*QueryMsgRequirementsResponse, error) {
+	out := new(QueryMsgRequirementsResponse)
+	err := c.cc.Invoke(ctx, Query_MsgRequirements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error) {
+	out := new(QueryResolveDidResponse)
+	err := c.cc.Invoke(ctx, Query_ResolveDid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Issuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error) {
+	out := new(QueryGetIssuerResponse)
+	err := c.cc.Invoke(ctx, Query_Issuer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuerAll(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error) {
+	out := new(QueryAllIssuerResponse)
+	err := c.cc.Invoke(ctx, Query_IssuerAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error) {
+	out := new(QueryGetCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_CredentialSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchemaAll(ctx context.Context, in *QueryAllCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryAllCredentialSchemaResponse, error) {
+	out := new(QueryAllCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_CredentialSchemaAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Credential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error) {
+	out := new(QueryGetCredentialResponse)
+	err := c.cc.Invoke(ctx, Query_Credential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialsByHolder(ctx context.Context, in *QueryCredentialsByHolderRequest, opts ...grpc.CallOption) (*QueryCredentialsByHolderResponse, error) {
+	out := new(QueryCredentialsByHolderResponse)
+	err := c.cc.Invoke(ctx, Query_CredentialsByHolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusList(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error) {
+	out := new(QueryCredentialStatusListResponse)
+	err := c.cc.Invoke(ctx, Query_CredentialStatusList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeCircuit(ctx context.Context, in *QueryGetAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryGetAttributeCircuitResponse, error) {
+	out := new(QueryGetAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, Query_AttributeCircuit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeCircuitAll(ctx context.Context, in *QueryAllAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryAllAttributeCircuitResponse, error) {
+	out := new(QueryAllAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, Query_AttributeCircuitAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeFlag(ctx context.Context, in *QueryGetAttributeFlagRequest, opts ...grpc.CallOption) (*QueryGetAttributeFlagResponse, error) {
+	out := new(QueryGetAttributeFlagResponse)
+	err := c.cc.Invoke(ctx, Query_AttributeFlag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeFlags(ctx context.Context, in *QueryAttributeFlagsRequest, opts ...grpc.CallOption) (*QueryAttributeFlagsResponse, error) {
+	out := new(QueryAttributeFlagsResponse)
+	err := c.cc.Invoke(ctx, Query_AttributeFlags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error) {
+	out := new(QuerySpendAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_SpendAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -95,6 +288,39 @@ type QueryServer interface {
 	IdentityAll(context.Context, *QueryAllIdentityRequest) (*QueryAllIdentityResponse, error)
 	// Queries Identity by CCCD ID hash
 	IdentityByCccdId(context.Context, *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error)
+	// Queries the identities attested by a verifier.
+	IdentitiesByVerifier(context.Context, *QueryIdentitiesByVerifierRequest) (*QueryIdentitiesByVerifierResponse, error)
+	// Queries the identity gating policy enforced by the ante handler.
+	GatingPolicy(context.Context, *QueryGatingPolicyRequest) (*QueryGatingPolicyResponse, error)
+	// Explains what the identity gate requires of the signers of a message type.
+	MsgRequirements(context.Context, *QueryMsgRequirementsRequest) (*QueryMsgRequirementsResponse, error)
+	// Resolves a did:nexelra DID to its DID document.
+	ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error)
+	// Queries a credential issuer by address.
+	Issuer(context.Context, *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error)
+	// Queries a list of credential issuers.
+	IssuerAll(context.Context, *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error)
+	// Queries a credential schema by id.
+	CredentialSchema(context.Context, *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error)
+	// Queries a list of credential schemas.
+	CredentialSchemaAll(context.Context, *QueryAllCredentialSchemaRequest) (*QueryAllCredentialSchemaResponse, error)
+	// Queries a credential and its current status by id.
+	Credential(context.Context, *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error)
+	// Queries the credentials issued to a holder.
+	CredentialsByHolder(context.Context, *QueryCredentialsByHolderRequest) (*QueryCredentialsByHolderResponse, error)
+	// Queries the revocation status list of an issuer.
+	CredentialStatusList(context.Context, *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error)
+	// Queries the circuit proving an attribute.
+	AttributeCircuit(context.Context, *QueryGetAttributeCircuitRequest) (*QueryGetAttributeCircuitResponse, error)
+	// Queries all attribute circuits.
+	AttributeCircuitAll(context.Context, *QueryAllAttributeCircuitRequest) (*QueryAllAttributeCircuitResponse, error)
+	// Queries the flag of an attribute on an identity.
+	AttributeFlag(context.Context, *QueryGetAttributeFlagRequest) (*QueryGetAttributeFlagResponse, error)
+	// Queries the attribute flags of an identity.
+	AttributeFlags(context.Context, *QueryAttributeFlagsRequest) (*QueryAttributeFlagsResponse, error)
+	// Queries what the identity of an address may still send within the
+	// window of the spend policy.
+	SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +340,54 @@ func (UnimplementedQueryServer) IdentityAll(context.Context, *QueryAllIdentityRe
 func (UnimplementedQueryServer) IdentityByCccdId(context.Context, *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IdentityByCccdId not implemented")
 }
+func (UnimplementedQueryServer) IdentitiesByVerifier(context.Context, *QueryIdentitiesByVerifierRequest) (*QueryIdentitiesByVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentitiesByVerifier not implemented")
+}
+func (UnimplementedQueryServer) GatingPolicy(context.Context, *QueryGatingPolicyRequest) (*QueryGatingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatingPolicy not implemented")
+}
+func (UnimplementedQueryServer) MsgRequirements(context.Context, *QueryMsgRequirementsRequest) (*QueryMsgRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRequirements not implemented")
+}
+func (UnimplementedQueryServer) ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDid not implemented")
+}
+func (UnimplementedQueryServer) Issuer(context.Context, *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issuer not implemented")
+}
+func (UnimplementedQueryServer) IssuerAll(context.Context, *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAll not implemented")
+}
+func (UnimplementedQueryServer) CredentialSchema(context.Context, *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchema not implemented")
+}
+func (UnimplementedQueryServer) CredentialSchemaAll(context.Context, *QueryAllCredentialSchemaRequest) (*QueryAllCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemaAll not implemented")
+}
+func (UnimplementedQueryServer) Credential(context.Context, *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credential not implemented")
+}
+func (UnimplementedQueryServer) CredentialsByHolder(context.Context, *QueryCredentialsByHolderRequest) (*QueryCredentialsByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialsByHolder not implemented")
+}
+func (UnimplementedQueryServer) CredentialStatusList(context.Context, *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusList not implemented")
+}
+func (UnimplementedQueryServer) AttributeCircuit(context.Context, *QueryGetAttributeCircuitRequest) (*QueryGetAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeCircuit not implemented")
+}
+func (UnimplementedQueryServer) AttributeCircuitAll(context.Context, *QueryAllAttributeCircuitRequest) (*QueryAllAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeCircuitAll not implemented")
+}
+func (UnimplementedQueryServer) AttributeFlag(context.Context, *QueryGetAttributeFlagRequest) (*QueryGetAttributeFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeFlag not implemented")
+}
+func (UnimplementedQueryServer) AttributeFlags(context.Context, *QueryAttributeFlagsRequest) (*QueryAttributeFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeFlags not implemented")
+}
+func (UnimplementedQueryServer) SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +473,294 @@ func _Query_IdentityByCccdId_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IdentitiesByVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentitiesByVerifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentitiesByVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IdentitiesByVerifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentitiesByVerifier(ctx, req.(*QueryIdentitiesByVerifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GatingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GatingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatingPolicy(ctx, req.(*QueryGatingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRequirements(ctx, req.(*QueryMsgRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResolveDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDid(ctx, req.(*QueryResolveDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Issuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Issuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Issuer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Issuer(ctx, req.(*QueryGetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IssuerAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAll(ctx, req.(*QueryAllIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CredentialSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchema(ctx, req.(*QueryGetCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchemaAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchemaAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CredentialSchemaAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchemaAll(ctx, req.(*QueryAllCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Credential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Credential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Credential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Credential(ctx, req.(*QueryGetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialsByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialsByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialsByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CredentialsByHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialsByHolder(ctx, req.(*QueryCredentialsByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CredentialStatusList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusList(ctx, req.(*QueryCredentialStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttributeCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttributeCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeCircuit(ctx, req.(*QueryGetAttributeCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeCircuitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttributeCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeCircuitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttributeCircuitAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeCircuitAll(ctx, req.(*QueryAllAttributeCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttributeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttributeFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeFlag(ctx, req.(*QueryGetAttributeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttributeFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeFlags(ctx, req.(*QueryAttributeFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowance(ctx, req.(*QuerySpendAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +784,70 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IdentityByCccdId",
 			Handler:    _Query_IdentityByCccdId_Handler,
 		},
+		{
+			MethodName: "IdentitiesByVerifier",
+			Handler:    _Query_IdentitiesByVerifier_Handler,
+		},
+		{
+			MethodName: "GatingPolicy",
+			Handler:    _Query_GatingPolicy_Handler,
+		},
+		{
+			MethodName: "MsgRequirements",
+			Handler:    _Query_MsgRequirements_Handler,
+		},
+		{
+			MethodName: "ResolveDid",
+			Handler:    _Query_ResolveDid_Handler,
+		},
+		{
+			MethodName: "Issuer",
+			Handler:    _Query_Issuer_Handler,
+		},
+		{
+			MethodName: "IssuerAll",
+			Handler:    _Query_IssuerAll_Handler,
+		},
+		{
+			MethodName: "CredentialSchema",
+			Handler:    _Query_CredentialSchema_Handler,
+		},
+		{
+			MethodName: "CredentialSchemaAll",
+			Handler:    _Query_CredentialSchemaAll_Handler,
+		},
+		{
+			MethodName: "Credential",
+			Handler:    _Query_Credential_Handler,
+		},
+		{
+			MethodName: "CredentialsByHolder",
+			Handler:    _Query_CredentialsByHolder_Handler,
+		},
+		{
+			MethodName: "CredentialStatusList",
+			Handler:    _Query_CredentialStatusList_Handler,
+		},
+		{
+			MethodName: "AttributeCircuit",
+			Handler:    _Query_AttributeCircuit_Handler,
+		},
+		{
+			MethodName: "AttributeCircuitAll",
+			Handler:    _Query_AttributeCircuitAll_Handler,
+		},
+		{
+			MethodName: "AttributeFlag",
+			Handler:    _Query_AttributeFlag_Handler,
+		},
+		{
+			MethodName: "AttributeFlags",
+			Handler:    _Query_AttributeFlags_Handler,
+		},
+		{
+			MethodName: "SpendAllowance",
+			Handler:    _Query_SpendAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nexelra/identity/query.proto",
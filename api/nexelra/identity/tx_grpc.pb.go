@@ -19,8 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName   = "/nexelra.identity.Msg/UpdateParams"
-	Msg_CreateIdentity_FullMethodName = "/nexelra.identity.Msg/CreateIdentity"
+	Msg_UpdateParams_FullMethodName             = "/nexelra.identity.Msg/UpdateParams"
+	Msg_CreateIdentity_FullMethodName           = "/nexelra.identity.Msg/CreateIdentity"
+	Msg_UpdateIdentity_FullMethodName           = "/nexelra.identity.Msg/UpdateIdentity"
+	Msg_RevokeIdentity_FullMethodName           = "/nexelra.identity.Msg/RevokeIdentity"
+	Msg_SuspendIdentity_FullMethodName          = "/nexelra.identity.Msg/SuspendIdentity"
+	Msg_ReinstateIdentity_FullMethodName        = "/nexelra.identity.Msg/ReinstateIdentity"
+	Msg_AttestIdentity_FullMethodName           = "/nexelra.identity.Msg/AttestIdentity"
+	Msg_SetIdentityLevel_FullMethodName         = "/nexelra.identity.Msg/SetIdentityLevel"
+	Msg_CreateDid_FullMethodName                = "/nexelra.identity.Msg/CreateDid"
+	Msg_UpdateDid_FullMethodName                = "/nexelra.identity.Msg/UpdateDid"
+	Msg_RotateDidKey_FullMethodName             = "/nexelra.identity.Msg/RotateDidKey"
+	Msg_DeactivateDid_FullMethodName            = "/nexelra.identity.Msg/DeactivateDid"
+	Msg_RegisterIssuer_FullMethodName           = "/nexelra.identity.Msg/RegisterIssuer"
+	Msg_RemoveIssuer_FullMethodName             = "/nexelra.identity.Msg/RemoveIssuer"
+	Msg_RegisterCredentialSchema_FullMethodName = "/nexelra.identity.Msg/RegisterCredentialSchema"
+	Msg_IssueCredential_FullMethodName          = "/nexelra.identity.Msg/IssueCredential"
+	Msg_RevokeCredential_FullMethodName         = "/nexelra.identity.Msg/RevokeCredential"
+	Msg_RegisterAttributeCircuit_FullMethodName = "/nexelra.identity.Msg/RegisterAttributeCircuit"
+	Msg_RemoveAttributeCircuit_FullMethodName   = "/nexelra.identity.Msg/RemoveAttributeCircuit"
+	Msg_ProveAttribute_FullMethodName           = "/nexelra.identity.Msg/ProveAttribute"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +47,24 @@ const (
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	CreateIdentity(ctx context.Context, in *MsgCreateIdentity, opts ...grpc.CallOption) (*MsgCreateIdentityResponse, error)
+	UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error)
+	RevokeIdentity(ctx context.Context, in *MsgRevokeIdentity, opts ...grpc.CallOption) (*MsgRevokeIdentityResponse, error)
+	SuspendIdentity(ctx context.Context, in *MsgSuspendIdentity, opts ...grpc.CallOption) (*MsgSuspendIdentityResponse, error)
+	ReinstateIdentity(ctx context.Context, in *MsgReinstateIdentity, opts ...grpc.CallOption) (*MsgReinstateIdentityResponse, error)
+	AttestIdentity(ctx context.Context, in *MsgAttestIdentity, opts ...grpc.CallOption) (*MsgAttestIdentityResponse, error)
+	SetIdentityLevel(ctx context.Context, in *MsgSetIdentityLevel, opts ...grpc.CallOption) (*MsgSetIdentityLevelResponse, error)
+	CreateDid(ctx context.Context, in *MsgCreateDid, opts ...grpc.CallOption) (*MsgCreateDidResponse, error)
+	UpdateDid(ctx context.Context, in *MsgUpdateDid, opts ...grpc.CallOption) (*MsgUpdateDidResponse, error)
+	RotateDidKey(ctx context.Context, in *MsgRotateDidKey, opts ...grpc.CallOption) (*MsgRotateDidKeyResponse, error)
+	DeactivateDid(ctx context.Context, in *MsgDeactivateDid, opts ...grpc.CallOption) (*MsgDeactivateDidResponse, error)
+	RegisterIssuer(ctx context.Context, in *MsgRegisterIssuer, opts ...grpc.CallOption) (*MsgRegisterIssuerResponse, error)
+	RemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error)
+	RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error)
+	IssueCredential(ctx context.Context, in *MsgIssueCredential, opts ...grpc.CallOption) (*MsgIssueCredentialResponse, error)
+	RevokeCredential(ctx context.Context, in *MsgRevokeCredential, opts ...grpc.CallOption) (*MsgRevokeCredentialResponse, error)
+	RegisterAttributeCircuit(ctx context.Context, in *MsgRegisterAttributeCircuit, opts ...grpc.CallOption) (*MsgRegisterAttributeCircuitResponse, error)
+	RemoveAttributeCircuit(ctx context.Context, in *MsgRemoveAttributeCircuit, opts ...grpc.CallOption) (*MsgRemoveAttributeCircuitResponse, error)
+	ProveAttribute(ctx context.Context, in *MsgProveAttribute, opts ...grpc.CallOption) (*MsgProveAttributeResponse, error)
 }
 
 type msgClient struct {
@@ -57,12 +93,192 @@ func (c *msgClient) CreateIdentity(ctx context.Context, in *MsgCreateIdentity, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error) {
+	out := new(MsgUpdateIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeIdentity(ctx context.Context, in *MsgRevokeIdentity, opts ...grpc.CallOption) (*MsgRevokeIdentityResponse, error) {
+	out := new(MsgRevokeIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuspendIdentity(ctx context.Context, in *MsgSuspendIdentity, opts ...grpc.CallOption) (*MsgSuspendIdentityResponse, error) {
+	out := new(MsgSuspendIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_SuspendIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReinstateIdentity(ctx context.Context, in *MsgReinstateIdentity, opts ...grpc.CallOption) (*MsgReinstateIdentityResponse, error) {
+	out := new(MsgReinstateIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_ReinstateIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestIdentity(ctx context.Context, in *MsgAttestIdentity, opts ...grpc.CallOption) (*MsgAttestIdentityResponse, error) {
+	out := new(MsgAttestIdentityResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIdentityLevel(ctx context.Context, in *MsgSetIdentityLevel, opts ...grpc.CallOption) (*MsgSetIdentityLevelResponse, error) {
+	out := new(MsgSetIdentityLevelResponse)
+	err := c.cc.Invoke(ctx, Msg_SetIdentityLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDid(ctx context.Context, in *MsgCreateDid, opts ...grpc.CallOption) (*MsgCreateDidResponse, error) {
+	out := new(MsgCreateDidResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDid(ctx context.Context, in *MsgUpdateDid, opts ...grpc.CallOption) (*MsgUpdateDidResponse, error) {
+	out := new(MsgUpdateDidResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateDidKey(ctx context.Context, in *MsgRotateDidKey, opts ...grpc.CallOption) (*MsgRotateDidKeyResponse, error) {
+	out := new(MsgRotateDidKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateDidKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeactivateDid(ctx context.Context, in *MsgDeactivateDid, opts ...grpc.CallOption) (*MsgDeactivateDidResponse, error) {
+	out := new(MsgDeactivateDidResponse)
+	err := c.cc.Invoke(ctx, Msg_DeactivateDid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterIssuer(ctx context.Context, in *MsgRegisterIssuer, opts ...grpc.CallOption) (*MsgRegisterIssuerResponse, error) {
+	out := new(MsgRegisterIssuerResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterIssuer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveIssuer(ctx context.Context, in *MsgRemoveIssuer, opts ...grpc.CallOption) (*MsgRemoveIssuerResponse, error) {
+	out := new(MsgRemoveIssuerResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveIssuer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error) {
+	out := new(MsgRegisterCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCredentialSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueCredential(ctx context.Context, in *MsgIssueCredential, opts ...grpc.CallOption) (*MsgIssueCredentialResponse, error) {
+	out := new(MsgIssueCredentialResponse)
+	err := c.cc.Invoke(ctx, Msg_IssueCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCredential(ctx context.Context, in *MsgRevokeCredential, opts ...grpc.CallOption) (*MsgRevokeCredentialResponse, error) {
+	out := new(MsgRevokeCredentialResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAttributeCircuit(ctx context.Context, in *MsgRegisterAttributeCircuit, opts ...grpc.CallOption) (*MsgRegisterAttributeCircuitResponse, error) {
+	out := new(MsgRegisterAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterAttributeCircuit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttributeCircuit(ctx context.Context, in *MsgRemoveAttributeCircuit, opts ...grpc.CallOption) (*MsgRemoveAttributeCircuitResponse, error) {
+	out := new(MsgRemoveAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAttributeCircuit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProveAttribute(ctx context.Context, in *MsgProveAttribute, opts ...grpc.CallOption) (*MsgProveAttributeResponse, error) {
+	out := new(MsgProveAttributeResponse)
+	err := c.cc.Invoke(ctx, Msg_ProveAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	CreateIdentity(context.Context, *MsgCreateIdentity) (*MsgCreateIdentityResponse, error)
+	UpdateIdentity(context.Context, *MsgUpdateIdentity) (*MsgUpdateIdentityResponse, error)
+	RevokeIdentity(context.Context, *MsgRevokeIdentity) (*MsgRevokeIdentityResponse, error)
+	SuspendIdentity(context.Context, *MsgSuspendIdentity) (*MsgSuspendIdentityResponse, error)
+	ReinstateIdentity(context.Context, *MsgReinstateIdentity) (*MsgReinstateIdentityResponse, error)
+	AttestIdentity(context.Context, *MsgAttestIdentity) (*MsgAttestIdentityResponse, error)
+	SetIdentityLevel(context.Context, *MsgSetIdentityLevel) (*MsgSetIdentityLevelResponse, error)
+	CreateDid(context.Context, *MsgCreateDid) (*MsgCreateDidResponse, error)
+	UpdateDid(context.Context, *MsgUpdateDid) (*MsgUpdateDidResponse, error)
+	RotateDidKey(context.Context, *MsgRotateDidKey) (*MsgRotateDidKeyResponse, error)
+	DeactivateDid(context.Context, *MsgDeactivateDid) (*MsgDeactivateDidResponse, error)
+	RegisterIssuer(context.Context, *MsgRegisterIssuer) (*MsgRegisterIssuerResponse, error)
+	RemoveIssuer(context.Context, *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error)
+	RegisterCredentialSchema(context.Context, *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error)
+	IssueCredential(context.Context, *MsgIssueCredential) (*MsgIssueCredentialResponse, error)
+	RevokeCredential(context.Context, *MsgRevokeCredential) (*MsgRevokeCredentialResponse, error)
+	RegisterAttributeCircuit(context.Context, *MsgRegisterAttributeCircuit) (*MsgRegisterAttributeCircuitResponse, error)
+	RemoveAttributeCircuit(context.Context, *MsgRemoveAttributeCircuit) (*MsgRemoveAttributeCircuitResponse, error)
+	ProveAttribute(context.Context, *MsgProveAttribute) (*MsgProveAttributeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -76,6 +292,60 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CreateIdentity(context.Context, *MsgCreateIdentity) (*MsgCreateIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIdentity not implemented")
 }
+func (UnimplementedMsgServer) UpdateIdentity(context.Context, *MsgUpdateIdentity) (*MsgUpdateIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdentity not implemented")
+}
+func (UnimplementedMsgServer) RevokeIdentity(context.Context, *MsgRevokeIdentity) (*MsgRevokeIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeIdentity not implemented")
+}
+func (UnimplementedMsgServer) SuspendIdentity(context.Context, *MsgSuspendIdentity) (*MsgSuspendIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendIdentity not implemented")
+}
+func (UnimplementedMsgServer) ReinstateIdentity(context.Context, *MsgReinstateIdentity) (*MsgReinstateIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateIdentity not implemented")
+}
+func (UnimplementedMsgServer) AttestIdentity(context.Context, *MsgAttestIdentity) (*MsgAttestIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestIdentity not implemented")
+}
+func (UnimplementedMsgServer) SetIdentityLevel(context.Context, *MsgSetIdentityLevel) (*MsgSetIdentityLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIdentityLevel not implemented")
+}
+func (UnimplementedMsgServer) CreateDid(context.Context, *MsgCreateDid) (*MsgCreateDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDid not implemented")
+}
+func (UnimplementedMsgServer) UpdateDid(context.Context, *MsgUpdateDid) (*MsgUpdateDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDid not implemented")
+}
+func (UnimplementedMsgServer) RotateDidKey(context.Context, *MsgRotateDidKey) (*MsgRotateDidKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDidKey not implemented")
+}
+func (UnimplementedMsgServer) DeactivateDid(context.Context, *MsgDeactivateDid) (*MsgDeactivateDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDid not implemented")
+}
+func (UnimplementedMsgServer) RegisterIssuer(context.Context, *MsgRegisterIssuer) (*MsgRegisterIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIssuer not implemented")
+}
+func (UnimplementedMsgServer) RemoveIssuer(context.Context, *MsgRemoveIssuer) (*MsgRemoveIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIssuer not implemented")
+}
+func (UnimplementedMsgServer) RegisterCredentialSchema(context.Context, *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialSchema not implemented")
+}
+func (UnimplementedMsgServer) IssueCredential(context.Context, *MsgIssueCredential) (*MsgIssueCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCredential not implemented")
+}
+func (UnimplementedMsgServer) RevokeCredential(context.Context, *MsgRevokeCredential) (*MsgRevokeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCredential not implemented")
+}
+func (UnimplementedMsgServer) RegisterAttributeCircuit(context.Context, *MsgRegisterAttributeCircuit) (*MsgRegisterAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAttributeCircuit not implemented")
+}
+func (UnimplementedMsgServer) RemoveAttributeCircuit(context.Context, *MsgRemoveAttributeCircuit) (*MsgRemoveAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttributeCircuit not implemented")
+}
+func (UnimplementedMsgServer) ProveAttribute(context.Context, *MsgProveAttribute) (*MsgProveAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveAttribute not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +395,330 @@ func _Msg_CreateIdentity_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIdentity(ctx, req.(*MsgUpdateIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeIdentity(ctx, req.(*MsgRevokeIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SuspendIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendIdentity(ctx, req.(*MsgSuspendIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReinstateIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReinstateIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReinstateIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReinstateIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReinstateIdentity(ctx, req.(*MsgReinstateIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestIdentity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestIdentity(ctx, req.(*MsgAttestIdentity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIdentityLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIdentityLevel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIdentityLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetIdentityLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIdentityLevel(ctx, req.(*MsgSetIdentityLevel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDid(ctx, req.(*MsgCreateDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDid(ctx, req.(*MsgUpdateDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDidKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDidKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDidKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateDidKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDidKey(ctx, req.(*MsgRotateDidKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeactivateDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateDid(ctx, req.(*MsgDeactivateDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterIssuer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterIssuer(ctx, req.(*MsgRegisterIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveIssuer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveIssuer(ctx, req.(*MsgRemoveIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCredentialSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCredentialSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCredentialSchema(ctx, req.(*MsgRegisterCredentialSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_IssueCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueCredential(ctx, req.(*MsgIssueCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCredential(ctx, req.(*MsgRevokeCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAttributeCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAttributeCircuit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAttributeCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterAttributeCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAttributeCircuit(ctx, req.(*MsgRegisterAttributeCircuit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAttributeCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttributeCircuit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAttributeCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAttributeCircuit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAttributeCircuit(ctx, req.(*MsgRemoveAttributeCircuit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProveAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProveAttribute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProveAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProveAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProveAttribute(ctx, req.(*MsgProveAttribute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +734,78 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateIdentity",
 			Handler:    _Msg_CreateIdentity_Handler,
 		},
+		{
+			MethodName: "UpdateIdentity",
+			Handler:    _Msg_UpdateIdentity_Handler,
+		},
+		{
+			MethodName: "RevokeIdentity",
+			Handler:    _Msg_RevokeIdentity_Handler,
+		},
+		{
+			MethodName: "SuspendIdentity",
+			Handler:    _Msg_SuspendIdentity_Handler,
+		},
+		{
+			MethodName: "ReinstateIdentity",
+			Handler:    _Msg_ReinstateIdentity_Handler,
+		},
+		{
+			MethodName: "AttestIdentity",
+			Handler:    _Msg_AttestIdentity_Handler,
+		},
+		{
+			MethodName: "SetIdentityLevel",
+			Handler:    _Msg_SetIdentityLevel_Handler,
+		},
+		{
+			MethodName: "CreateDid",
+			Handler:    _Msg_CreateDid_Handler,
+		},
+		{
+			MethodName: "UpdateDid",
+			Handler:    _Msg_UpdateDid_Handler,
+		},
+		{
+			MethodName: "RotateDidKey",
+			Handler:    _Msg_RotateDidKey_Handler,
+		},
+		{
+			MethodName: "DeactivateDid",
+			Handler:    _Msg_DeactivateDid_Handler,
+		},
+		{
+			MethodName: "RegisterIssuer",
+			Handler:    _Msg_RegisterIssuer_Handler,
+		},
+		{
+			MethodName: "RemoveIssuer",
+			Handler:    _Msg_RemoveIssuer_Handler,
+		},
+		{
+			MethodName: "RegisterCredentialSchema",
+			Handler:    _Msg_RegisterCredentialSchema_Handler,
+		},
+		{
+			MethodName: "IssueCredential",
+			Handler:    _Msg_IssueCredential_Handler,
+		},
+		{
+			MethodName: "RevokeCredential",
+			Handler:    _Msg_RevokeCredential_Handler,
+		},
+		{
+			MethodName: "RegisterAttributeCircuit",
+			Handler:    _Msg_RegisterAttributeCircuit_Handler,
+		},
+		{
+			MethodName: "RemoveAttributeCircuit",
+			Handler:    _Msg_RemoveAttributeCircuit_Handler,
+		},
+		{
+			MethodName: "ProveAttribute",
+			Handler:    _Msg_ProveAttribute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nexelra/identity/tx.proto",
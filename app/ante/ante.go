@@ -2,19 +2,30 @@ package ante
 
 import (
     "fmt"
-    "reflect"
+    "strconv"
 
     identitykeeper "Nexelra/x/identity/keeper"
+    identitytypes "Nexelra/x/identity/types"
 
+    errorsmod "cosmossdk.io/errors"
+    "github.com/cosmos/cosmos-sdk/codec"
     sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
     "github.com/cosmos/cosmos-sdk/x/auth/ante"
-    banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
     ante.HandlerOptions
+    // Codec resolves message signers from their cosmos.msg.v1.signer option.
+    Codec          codec.Codec
     IdentityKeeper identitykeeper.Keeper
+    // ModuleAccounts are the bech32 addresses of the app's module accounts,
+    // exempt from the identity gate when the gating policy says so.
+    ModuleAccounts map[string]bool
+    // RecipientRegistry finds the recipients of each message, defaults to
+    // DefaultRecipientRegistry().
+    RecipientRegistry *RecipientRegistry
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -33,6 +44,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
         return nil, fmt.Errorf("sign mode handler is required for ante builder")
     }
 
+    if options.Codec == nil {
+        return nil, fmt.Errorf("codec is required for ante builder")
+    }
+
+    if options.RecipientRegistry == nil {
+        options.RecipientRegistry = DefaultRecipientRegistry()
+    }
+
     anteDecorators := []sdk.AnteDecorator{
         ante.NewSetUpContextDecorator(),
         ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -46,22 +65,34 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
         ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
         ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
         ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-        NewIdentityVerificationDecorator(options.IdentityKeeper), // Custom decorator
+        NewIdentityVerificationDecorator(options.Codec, options.IdentityKeeper, options.ModuleAccounts, options.RecipientRegistry), // Custom decorator
+        NewSoulboundNFTDecorator(options.RecipientRegistry),
+        NewValidatorIdentityDecorator(options.IdentityKeeper, options.RecipientRegistry),
+        NewSpendLimitDecorator(options.IdentityKeeper, options.ModuleAccounts, options.RecipientRegistry),
     }
 
     return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
 
 // IdentityVerificationDecorator is an ante decorator that verifies if the
-// transaction signer has registered their identity.
+// transaction signers and recipients have an active identity, following the
+// gating policy stored in the identity module params. The policy may also
+// require a minimum identity level or proven attributes of the signers, and
+// limit the funds sent by the identities of each level.
 type IdentityVerificationDecorator struct {
+    Codec          codec.Codec
     IdentityKeeper identitykeeper.Keeper
+    ModuleAccounts map[string]bool
+    Recipients     *RecipientRegistry
 }
 
 // NewIdentityVerificationDecorator creates a new IdentityVerificationDecorator
-func NewIdentityVerificationDecorator(keeper identitykeeper.Keeper) IdentityVerificationDecorator {
+func NewIdentityVerificationDecorator(cdc codec.Codec, keeper identitykeeper.Keeper, moduleAccounts map[string]bool, recipients *RecipientRegistry) IdentityVerificationDecorator {
     return IdentityVerificationDecorator{
+        Codec:          cdc,
         IdentityKeeper: keeper,
+        ModuleAccounts: moduleAccounts,
+        Recipients:     recipients,
     }
 }
 
@@ -91,58 +122,51 @@ func (d IdentityVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
         return next(ctx, tx, simulate)
     }
 
+    // Chính sách kiểm tra danh tính do governance quản lý trong Params
+    policy := d.IdentityKeeper.GetParams(ctx).GatingPolicy
+
     // Process each message individually
     for i, msg := range msgs {
         msgType := sdk.MsgTypeURL(msg)
-        ctx.Logger().Info("🔍 PROCESSING MESSAGE", "index", i, "type", msgType)
+        rule := policy.RuleFor(msgType)
+        ctx.Logger().Info("🔍 PROCESSING MESSAGE", "index", i, "type", msgType, "rule", rule.String())
 
-        // Check if this is an identity module message
-        if isIdentityModuleMsg(msgType) {
-            ctx.Logger().Info("✅ ALLOWING IDENTITY MODULE MESSAGE", "type", msgType)
+        if rule == identitytypes.GatingRule_GATING_RULE_EXEMPT {
+            ctx.Logger().Info("✅ ALLOWING EXEMPT MESSAGE", "type", msgType)
             continue
         }
 
-        // Get signers from this specific message
-        var signers []sdk.AccAddress
-
-        // Most Cosmos SDK messages implement GetSigners() method
-        if signerMsg, ok := msg.(interface{ GetSigners() []sdk.AccAddress }); ok {
-            signers = signerMsg.GetSigners()
-            ctx.Logger().Info("👥 GENERIC SIGNERS EXTRACTED", "count", len(signers), "type", msgType)
-        } else {
-            // Fallback for specific message types that don't implement GetSigners() properly
-            switch m := msg.(type) {
-            case *banktypes.MsgSend:
-                signers = []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.FromAddress)}
-                ctx.Logger().Info("👥 BANK SEND SIGNERS", "count", len(signers), "from", m.FromAddress)
-            case *banktypes.MsgMultiSend:
-                for _, input := range m.Inputs {
-                    signers = append(signers, sdk.MustAccAddressFromBech32(input.Address))
-                }
-                ctx.Logger().Info("👥 BANK MULTI-SEND SIGNERS", "count", len(signers))
-            default:
-                // GENERIC FALLBACK: Tự động extract Creator field từ bất kỳ message nào
-                if creatorAddr := extractCreatorFromMessage(msg); creatorAddr != "" {
-                    signers = []sdk.AccAddress{sdk.MustAccAddressFromBech32(creatorAddr)}
-                    ctx.Logger().Info("👥 GENERIC CREATOR EXTRACTED", "count", len(signers), "creator", creatorAddr, "type", msgType)
-                } else {
-                    ctx.Logger().Info("❌ NO SIGNERS FOUND", "type", msgType)
-                }
-            }
+        // Signers lấy từ annotation cosmos.msg.v1.signer qua signing context,
+        // giống SigVerificationDecorator của SDK
+        signerBzs, _, err := d.Codec.GetMsgV1Signers(msg)
+        if err != nil {
+            ctx.Logger().Info("❌ REJECTING TRANSACTION - CANNOT GET SIGNERS", "type", msgType, "error", err.Error())
+            return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot get signers of %s: %s", msgType, err)
         }
 
         // BẮT BUỘC: Nếu không extract được signers, reject transaction
-        if len(signers) == 0 {
+        if len(signerBzs) == 0 {
             ctx.Logger().Info("❌ REJECTING TRANSACTION - NO SIGNERS FOUND", "type", msgType)
-            return ctx, fmt.Errorf("NGƯỜI GỬI CHƯA ĐĂNG KÝ DANH TÍNH: %s", "address_not_found")
+            return ctx, errorsmod.Wrapf(sdkerrors.ErrNoSignatures, "%s has no signers", msgType)
         }
 
+        signers := make([]sdk.AccAddress, len(signerBzs))
+        for j, bz := range signerBzs {
+            signers[j] = sdk.AccAddress(bz)
+        }
+        ctx.Logger().Info("👥 SIGNERS EXTRACTED", "count", len(signers), "type", msgType)
+
         // Check each signer
         for j, signer := range signers {
             ctx.Logger().Info("🔍 CHECKING SIGNER", "msgIndex", i, "signerIndex", j, "address", signer.String())
 
-            // Check if user has registered identity (đơn giản hóa - chỉ kiểm tra có identity hay không)
-            _, found := d.IdentityKeeper.GetIdentity(ctx, signer.String())
+            if policy.IsExempt(signer.String(), d.ModuleAccounts) {
+                ctx.Logger().Info("✅ SIGNER EXEMPT", "address", signer.String(), "msgType", msgType)
+                continue
+            }
+
+            // Check if user has registered identity
+            identity, found := d.IdentityKeeper.GetIdentity(ctx, signer.String())
 
             if !found {
                 ctx.Logger().Info("❌ REJECTING TRANSACTION - NO IDENTITY",
@@ -150,34 +174,113 @@ func (d IdentityVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
                     "msgType", msgType,
                     "msgIndex", i,
                     "signerIndex", j)
-                return ctx, fmt.Errorf("NGƯỜI GỬI CHƯA ĐĂNG KÝ DANH TÍNH: %s", signer.String())
+                return ctx, errorsmod.Wrapf(identitytypes.ErrSignerNotRegistered, identitytypes.RejectionFormat, signer.String(), i, msgType)
+            }
+
+            // Identity chưa được verifier xác thực thì chưa được giao dịch
+            if identity.Status == identitytypes.IdentityStatus_IDENTITY_STATUS_PENDING {
+                ctx.Logger().Info("❌ REJECTING TRANSACTION - IDENTITY PENDING ATTESTATION",
+                    "address", signer.String(),
+                    "msgType", msgType,
+                    "msgIndex", i,
+                    "signerIndex", j)
+                return ctx, errorsmod.Wrapf(identitytypes.ErrIdentityPending, identitytypes.RejectionFormat, signer.String(), i, msgType)
+            }
+
+            // Identity bị đình chỉ hoặc thu hồi không được giao dịch
+            if identity.Status != identitytypes.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+                ctx.Logger().Info("❌ REJECTING TRANSACTION - IDENTITY NOT ACTIVE",
+                    "address", signer.String(),
+                    "status", identity.Status.String(),
+                    "msgType", msgType,
+                    "msgIndex", i,
+                    "signerIndex", j)
+                return ctx, errorsmod.Wrapf(inactiveIdentityError(identity.Status), identitytypes.RejectionFormat, signer.String(), i, msgType)
             }
 
             // Log identity found
             ctx.Logger().Info("✅ IDENTITY FOUND",
                 "address", signer.String(),
                 "msgType", msgType)
+
+            // Cấp độ danh tính tối thiểu mà policy yêu cầu cho loại message
+            if minLevel := policy.LevelFor(msgType); identity.Level < minLevel {
+                ctx.Logger().Info("❌ REJECTING TRANSACTION - IDENTITY LEVEL TOO LOW",
+                    "address", signer.String(),
+                    "level", identity.Level.String(),
+                    "requiredLevel", minLevel.String(),
+                    "msgType", msgType,
+                    "msgIndex", i,
+                    "signerIndex", j)
+                return ctx, errorsmod.Wrapf(identitytypes.ErrIdentityLevelTooLow,
+                    identitytypes.RejectionFormat+" "+identitytypes.AttributeKeyLevel+"=%s "+identitytypes.AttributeKeyRequiredLevel+"=%s",
+                    signer.String(), i, msgType, identity.Level, minLevel)
+            }
+
+            // Thuộc tính đã chứng minh bằng ZK proof mà policy yêu cầu
+            for _, attribute := range policy.AttributesFor(msgType) {
+                if !d.IdentityKeeper.HasAttribute(ctx, signer.String(), attribute) {
+                    ctx.Logger().Info("❌ REJECTING TRANSACTION - MISSING ATTRIBUTE",
+                        "address", signer.String(),
+                        "attribute", attribute,
+                        "msgType", msgType,
+                        "msgIndex", i,
+                        "signerIndex", j)
+                    return ctx, errorsmod.Wrapf(identitytypes.ErrMissingAttribute, identitytypes.RejectionFormat+" "+identitytypes.AttributeKeyAttribute+"=%s", signer.String(), i, msgType, attribute)
+                }
+            }
+
+            emitIdentityCheck(ctx, identitytypes.AttributeValueRoleSigner, signer.String(), i, msgType)
+        }
+
+        if rule == identitytypes.GatingRule_GATING_RULE_SIGNER_ONLY {
+            continue
         }
 
         // THÊM: Kiểm tra TẤT CẢ người nhận trong mọi loại giao dịch
-        recipients := d.extractRecipients(ctx, msg, msgType)
+        // Message lồng trong MsgExec / proposal chỉ bị kiểm tra người nhận
+        // khi rule của chính nó yêu cầu
+        recipients, err := d.Recipients.Recipients(msg, func(inner sdk.Msg) bool {
+            return policy.RuleFor(sdk.MsgTypeURL(inner)) == identitytypes.GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
+        })
+        if err != nil {
+            ctx.Logger().Info("❌ REJECTING TRANSACTION - CANNOT EXTRACT RECIPIENTS", "msgType", msgType, "error", err.Error())
+            return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientUnresolvable, "%s=%d %s=%s: %s",
+                identitytypes.AttributeKeyMsgIndex, i, identitytypes.AttributeKeyMsgType, msgType, err)
+        }
+        if len(recipients) == 0 {
+            ctx.Logger().Info("ℹ️ NO RECIPIENTS TO CHECK", "msgType", msgType)
+        }
         for r, recipientAddr := range recipients {
             ctx.Logger().Info("🔍 CHECKING RECIPIENT", "index", r, "address", recipientAddr, "msgType", msgType)
 
-            // Check if recipient has registered identity
-            _, found := d.IdentityKeeper.GetIdentity(ctx, recipientAddr)
+            if policy.IsExempt(recipientAddr, d.ModuleAccounts) {
+                ctx.Logger().Info("✅ RECIPIENT EXEMPT", "recipient", recipientAddr, "msgType", msgType)
+                continue
+            }
 
+            // Check if recipient has an active identity
+            recipientIdentity, found := d.IdentityKeeper.GetIdentity(ctx, recipientAddr)
             if !found {
                 ctx.Logger().Info("❌ REJECTING TRANSACTION - RECIPIENT NO IDENTITY",
                     "recipient", recipientAddr,
                     "msgType", msgType,
                     "index", r)
-                return ctx, fmt.Errorf("NGƯỜI NHẬN CHƯA ĐĂNG KÝ DANH TÍNH: %s", recipientAddr)
+                return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientNotRegistered, identitytypes.RejectionFormat, recipientAddr, i, msgType)
+            }
+            if recipientIdentity.Status != identitytypes.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+                ctx.Logger().Info("❌ REJECTING TRANSACTION - RECIPIENT IDENTITY NOT ACTIVE",
+                    "recipient", recipientAddr,
+                    "status", recipientIdentity.Status.String(),
+                    "msgType", msgType,
+                    "index", r)
+                return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientNotActive, identitytypes.RejectionFormat, recipientAddr, i, msgType)
             }
 
             ctx.Logger().Info("✅ RECIPIENT IDENTITY FOUND",
                 "recipient", recipientAddr,
                 "msgType", msgType)
+            emitIdentityCheck(ctx, identitytypes.AttributeValueRoleRecipient, recipientAddr, i, msgType)
         }
     }
 
@@ -185,59 +288,24 @@ func (d IdentityVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
     return next(ctx, tx, simulate)
 }
 
-// extractRecipients extracts all recipient addresses from any message type
-func (d IdentityVerificationDecorator) extractRecipients(ctx sdk.Context, msg sdk.Msg, msgType string) []string {
-    var recipients []string
-
-    switch m := msg.(type) {
-    case *banktypes.MsgSend:
-        recipients = append(recipients, m.ToAddress)
-    case *banktypes.MsgMultiSend:
-        for _, output := range m.Outputs {
-            recipients = append(recipients, output.Address)
-        }
-    default:
-        // Có thể thêm logic để extract recipients từ các module khác
-        // Ví dụ: staking delegation, governance, etc.
-        ctx.Logger().Info("ℹ️ NO RECIPIENTS TO CHECK", "msgType", msgType)
+// inactiveIdentityError returns the gating error for an identity that exists
+// but is neither active nor pending
+func inactiveIdentityError(status identitytypes.IdentityStatus) error {
+    if status == identitytypes.IdentityStatus_IDENTITY_STATUS_REVOKED {
+        return identitytypes.ErrIdentityRevoked
     }
-
-    return recipients
+    return identitytypes.ErrIdentitySuspended
 }
 
-// isIdentityModuleMsg checks if the message type belongs to identity module
-func isIdentityModuleMsg(msgType string) bool {
-    identityMsgTypes := map[string]bool{
-        "/Nexelra.identity.MsgCreateIdentity": true,
-        "/Nexelra.identity.MsgUpdateParams":   true,
-    }
-    return identityMsgTypes[msgType]
-}
-
-// extractCreatorFromMessage extracts Creator field from any message using reflection
-func extractCreatorFromMessage(msg sdk.Msg) string {
-    msgValue := reflect.ValueOf(msg)
-    if msgValue.Kind() == reflect.Ptr {
-        msgValue = msgValue.Elem()
-    }
-
-    if msgValue.Kind() == reflect.Struct {
-        // Tìm field "Creator"
-        creatorField := msgValue.FieldByName("Creator")
-        if creatorField.IsValid() && creatorField.Kind() == reflect.String {
-            return creatorField.String()
-        }
-
-        // Backup: Tìm field "Signer" hoặc "From"
-        signerField := msgValue.FieldByName("Signer")
-        if signerField.IsValid() && signerField.Kind() == reflect.String {
-            return signerField.String()
-        }
-
-        fromField := msgValue.FieldByName("FromAddress")
-        if fromField.IsValid() && fromField.Kind() == reflect.String {
-            return fromField.String()
-        }
-    }
-    return ""
+// emitIdentityCheck records that address passed the identity gate for the
+// message at msgIndex. A rejected transaction drops its ante events, the
+// rejection is carried by the error code and log instead.
+func emitIdentityCheck(ctx sdk.Context, role, address string, msgIndex int, msgType string) {
+    ctx.EventManager().EmitEvent(sdk.NewEvent(
+        identitytypes.EventTypeIdentityCheck,
+        sdk.NewAttribute(identitytypes.AttributeKeyRole, role),
+        sdk.NewAttribute(identitytypes.AttributeKeyAddress, address),
+        sdk.NewAttribute(identitytypes.AttributeKeyMsgIndex, strconv.Itoa(msgIndex)),
+        sdk.NewAttribute(identitytypes.AttributeKeyMsgType, msgType),
+    ))
 }
@@ -0,0 +1,506 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"Nexelra/app/ante"
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// mockTx is the minimal sdk.Tx the identity decorator needs
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestIdentityVerificationDecoratorPolicy(t *testing.T) {
+	active := sample.AccAddress()
+	pending := sample.AccAddress()
+	suspended := sample.AccAddress()
+	revoked := sample.AccAddress()
+	stranger := sample.AccAddress()
+	moduleAccount := authtypes.NewModuleAddress("distribution").String()
+
+	send := func(from, to string) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), sdk.NewCoins())
+	}
+	exec := func(grantee string, msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(grantee), msgs)
+		return &msg
+	}
+	signerOnly := func(p *identitytypes.GatingPolicy) {
+		p.MsgRules = append(p.MsgRules, identitytypes.MsgGatingRule{
+			MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			Rule:       identitytypes.GatingRule_GATING_RULE_SIGNER_ONLY,
+		})
+	}
+
+	tests := []struct {
+		desc   string
+		msg    sdk.Msg
+		policy func(*identitytypes.GatingPolicy)
+		err    error
+	}{
+		{
+			desc: "active signer and recipient",
+			msg:  send(active, active),
+		},
+		{
+			desc: "recipient without identity",
+			msg:  send(active, stranger),
+			err:  identitytypes.ErrRecipientNotRegistered,
+		},
+		{
+			desc: "signer pending attestation",
+			msg:  send(pending, active),
+			err:  identitytypes.ErrIdentityPending,
+		},
+		{
+			desc:   "signer-only rule skips recipient",
+			msg:    send(active, stranger),
+			policy: signerOnly,
+		},
+		{
+			desc:   "signer-only rule still checks signer",
+			msg:    send(stranger, active),
+			policy: signerOnly,
+			err:    identitytypes.ErrSignerNotRegistered,
+		},
+		{
+			desc: "exempt message",
+			msg:  identitytypes.NewMsgCreateIdentity(stranger, "", identitytypes.DefaultHashScheme),
+		},
+		{
+			desc: "exempt rule removed",
+			msg:  identitytypes.NewMsgCreateIdentity(stranger, "", identitytypes.DefaultHashScheme),
+			policy: func(p *identitytypes.GatingPolicy) {
+				p.MsgRules = nil
+			},
+			err: identitytypes.ErrSignerNotRegistered,
+		},
+		{
+			desc: "module account recipient",
+			msg:  send(active, moduleAccount),
+		},
+		{
+			desc: "module account exemption disabled",
+			msg:  send(active, moduleAccount),
+			policy: func(p *identitytypes.GatingPolicy) {
+				p.ExemptModuleAccounts = false
+			},
+			err: identitytypes.ErrRecipientNotRegistered,
+		},
+		{
+			desc: "signers resolved from the msg signer option",
+			msg:  stakingtypes.NewMsgDelegate(active, sdk.ValAddress(sdk.MustAccAddressFromBech32(active)).String(), sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			desc: "authz exec to active recipient",
+			msg:  exec(active, send(pending, active)),
+		},
+		{
+			desc: "authz exec to recipient without identity",
+			msg:  exec(active, send(pending, stranger)),
+			err:  identitytypes.ErrRecipientNotRegistered,
+		},
+		{
+			desc: "suspended signer",
+			msg:  send(suspended, active),
+			err:  identitytypes.ErrIdentitySuspended,
+		},
+		{
+			desc: "revoked signer",
+			msg:  send(revoked, active),
+			err:  identitytypes.ErrIdentityRevoked,
+		},
+		{
+			desc: "recipient pending attestation",
+			msg:  send(active, pending),
+			err:  identitytypes.ErrRecipientNotActive,
+		},
+		{
+			desc: "malformed signer",
+			msg:  &banktypes.MsgSend{FromAddress: "not-an-address", ToAddress: active},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc: "exempt address",
+			msg:  send(stranger, active),
+			policy: func(p *identitytypes.GatingPolicy) {
+				p.ExemptAddresses = []string{stranger}
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			ctx = ctx.WithBlockHeight(1)
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: active, IdHash: "h1"}))
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: pending, IdHash: "h2", Status: identitytypes.IdentityStatus_IDENTITY_STATUS_PENDING}))
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: suspended, IdHash: "h3", Status: identitytypes.IdentityStatus_IDENTITY_STATUS_SUSPENDED}))
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: revoked, IdHash: "h4", Status: identitytypes.IdentityStatus_IDENTITY_STATUS_REVOKED}))
+
+			params := k.GetParams(ctx)
+			if tc.policy != nil {
+				tc.policy(&params.GatingPolicy)
+			}
+			require.NoError(t, k.SetParams(ctx, params))
+
+			decorator := ante.NewIdentityVerificationDecorator(moduletestutil.MakeTestEncodingConfig().Codec, k, map[string]bool{moduleAccount: true}, ante.DefaultRecipientRegistry())
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{tc.msg}}, false, next)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestIdentityVerificationDecoratorReporting(t *testing.T) {
+	active := sample.AccAddress()
+	stranger := sample.AccAddress()
+
+	k, ctx := keepertest.IdentityKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: active, IdHash: "h1"}))
+
+	decorator := ante.NewIdentityVerificationDecorator(moduletestutil.MakeTestEncodingConfig().Codec, k, nil, ante.DefaultRecipientRegistry())
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	send := func(from, to string) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), sdk.NewCoins())
+	}
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	t.Run("passed checks are emitted as events", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{send(active, active)}}, false, next)
+		require.NoError(t, err)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+		for i, role := range []string{identitytypes.AttributeValueRoleSigner, identitytypes.AttributeValueRoleRecipient} {
+			require.Equal(t, identitytypes.EventTypeIdentityCheck, events[i].Type)
+			require.Equal(t, []sdk.Attribute{
+				sdk.NewAttribute(identitytypes.AttributeKeyRole, role),
+				sdk.NewAttribute(identitytypes.AttributeKeyAddress, active),
+				sdk.NewAttribute(identitytypes.AttributeKeyMsgIndex, "0"),
+				sdk.NewAttribute(identitytypes.AttributeKeyMsgType, sendType),
+			}, attributes(events[i]))
+		}
+	})
+
+	t.Run("rejections carry address and msg index", func(t *testing.T) {
+		_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{send(active, active), send(active, stranger)}}, false, next)
+		require.ErrorIs(t, err, identitytypes.ErrRecipientNotRegistered)
+
+		codespace, code, log := errorsmod.ABCIInfo(err, false)
+		require.Equal(t, identitytypes.ModuleName, codespace)
+		require.Equal(t, identitytypes.ErrRecipientNotRegistered.ABCICode(), code)
+		require.Contains(t, log, "address="+stranger+" msg_index=1 msg_type="+sendType)
+	})
+}
+
+func TestIdentityVerificationDecoratorAttributes(t *testing.T) {
+	adult := sample.AccAddress()
+	minor := sample.AccAddress()
+	recipient := sample.AccAddress()
+	now := time.Unix(1_700_000_000, 0)
+
+	send := func(from string) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins())
+	}
+
+	tests := []struct {
+		desc  string
+		setup func(sdk.Context, keeper.Keeper)
+		msg   sdk.Msg
+		err   error
+	}{
+		{
+			desc: "signer with the attribute",
+			msg:  send(adult),
+		},
+		{
+			desc: "signer without the attribute",
+			msg:  send(minor),
+			err:  identitytypes.ErrMissingAttribute,
+		},
+		{
+			desc: "recipients need no attribute",
+			msg:  banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(adult), sdk.MustAccAddressFromBech32(minor), sdk.NewCoins()),
+		},
+		{
+			desc: "messages without attribute rule",
+			msg:  stakingtypes.NewMsgDelegate(minor, sdk.ValAddress(sdk.MustAccAddressFromBech32(minor)).String(), sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			desc: "expired flag",
+			setup: func(ctx sdk.Context, k keeper.Keeper) {
+				flag, _ := k.GetAttributeFlag(ctx, adult, "age-over-18")
+				flag.ExpiresAt = now.Unix()
+				require.NoError(t, k.SetAttributeFlag(ctx, flag))
+			},
+			msg: send(adult),
+			err: identitytypes.ErrMissingAttribute,
+		},
+		{
+			desc: "commitment changed since the proof",
+			setup: func(ctx sdk.Context, k keeper.Keeper) {
+				identity, _ := k.GetIdentity(ctx, adult)
+				identity.IdHash = "h9"
+				require.NoError(t, k.SetIdentity(ctx, identity))
+			},
+			msg: send(adult),
+			err: identitytypes.ErrMissingAttribute,
+		},
+		{
+			desc: "circuit removed",
+			setup: func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.RemoveAttributeCircuit(ctx, "age-over-18"))
+			},
+			msg: send(adult),
+			err: identitytypes.ErrMissingAttribute,
+		},
+		{
+			desc: "circuit replaced",
+			setup: func(ctx sdk.Context, k keeper.Keeper) {
+				require.NoError(t, k.SetAttributeCircuit(ctx, identitytypes.AttributeCircuit{Attribute: "age-over-18", RegisteredHeight: 2}))
+			},
+			msg: send(adult),
+			err: identitytypes.ErrMissingAttribute,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+			for i, address := range []string{adult, minor, recipient} {
+				require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: address, IdHash: fmt.Sprintf("h%d", i)}))
+			}
+			require.NoError(t, k.SetAttributeCircuit(ctx, identitytypes.AttributeCircuit{Attribute: "age-over-18"}))
+			require.NoError(t, k.SetAttributeFlag(ctx, identitytypes.AttributeFlag{
+				Address:      adult,
+				Attribute:    "age-over-18",
+				IdHash:       "h0",
+				ProvenHeight: 2,
+				ExpiresAt:    now.Add(time.Hour).Unix(),
+			}))
+
+			params := k.GetParams(ctx)
+			params.GatingPolicy.AttributeRules = []identitytypes.MsgAttributeRule{
+				{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Attributes: []string{"age-over-18"}},
+			}
+			require.NoError(t, k.SetParams(ctx, params))
+			if tc.setup != nil {
+				tc.setup(ctx, k)
+			}
+
+			decorator := ante.NewIdentityVerificationDecorator(moduletestutil.MakeTestEncodingConfig().Codec, k, nil, ante.DefaultRecipientRegistry())
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{tc.msg}}, false, next)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				require.ErrorContains(t, err, identitytypes.AttributeKeyAttribute+"=age-over-18")
+			}
+		})
+	}
+}
+
+// TestIdentityVerificationDecoratorLevels runs the identity gate and the spend
+// limits together, as the ante handler does, on level rules and the
+// per-transaction limits of the levels.
+func TestIdentityVerificationDecoratorLevels(t *testing.T) {
+	unregistered := sample.AccAddress()
+	selfDeclared := sample.AccAddress()
+	verified := sample.AccAddress()
+	enhanced := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	send := func(from string, amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	transfer := func(from string, coin sdk.Coin) sdk.Msg {
+		return ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coin, from, "osmo1receiver", clienttypes.ZeroHeight(), 100, "")
+	}
+	exec := func(grantee string, msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(grantee), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+		log  string
+	}{
+		{
+			desc: "verified signer",
+			msgs: []sdk.Msg{send(verified, 1)},
+		},
+		{
+			desc: "enhanced signer",
+			msgs: []sdk.Msg{send(enhanced, 1)},
+		},
+		{
+			desc: "self-declared signer",
+			msgs: []sdk.Msg{send(selfDeclared, 1)},
+			err:  identitytypes.ErrIdentityLevelTooLow,
+			log:  "level=IDENTITY_LEVEL_SELF_DECLARED required_level=IDENTITY_LEVEL_VERIFIED",
+		},
+		{
+			desc: "self-declared signer of a message without level rule",
+			msgs: []sdk.Msg{transfer(selfDeclared, sdk.NewInt64Coin("stake", 10))},
+		},
+		{
+			desc: "over the self-declared limit",
+			msgs: []sdk.Msg{transfer(selfDeclared, sdk.NewInt64Coin("stake", 11))},
+			err:  identitytypes.ErrTransferLimitExceeded,
+			log:  "level=IDENTITY_LEVEL_SELF_DECLARED amount=11stake limit=10stake",
+		},
+		{
+			desc: "at the verified limit",
+			msgs: []sdk.Msg{send(verified, 60), transfer(verified, sdk.NewInt64Coin("stake", 40))},
+		},
+		{
+			desc: "over the verified limit across messages",
+			msgs: []sdk.Msg{send(verified, 60), transfer(verified, sdk.NewInt64Coin("stake", 41))},
+			err:  identitytypes.ErrTransferLimitExceeded,
+			log:  "msg_index=1",
+		},
+		{
+			desc: "denom without limit",
+			msgs: []sdk.Msg{transfer(verified, sdk.NewInt64Coin("uatom", 1000))},
+		},
+		{
+			desc: "level without limit",
+			msgs: []sdk.Msg{send(enhanced, 1000)},
+		},
+		{
+			// the granter's funds move, under the granter's limit
+			desc: "exec by a higher level grantee",
+			msgs: []sdk.Msg{exec(enhanced, send(verified, 101))},
+			err:  identitytypes.ErrTransferLimitExceeded,
+			log:  "address=" + verified,
+		},
+		{
+			desc: "exec of a granter without identity",
+			msgs: []sdk.Msg{exec(verified, send(unregistered, 10))},
+		},
+		{
+			// senders without identity are held to the self-declared limit
+			desc: "exec of a granter without identity over the self-declared limit",
+			msgs: []sdk.Msg{exec(verified, send(unregistered, 11))},
+			err:  identitytypes.ErrTransferLimitExceeded,
+			log:  "address=" + unregistered,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			ctx = ctx.WithBlockHeight(1)
+			verifier := sample.AccAddress()
+			for i, identity := range []identitytypes.Identity{
+				{Address: selfDeclared},
+				{Address: verified, Verifier: verifier, Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED},
+				{Address: enhanced, Verifier: verifier, Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_ENHANCED},
+				{Address: recipient},
+			} {
+				identity.IdHash = fmt.Sprintf("h%d", i)
+				require.NoError(t, k.SetIdentity(ctx, identity))
+			}
+
+			params := k.GetParams(ctx)
+			params.GatingPolicy.LevelRules = []identitytypes.MsgLevelRule{
+				{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MinLevel: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED},
+			}
+			params.SpendPolicy.Limits = []identitytypes.LevelSpendLimit{
+				{Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, MaxTxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				{Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED, MaxTxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			}
+			require.NoError(t, params.Validate())
+			require.NoError(t, k.SetParams(ctx, params))
+
+			handler := sdk.ChainAnteDecorators(
+				ante.NewIdentityVerificationDecorator(moduletestutil.MakeTestEncodingConfig().Codec, k, nil, ante.DefaultRecipientRegistry()),
+				ante.NewSpendLimitDecorator(k, nil, ante.DefaultRecipientRegistry()),
+			)
+			_, err := handler(ctx, mockTx{msgs: tc.msgs}, false)
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.log)
+			}
+		})
+	}
+}
+
+func attributes(event sdk.Event) []sdk.Attribute {
+	attrs := make([]sdk.Attribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		attrs[i] = sdk.NewAttribute(attr.Key, attr.Value)
+	}
+	return attrs
+}
+
+// FuzzIdentityVerificationDecorator feeds messages carrying arbitrary
+// addresses through the decorator, which must reject them with an error
+// rather than panic.
+func FuzzIdentityVerificationDecorator(f *testing.F) {
+	valid := sample.AccAddress()
+	f.Add(valid, valid)
+	f.Add("", "")
+	f.Add("not-an-address", valid)
+	f.Add(valid, "cosmosvaloper1invalid")
+	f.Add("cosmos1", "\x00\xff")
+
+	k, ctx := keepertest.IdentityKeeper(f)
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(f, k.SetIdentity(ctx, identitytypes.Identity{Address: valid, IdHash: "h1"}))
+	decorator := ante.NewIdentityVerificationDecorator(moduletestutil.MakeTestEncodingConfig().Codec, k, nil, ante.DefaultRecipientRegistry())
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	f.Fuzz(func(t *testing.T, from, to string) {
+		inner := &banktypes.MsgSend{FromAddress: from, ToAddress: to}
+		exec := &authz.MsgExec{Grantee: from}
+		if any, err := codectypes.NewAnyWithValue(inner); err == nil {
+			exec.Msgs = append(exec.Msgs, any)
+		}
+
+		msgs := []sdk.Msg{
+			inner,
+			&banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: from}},
+				Outputs: []banktypes.Output{{Address: to}},
+			},
+			&stakingtypes.MsgDelegate{DelegatorAddress: from, ValidatorAddress: to},
+			&stakingtypes.MsgBeginRedelegate{DelegatorAddress: from, ValidatorSrcAddress: to, ValidatorDstAddress: to},
+			&identitytypes.MsgAttestIdentity{Verifier: from, Address: to},
+			&ibctransfertypes.MsgTransfer{Sender: from, Receiver: to, TimeoutHeight: clienttypes.ZeroHeight()},
+			exec,
+		}
+		for _, msg := range msgs {
+			require.NotPanics(t, func() {
+				_, _ = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}}, false, next)
+			}, "%T", msg)
+		}
+	})
+}
@@ -0,0 +1,294 @@
+package ante
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// maxUnwrapDepth bounds how deep wrapper messages such as authz.MsgExec may
+// be nested inside each other.
+const maxUnwrapDepth = 5
+
+// RecipientExtractor returns the bech32 account addresses that receive funds
+// or rights from msg.
+type RecipientExtractor func(msg sdk.Msg) ([]string, error)
+
+// MsgUnwrapper returns the messages nested inside a wrapper message.
+type MsgUnwrapper func(msg sdk.Msg) ([]sdk.Msg, error)
+
+// Transfer is an amount of funds a message sends out of an account.
+type Transfer struct {
+	Sender string
+	Amount sdk.Coins
+}
+
+// TransferExtractor returns the funds msg sends out of their accounts.
+type TransferExtractor func(msg sdk.Msg) ([]Transfer, error)
+
+// RecipientRegistry maps message type URLs to the functions that find their
+// recipients and the funds they send. Messages without an entry have no
+// recipients and send nothing.
+type RecipientRegistry struct {
+	extractors map[string]RecipientExtractor
+	unwrappers map[string]MsgUnwrapper
+	transfers  map[string]TransferExtractor
+}
+
+// NewRecipientRegistry returns an empty RecipientRegistry.
+func NewRecipientRegistry() *RecipientRegistry {
+	return &RecipientRegistry{
+		extractors: make(map[string]RecipientExtractor),
+		unwrappers: make(map[string]MsgUnwrapper),
+		transfers:  make(map[string]TransferExtractor),
+	}
+}
+
+// RegisterExtractor sets the recipient extractor of msg's type.
+func (r *RecipientRegistry) RegisterExtractor(msg sdk.Msg, extractor RecipientExtractor) {
+	r.extractors[sdk.MsgTypeURL(msg)] = extractor
+}
+
+// RegisterUnwrapper marks msg's type as a wrapper whose nested messages are
+// checked in its place.
+func (r *RecipientRegistry) RegisterUnwrapper(msg sdk.Msg, unwrapper MsgUnwrapper) {
+	r.unwrappers[sdk.MsgTypeURL(msg)] = unwrapper
+}
+
+// RegisterTransferExtractor sets the transfer extractor of msg's type.
+func (r *RecipientRegistry) RegisterTransferExtractor(msg sdk.Msg, extractor TransferExtractor) {
+	r.transfers[sdk.MsgTypeURL(msg)] = extractor
+}
+
+// Recipients returns the recipients of msg. Nested messages of wrappers are
+// unwrapped recursively, and only those for which include returns true
+// contribute their recipients.
+func (r *RecipientRegistry) Recipients(msg sdk.Msg, include func(sdk.Msg) bool) ([]string, error) {
+	return r.recipients(msg, include, 0)
+}
+
+func (r *RecipientRegistry) recipients(msg sdk.Msg, include func(sdk.Msg) bool, depth int) ([]string, error) {
+	msgType := sdk.MsgTypeURL(msg)
+
+	if unwrap, ok := r.unwrappers[msgType]; ok {
+		if depth >= maxUnwrapDepth {
+			return nil, fmt.Errorf("%s nested deeper than %d messages", msgType, maxUnwrapDepth)
+		}
+
+		inner, err := unwrap(msg)
+		if err != nil {
+			return nil, fmt.Errorf("unwrap %s: %w", msgType, err)
+		}
+
+		var recipients []string
+		for _, m := range inner {
+			if !include(m) {
+				continue
+			}
+			rs, err := r.recipients(m, include, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			recipients = append(recipients, rs...)
+		}
+		return recipients, nil
+	}
+
+	if extract, ok := r.extractors[msgType]; ok {
+		return extract(msg)
+	}
+
+	return nil, nil
+}
+
+// Messages returns msg, or the messages nested inside it if msg is a
+// wrapper, recursively.
+func (r *RecipientRegistry) Messages(msg sdk.Msg) ([]sdk.Msg, error) {
+	return r.messages(msg, 0)
+}
+
+func (r *RecipientRegistry) messages(msg sdk.Msg, depth int) ([]sdk.Msg, error) {
+	msgType := sdk.MsgTypeURL(msg)
+
+	unwrap, ok := r.unwrappers[msgType]
+	if !ok {
+		return []sdk.Msg{msg}, nil
+	}
+	if depth >= maxUnwrapDepth {
+		return nil, fmt.Errorf("%s nested deeper than %d messages", msgType, maxUnwrapDepth)
+	}
+
+	inner, err := unwrap(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap %s: %w", msgType, err)
+	}
+
+	var msgs []sdk.Msg
+	for _, m := range inner {
+		ms, err := r.messages(m, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, ms...)
+	}
+	return msgs, nil
+}
+
+// Transfers returns the funds msg and the messages nested inside it send.
+func (r *RecipientRegistry) Transfers(msg sdk.Msg) ([]Transfer, error) {
+	msgs, err := r.Messages(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []Transfer
+	for _, m := range msgs {
+		extract, ok := r.transfers[sdk.MsgTypeURL(m)]
+		if !ok {
+			continue
+		}
+		ts, err := extract(m)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, ts...)
+	}
+	return transfers, nil
+}
+
+// DefaultRecipientRegistry returns a registry covering the SDK and IBC
+// messages of this app that move funds or rights to another account, and
+// the funds sent by bank, vesting and ICS-20 transfers.
+func DefaultRecipientRegistry() *RecipientRegistry {
+	r := NewRecipientRegistry()
+
+	// bank
+	r.RegisterExtractor(&banktypes.MsgSend{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*banktypes.MsgSend).ToAddress}, nil
+	})
+	r.RegisterExtractor(&banktypes.MsgMultiSend{}, func(msg sdk.Msg) ([]string, error) {
+		var recipients []string
+		for _, output := range msg.(*banktypes.MsgMultiSend).Outputs {
+			recipients = append(recipients, output.Address)
+		}
+		return recipients, nil
+	})
+
+	// staking: a delegation pays the validator's operator account
+	r.RegisterExtractor(&stakingtypes.MsgDelegate{}, func(msg sdk.Msg) ([]string, error) {
+		return operatorAccount(msg.(*stakingtypes.MsgDelegate).ValidatorAddress)
+	})
+	r.RegisterExtractor(&stakingtypes.MsgBeginRedelegate{}, func(msg sdk.Msg) ([]string, error) {
+		return operatorAccount(msg.(*stakingtypes.MsgBeginRedelegate).ValidatorDstAddress)
+	})
+
+	// distribution
+	r.RegisterExtractor(&distrtypes.MsgSetWithdrawAddress{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*distrtypes.MsgSetWithdrawAddress).WithdrawAddress}, nil
+	})
+	r.RegisterExtractor(&distrtypes.MsgFundCommunityPool{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{authtypes.NewModuleAddress(distrtypes.ModuleName).String()}, nil
+	})
+	r.RegisterExtractor(&distrtypes.MsgCommunityPoolSpend{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*distrtypes.MsgCommunityPoolSpend).Recipient}, nil
+	})
+	r.RegisterExtractor(&distrtypes.MsgDepositValidatorRewardsPool{}, func(msg sdk.Msg) ([]string, error) {
+		return operatorAccount(msg.(*distrtypes.MsgDepositValidatorRewardsPool).ValidatorAddress)
+	})
+
+	// vesting
+	r.RegisterExtractor(&vestingtypes.MsgCreateVestingAccount{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*vestingtypes.MsgCreateVestingAccount).ToAddress}, nil
+	})
+	r.RegisterExtractor(&vestingtypes.MsgCreatePermanentLockedAccount{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*vestingtypes.MsgCreatePermanentLockedAccount).ToAddress}, nil
+	})
+	r.RegisterExtractor(&vestingtypes.MsgCreatePeriodicVestingAccount{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*vestingtypes.MsgCreatePeriodicVestingAccount).ToAddress}, nil
+	})
+
+	// ibc transfer: a receiver on another chain cannot be looked up in this
+	// chain's registry, only one that is an account of this chain can
+	r.RegisterExtractor(&ibctransfertypes.MsgTransfer{}, func(msg sdk.Msg) ([]string, error) {
+		receiver := msg.(*ibctransfertypes.MsgTransfer).Receiver
+		if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+			return nil, nil
+		}
+		return []string{receiver}, nil
+	})
+
+	// nft
+	r.RegisterExtractor(&nft.MsgSend{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*nft.MsgSend).Receiver}, nil
+	})
+
+	// authz: the grantee receives the right to act for the granter
+	r.RegisterExtractor(&authz.MsgGrant{}, func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*authz.MsgGrant).Grantee}, nil
+	})
+
+	// transfers
+	r.RegisterTransferExtractor(&banktypes.MsgSend{}, func(msg sdk.Msg) ([]Transfer, error) {
+		m := msg.(*banktypes.MsgSend)
+		return []Transfer{{Sender: m.FromAddress, Amount: m.Amount}}, nil
+	})
+	r.RegisterTransferExtractor(&banktypes.MsgMultiSend{}, func(msg sdk.Msg) ([]Transfer, error) {
+		var transfers []Transfer
+		for _, input := range msg.(*banktypes.MsgMultiSend).Inputs {
+			transfers = append(transfers, Transfer{Sender: input.Address, Amount: input.Coins})
+		}
+		return transfers, nil
+	})
+	r.RegisterTransferExtractor(&vestingtypes.MsgCreateVestingAccount{}, func(msg sdk.Msg) ([]Transfer, error) {
+		m := msg.(*vestingtypes.MsgCreateVestingAccount)
+		return []Transfer{{Sender: m.FromAddress, Amount: m.Amount}}, nil
+	})
+	r.RegisterTransferExtractor(&vestingtypes.MsgCreatePermanentLockedAccount{}, func(msg sdk.Msg) ([]Transfer, error) {
+		m := msg.(*vestingtypes.MsgCreatePermanentLockedAccount)
+		return []Transfer{{Sender: m.FromAddress, Amount: m.Amount}}, nil
+	})
+	r.RegisterTransferExtractor(&vestingtypes.MsgCreatePeriodicVestingAccount{}, func(msg sdk.Msg) ([]Transfer, error) {
+		m := msg.(*vestingtypes.MsgCreatePeriodicVestingAccount)
+		var amount sdk.Coins
+		for _, period := range m.VestingPeriods {
+			amount = amount.Add(period.Amount...)
+		}
+		return []Transfer{{Sender: m.FromAddress, Amount: amount}}, nil
+	})
+	r.RegisterTransferExtractor(&ibctransfertypes.MsgTransfer{}, func(msg sdk.Msg) ([]Transfer, error) {
+		m := msg.(*ibctransfertypes.MsgTransfer)
+		return []Transfer{{Sender: m.Sender, Amount: sdk.NewCoins(m.Token)}}, nil
+	})
+
+	// wrappers
+	r.RegisterUnwrapper(&authz.MsgExec{}, func(msg sdk.Msg) ([]sdk.Msg, error) {
+		return msg.(*authz.MsgExec).GetMessages()
+	})
+	r.RegisterUnwrapper(&group.MsgSubmitProposal{}, func(msg sdk.Msg) ([]sdk.Msg, error) {
+		return msg.(*group.MsgSubmitProposal).GetMsgs()
+	})
+	r.RegisterUnwrapper(&govv1.MsgSubmitProposal{}, func(msg sdk.Msg) ([]sdk.Msg, error) {
+		return msg.(*govv1.MsgSubmitProposal).GetMsgs()
+	})
+
+	return r
+}
+
+// operatorAccount returns the account address operating a validator.
+func operatorAccount(valoper string) ([]string, error) {
+	valAddr, err := sdk.ValAddressFromBech32(valoper)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator address %q: %w", valoper, err)
+	}
+	return []string{sdk.AccAddress(valAddr).String()}, nil
+}
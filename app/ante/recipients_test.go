@@ -0,0 +1,255 @@
+package ante_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app/ante"
+	"Nexelra/testutil/sample"
+)
+
+func TestRecipientRegistry(t *testing.T) {
+	from := sample.AccAddress()
+	to := sample.AccAddress()
+	other := sample.AccAddress()
+	operator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	valoper := sdk.ValAddress(operator).String()
+	coin := sdk.NewInt64Coin("stake", 1)
+	coins := sdk.NewCoins(coin)
+
+	send := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), coins)
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(from), msgs)
+		return &msg
+	}
+
+	groupProposal, err := group.NewMsgSubmitProposal(from, []string{from}, []sdk.Msg{send}, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+	require.NoError(t, err)
+	govProposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{
+		&distrtypes.MsgCommunityPoolSpend{Authority: authtypes.NewModuleAddress("gov").String(), Recipient: other, Amount: coins},
+	}, coins, from, "", "title", "summary", false)
+	require.NoError(t, err)
+	grant, err := authz.NewMsgGrant(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		msg        sdk.Msg
+		recipients []string
+		err        bool
+	}{
+		{
+			desc:       "bank send",
+			msg:        send,
+			recipients: []string{to},
+		},
+		{
+			desc: "bank multi-send",
+			msg: &banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: from, Coins: coins}},
+				Outputs: []banktypes.Output{{Address: to, Coins: coins}, {Address: other, Coins: coins}},
+			},
+			recipients: []string{to, other},
+		},
+		{
+			desc:       "staking delegate",
+			msg:        stakingtypes.NewMsgDelegate(from, valoper, coin),
+			recipients: []string{operator.String()},
+		},
+		{
+			desc:       "staking redelegate",
+			msg:        stakingtypes.NewMsgBeginRedelegate(from, sdk.ValAddress(sdk.MustAccAddressFromBech32(other)).String(), valoper, coin),
+			recipients: []string{operator.String()},
+		},
+		{
+			desc: "staking delegate to invalid validator",
+			msg:  stakingtypes.NewMsgDelegate(from, "invalid_valoper", coin),
+			err:  true,
+		},
+		{
+			desc:       "staking undelegate",
+			msg:        stakingtypes.NewMsgUndelegate(from, valoper, coin),
+			recipients: nil,
+		},
+		{
+			desc:       "distribution set withdraw address",
+			msg:        distrtypes.NewMsgSetWithdrawAddress(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to)),
+			recipients: []string{to},
+		},
+		{
+			desc:       "distribution fund community pool",
+			msg:        distrtypes.NewMsgFundCommunityPool(coins, from),
+			recipients: []string{authtypes.NewModuleAddress(distrtypes.ModuleName).String()},
+		},
+		{
+			desc:       "distribution deposit validator rewards pool",
+			msg:        distrtypes.NewMsgDepositValidatorRewardsPool(from, valoper, coins),
+			recipients: []string{operator.String()},
+		},
+		{
+			desc:       "vesting account",
+			msg:        vestingtypes.NewMsgCreateVestingAccount(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), coins, 100, false),
+			recipients: []string{to},
+		},
+		{
+			desc:       "permanent locked account",
+			msg:        vestingtypes.NewMsgCreatePermanentLockedAccount(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), coins),
+			recipients: []string{to},
+		},
+		{
+			desc:       "periodic vesting account",
+			msg:        vestingtypes.NewMsgCreatePeriodicVestingAccount(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(to), 0, nil),
+			recipients: []string{to},
+		},
+		{
+			desc:       "ibc transfer to local account",
+			msg:        ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coin, from, to, clienttypes.ZeroHeight(), 100, ""),
+			recipients: []string{to},
+		},
+		{
+			desc:       "ibc transfer to foreign account",
+			msg:        ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coin, from, "osmo1receiver", clienttypes.ZeroHeight(), 100, ""),
+			recipients: nil,
+		},
+		{
+			desc:       "nft send",
+			msg:        &nft.MsgSend{ClassId: "class", Id: "id", Sender: from, Receiver: to},
+			recipients: []string{to},
+		},
+		{
+			desc:       "authz grant",
+			msg:        grant,
+			recipients: []string{to},
+		},
+		{
+			desc:       "authz exec",
+			msg:        exec(send, distrtypes.NewMsgSetWithdrawAddress(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(other))),
+			recipients: []string{to, other},
+		},
+		{
+			desc:       "nested authz exec",
+			msg:        exec(exec(send)),
+			recipients: []string{to},
+		},
+		{
+			desc: "authz exec nested too deep",
+			msg:  exec(exec(exec(exec(exec(exec(send)))))),
+			err:  true,
+		},
+		{
+			desc:       "group proposal",
+			msg:        groupProposal,
+			recipients: []string{to},
+		},
+		{
+			desc:       "gov proposal",
+			msg:        govProposal,
+			recipients: []string{other},
+		},
+	}
+
+	registry := ante.DefaultRecipientRegistry()
+	includeAll := func(sdk.Msg) bool { return true }
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			recipients, err := registry.Recipients(tc.msg, includeAll)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.recipients, recipients)
+		})
+	}
+}
+
+func TestRecipientRegistryInclude(t *testing.T) {
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sample.AccAddress()
+	withdraw := sample.AccAddress()
+
+	msg := authz.NewMsgExec(from, []sdk.Msg{
+		banktypes.NewMsgSend(from, sdk.MustAccAddressFromBech32(to), sdk.NewCoins()),
+		distrtypes.NewMsgSetWithdrawAddress(from, sdk.MustAccAddressFromBech32(withdraw)),
+	})
+
+	// nested messages excluded by the caller contribute no recipients
+	recipients, err := ante.DefaultRecipientRegistry().Recipients(&msg, func(inner sdk.Msg) bool {
+		return sdk.MsgTypeURL(inner) != sdk.MsgTypeURL(&banktypes.MsgSend{})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{withdraw}, recipients)
+}
+
+func TestRecipientRegistryTransfers(t *testing.T) {
+	granter := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	grantee := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+
+	send := banktypes.NewMsgSend(granter, to, coins)
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{send, distrtypes.NewMsgSetWithdrawAddress(granter, to)})
+	periods := vestingtypes.Periods{{Length: 10, Amount: coins}, {Length: 10, Amount: coins}}
+
+	tests := []struct {
+		desc      string
+		msg       sdk.Msg
+		transfers []ante.Transfer
+	}{
+		{
+			desc:      "bank send",
+			msg:       send,
+			transfers: []ante.Transfer{{Sender: granter.String(), Amount: coins}},
+		},
+		{
+			desc: "bank multi-send",
+			msg: &banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{{Address: granter.String(), Coins: coins}},
+				Outputs: []banktypes.Output{{Address: to.String(), Coins: coins}},
+			},
+			transfers: []ante.Transfer{{Sender: granter.String(), Amount: coins}},
+		},
+		{
+			desc:      "periodic vesting account",
+			msg:       vestingtypes.NewMsgCreatePeriodicVestingAccount(granter, to, 0, periods),
+			transfers: []ante.Transfer{{Sender: granter.String(), Amount: coins.Add(coins...)}},
+		},
+		{
+			desc:      "ibc transfer",
+			msg:       ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coins[0], granter.String(), "osmo1receiver", clienttypes.ZeroHeight(), 100, ""),
+			transfers: []ante.Transfer{{Sender: granter.String(), Amount: coins}},
+		},
+		{
+			// the granter's funds move, not the grantee's
+			desc:      "authz exec",
+			msg:       &exec,
+			transfers: []ante.Transfer{{Sender: granter.String(), Amount: coins}},
+		},
+		{
+			desc: "staking delegate",
+			msg:  stakingtypes.NewMsgDelegate(granter.String(), sdk.ValAddress(to).String(), coins[0]),
+		},
+	}
+
+	registry := ante.DefaultRecipientRegistry()
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			transfers, err := registry.Transfers(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.transfers, transfers)
+		})
+	}
+}
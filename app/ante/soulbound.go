@@ -0,0 +1,42 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// SoulboundNFTDecorator rejects transactions sending an identity NFT, which
+// stays with the holder it was issued to until its identity is revoked.
+// Sends nested in wrapper messages such as authz.MsgExec are rejected too.
+type SoulboundNFTDecorator struct {
+	Recipients *RecipientRegistry
+}
+
+// NewSoulboundNFTDecorator creates a new SoulboundNFTDecorator
+func NewSoulboundNFTDecorator(recipients *RecipientRegistry) SoulboundNFTDecorator {
+	return SoulboundNFTDecorator{Recipients: recipients}
+}
+
+// AnteHandle rejects the transaction if one of its messages sends an
+// identity NFT.
+func (d SoulboundNFTDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for i, msg := range tx.GetMsgs() {
+		msgs, err := d.Recipients.Messages(msg)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientUnresolvable, "%s=%d %s=%s: %s",
+				identitytypes.AttributeKeyMsgIndex, i, identitytypes.AttributeKeyMsgType, sdk.MsgTypeURL(msg), err)
+		}
+
+		for _, m := range msgs {
+			send, ok := m.(*nft.MsgSend)
+			if ok && send.ClassId == identitytypes.NFTClassID {
+				return ctx, errorsmod.Wrapf(identitytypes.ErrSoulboundNFT, identitytypes.RejectionFormat, send.Sender, i, sdk.MsgTypeURL(msg))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app/ante"
+	"Nexelra/testutil/sample"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+func TestSoulboundNFTDecorator(t *testing.T) {
+	holder := sample.AccAddress()
+	send := func(classID string) sdk.Msg {
+		return &nft.MsgSend{ClassId: classID, Id: holder, Sender: holder, Receiver: sample.AccAddress()}
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			desc: "other class",
+			msgs: []sdk.Msg{send("artwork")},
+		},
+		{
+			desc: "identity class",
+			msgs: []sdk.Msg{send("artwork"), send(identitytypes.NFTClassID)},
+			err:  identitytypes.ErrSoulboundNFT,
+		},
+		{
+			desc: "identity class through authz",
+			msgs: []sdk.Msg{exec(send(identitytypes.NFTClassID))},
+			err:  identitytypes.ErrSoulboundNFT,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			decorator := ante.NewSoulboundNFTDecorator(ante.DefaultRecipientRegistry())
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tc.msgs}, false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
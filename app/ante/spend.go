@@ -0,0 +1,166 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	identitykeeper "Nexelra/x/identity/keeper"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// SpendLimitDecorator enforces the spend policy of the identity params: the
+// bank and ICS-20 transfers of a transaction, including those nested in
+// wrappers, are added to the spend of the sender's identity over the rolling
+// window, and the transaction is rejected if that takes the identity over the
+// amount or transaction count limit of its level, or if the transaction alone
+// sends more than the per-transaction amount of that level. The spend is
+// keyed by the CCCD key of the identity, its verifier-attested CCCD tag, so
+// all the addresses of one CCCD share it.
+//
+// The spend is recorded in the ante handler, so a transaction whose messages
+// fail still counts, as its fees do. Senders without an identity are left to
+// the identity gate, except that what they send in the transaction is held to
+// the per-transaction amount of SELF_DECLARED. Exempt messages and exempt
+// senders are not counted.
+// Nothing is tracked while the policy sets no limits.
+type SpendLimitDecorator struct {
+	IdentityKeeper identitykeeper.Keeper
+	ModuleAccounts map[string]bool
+	Recipients     *RecipientRegistry
+}
+
+// NewSpendLimitDecorator creates a new SpendLimitDecorator
+func NewSpendLimitDecorator(keeper identitykeeper.Keeper, moduleAccounts map[string]bool, recipients *RecipientRegistry) SpendLimitDecorator {
+	return SpendLimitDecorator{
+		IdentityKeeper: keeper,
+		ModuleAccounts: moduleAccounts,
+		Recipients:     recipients,
+	}
+}
+
+// pendingSpend is what one identity sends in the transaction being checked.
+type pendingSpend struct {
+	identity identitytypes.Identity
+	level    identitytypes.IdentityLevel
+	limit    identitytypes.LevelSpendLimit
+	limited  bool
+	spent    sdk.Coins
+	amount   sdk.Coins
+}
+
+// AnteHandle checks and records the spend of the identities sending funds in tx.
+func (d SpendLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.IdentityKeeper.GetParams(ctx)
+	if len(params.SpendPolicy.Limits) == 0 {
+		return next(ctx, tx, simulate)
+	}
+	gating := params.GatingPolicy
+
+	// Gom tổng số tiền gửi của từng định danh trong giao dịch, theo thứ tự
+	// xuất hiện để việc ghi nhận là tất định
+	spends := make(map[string]*pendingSpend)
+	var order []string
+	unregistered := make(map[string]sdk.Coins)
+	for i, msg := range tx.GetMsgs() {
+		msgType := sdk.MsgTypeURL(msg)
+		if gating.RuleFor(msgType) == identitytypes.GatingRule_GATING_RULE_EXEMPT {
+			continue
+		}
+
+		transfers, err := d.Recipients.Transfers(msg)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientUnresolvable, "%s=%d %s=%s: %s",
+				identitytypes.AttributeKeyMsgIndex, i, identitytypes.AttributeKeyMsgType, msgType, err)
+		}
+
+		for _, transfer := range transfers {
+			if gating.IsExempt(transfer.Sender, d.ModuleAccounts) {
+				continue
+			}
+			identity, found := d.IdentityKeeper.GetIdentity(ctx, transfer.Sender)
+			if !found {
+				// Không có định danh để ghi nhận theo cửa sổ, chỉ áp hạn mức
+				// mỗi giao dịch của SELF_DECLARED
+				unregistered[transfer.Sender] = unregistered[transfer.Sender].Add(transfer.Amount...)
+				level := identitytypes.GatedLevel(identity, found)
+				if limit, ok := params.SpendPolicy.LimitFor(level); ok && limit.ExceedsTxAmount(unregistered[transfer.Sender]) {
+					return ctx, transferLimitError(ctx, transfer.Sender, level, unregistered[transfer.Sender], limit, msgType, i)
+				}
+				continue
+			}
+
+			spend, ok := spends[identity.CccdKey()]
+			if !ok {
+				spend = &pendingSpend{identity: identity, level: identitytypes.GatedLevel(identity, found)}
+				spend.limit, spend.limited = params.SpendPolicy.LimitFor(spend.level)
+				spends[identity.CccdKey()] = spend
+				order = append(order, identity.CccdKey())
+
+				// Giao dịch đang xét được tính là một giao dịch của định danh
+				var txCount uint64
+				spend.spent, txCount = d.IdentityKeeper.SpendUsage(ctx, identity)
+				if spend.limited && spend.limit.ExceedsTxCount(txCount+1) {
+					ctx.Logger().Info("❌ REJECTING TRANSACTION - SPEND TX COUNT EXCEEDED",
+						"address", transfer.Sender,
+						"level", spend.level.String(),
+						"tx_count", txCount+1,
+						"limit", spend.limit.MaxTxCount,
+						"msgType", msgType,
+						"msgIndex", i)
+					return ctx, errorsmod.Wrapf(identitytypes.ErrTxCountLimitExceeded,
+						identitytypes.RejectionFormat+" "+identitytypes.AttributeKeyLevel+"=%s "+identitytypes.AttributeKeyTxCount+"=%d "+identitytypes.AttributeKeyLimit+"=%d "+identitytypes.AttributeKeyWindow+"=%d",
+						transfer.Sender, i, msgType, spend.level, txCount+1, spend.limit.MaxTxCount, params.SpendPolicy.Window)
+				}
+			}
+
+			spend.amount = spend.amount.Add(transfer.Amount...)
+			if spend.limited && spend.limit.ExceedsTxAmount(spend.amount) {
+				return ctx, transferLimitError(ctx, transfer.Sender, spend.level, spend.amount, spend.limit, msgType, i)
+			}
+
+			total := spend.spent.Add(spend.amount...)
+			if !spend.limited || !spend.limit.ExceedsAmount(total) {
+				continue
+			}
+
+			ctx.Logger().Info("❌ REJECTING TRANSACTION - SPEND LIMIT EXCEEDED",
+				"address", transfer.Sender,
+				"level", spend.level.String(),
+				"amount", total.String(),
+				"limit", spend.limit.MaxAmount.String(),
+				"msgType", msgType,
+				"msgIndex", i)
+			return ctx, errorsmod.Wrapf(identitytypes.ErrSpendLimitExceeded,
+				identitytypes.RejectionFormat+" "+identitytypes.AttributeKeyLevel+"=%s "+identitytypes.AttributeKeyAmount+"=%s "+identitytypes.AttributeKeyLimit+"=%s "+identitytypes.AttributeKeyWindow+"=%d",
+				transfer.Sender, i, msgType, spend.level, total, spend.limit.MaxAmount, params.SpendPolicy.Window)
+		}
+	}
+
+	for _, cccdKey := range order {
+		spend := spends[cccdKey]
+		if err := d.IdentityKeeper.RecordSpend(ctx, spend.identity, spend.amount); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// transferLimitError logs and returns the rejection of a transaction in which
+// sender sends amount, over the per-transaction amount of limit.
+func transferLimitError(ctx sdk.Context, sender string, level identitytypes.IdentityLevel, amount sdk.Coins, limit identitytypes.LevelSpendLimit, msgType string, msgIndex int) error {
+	ctx.Logger().Info("❌ REJECTING TRANSACTION - TRANSFER LIMIT EXCEEDED",
+		"address", sender,
+		"level", level.String(),
+		"amount", amount.String(),
+		"limit", limit.MaxTxAmount.String(),
+		"msgType", msgType,
+		"msgIndex", msgIndex)
+	return errorsmod.Wrapf(identitytypes.ErrTransferLimitExceeded,
+		identitytypes.RejectionFormat+" "+identitytypes.AttributeKeyLevel+"=%s "+identitytypes.AttributeKeyAmount+"=%s "+identitytypes.AttributeKeyLimit+"=%s",
+		sender, msgIndex, msgType, level, amount, limit.MaxTxAmount)
+}
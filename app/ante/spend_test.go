@@ -0,0 +1,132 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app/ante"
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+func TestSpendLimitDecorator(t *testing.T) {
+	verified := sample.AccAddress()
+	// linked has its own salted commitment but was attested with the CCCD tag
+	// of verified, as a second address of the same CCCD would be
+	linked := sample.AccAddress()
+	enhanced := sample.AccAddress()
+	stranger := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	send := func(from string, coin sdk.Coin) sdk.Msg {
+		return banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(from), sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(coin))
+	}
+	transfer := func(from string, coin sdk.Coin) sdk.Msg {
+		return ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coin, from, "osmo1receiver", clienttypes.ZeroHeight(), 100, "")
+	}
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin("stake", amount) }
+
+	k, ctx := keepertest.IdentityKeeper(t)
+	verifier := sample.AccAddress()
+	for _, identity := range []identitytypes.Identity{
+		{Address: verified, IdHash: "h1", CccdTag: "t1", Verifier: verifier, Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED},
+		{Address: linked, IdHash: "h4", CccdTag: "t1", Verifier: verifier, Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED},
+		{Address: enhanced, IdHash: "h2", Verifier: verifier, Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_ENHANCED},
+		{Address: recipient, IdHash: "h3"},
+	} {
+		require.NoError(t, k.SetIdentity(ctx, identity))
+	}
+
+	params := k.GetParams(ctx)
+	params.SpendPolicy.Limits = []identitytypes.LevelSpendLimit{
+		{Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED, MaxAmount: sdk.NewCoins(stake(100)), MaxTxCount: 3},
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	decorator := ante.NewSpendLimitDecorator(k, nil, ante.DefaultRecipientRegistry())
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	// on the hour, so that buckets line up with the steps
+	start := time.Unix(1_700_000_000-1_700_000_000%3600, 0)
+
+	steps := []struct {
+		desc  string
+		hours time.Duration
+		msgs  []sdk.Msg
+		err   error
+		log   string
+	}{
+		{
+			desc: "within the limit",
+			msgs: []sdk.Msg{send(verified, stake(60))},
+		},
+		{
+			desc:  "over the amount within the window",
+			hours: 1,
+			msgs:  []sdk.Msg{send(verified, stake(41))},
+			err:   identitytypes.ErrSpendLimitExceeded,
+			log:   "level=IDENTITY_LEVEL_VERIFIED amount=101stake limit=100stake window=86400",
+		},
+		{
+			desc:  "up to the amount in one transaction of two messages",
+			hours: 1,
+			msgs:  []sdk.Msg{send(verified, stake(20)), transfer(verified, stake(20))},
+		},
+		{
+			desc:  "denom without limit",
+			hours: 2,
+			msgs:  []sdk.Msg{transfer(verified, sdk.NewInt64Coin("uatom", 1000))},
+		},
+		{
+			desc:  "level without limit",
+			hours: 2,
+			msgs:  []sdk.Msg{send(enhanced, stake(1000))},
+		},
+		{
+			desc:  "sender without identity",
+			hours: 2,
+			msgs:  []sdk.Msg{send(stranger, stake(1000))},
+		},
+		{
+			desc:  "over the transaction count",
+			hours: 3,
+			msgs:  []sdk.Msg{send(verified, stake(0))},
+			err:   identitytypes.ErrTxCountLimitExceeded,
+			log:   "tx_count=4 limit=3 window=86400",
+		},
+		{
+			// the first transaction left the window
+			desc:  "after the window rolled",
+			hours: 25,
+			msgs:  []sdk.Msg{send(verified, stake(60))},
+		},
+		{
+			desc:  "other address of the CCCD",
+			hours: 25,
+			msgs:  []sdk.Msg{send(linked, stake(1))},
+			err:   identitytypes.ErrTxCountLimitExceeded,
+			log:   "address=" + linked,
+		},
+	}
+	for _, step := range steps {
+		ctx := ctx.WithBlockHeight(1).WithBlockTime(start.Add(step.hours * time.Hour))
+		_, err := decorator.AnteHandle(ctx, mockTx{msgs: step.msgs}, false, next)
+		require.ErrorIs(t, err, step.err, step.desc)
+		if step.err != nil {
+			require.ErrorContains(t, err, step.log, step.desc)
+		}
+	}
+
+	// rejected transactions are not recorded
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Hour))
+	spent, txCount := k.SpendUsage(ctx, identitytypes.Identity{IdHash: "h4", CccdTag: "t1"})
+	require.Equal(t, sdk.NewCoins(stake(100), sdk.NewInt64Coin("uatom", 1000)), spent)
+	require.Equal(t, uint64(3), txCount)
+}
@@ -0,0 +1,66 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	identitykeeper "Nexelra/x/identity/keeper"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// ValidatorIdentityDecorator rejects transactions creating or editing a
+// validator whose operator account does not hold an active identity of the
+// verification level required by the identity params. Messages nested in
+// wrappers such as authz.MsgExec are checked too. Gentxs are checked against
+// the identity genesis instead.
+type ValidatorIdentityDecorator struct {
+	IdentityKeeper identitykeeper.Keeper
+	Recipients     *RecipientRegistry
+}
+
+// NewValidatorIdentityDecorator creates a new ValidatorIdentityDecorator
+func NewValidatorIdentityDecorator(keeper identitykeeper.Keeper, recipients *RecipientRegistry) ValidatorIdentityDecorator {
+	return ValidatorIdentityDecorator{
+		IdentityKeeper: keeper,
+		Recipients:     recipients,
+	}
+}
+
+// AnteHandle checks the operators of the validators created or edited by tx.
+func (d ValidatorIdentityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for i, msg := range tx.GetMsgs() {
+		msgs, err := d.Recipients.Messages(msg)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(identitytypes.ErrRecipientUnresolvable, "%s=%d %s=%s: %s",
+				identitytypes.AttributeKeyMsgIndex, i, identitytypes.AttributeKeyMsgType, sdk.MsgTypeURL(msg), err)
+		}
+
+		for _, m := range msgs {
+			var valoper string
+			switch m := m.(type) {
+			case *stakingtypes.MsgCreateValidator:
+				valoper = m.ValidatorAddress
+			case *stakingtypes.MsgEditValidator:
+				valoper = m.ValidatorAddress
+			default:
+				continue
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(valoper)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %q: %s", valoper, err)
+			}
+			if err := d.IdentityKeeper.ValidateOperator(ctx, sdk.AccAddress(valAddr)); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s=%d %s=%s", identitytypes.AttributeKeyMsgIndex, i, identitytypes.AttributeKeyMsgType, sdk.MsgTypeURL(msg))
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,108 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app/ante"
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+func TestValidatorIdentityDecorator(t *testing.T) {
+	unattested := sample.AccAddress()
+	verified := sample.AccAddress()
+	enhanced := sample.AccAddress()
+	stranger := sample.AccAddress()
+
+	edit := func(operator string) sdk.Msg {
+		valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(operator))
+		return stakingtypes.NewMsgEditValidator(valAddr.String(), stakingtypes.Description{}, nil, nil)
+	}
+	create := func(operator string) sdk.Msg {
+		valAddr := sdk.ValAddress(sdk.MustAccAddressFromBech32(operator))
+		return &stakingtypes.MsgCreateValidator{ValidatorAddress: valAddr.String()}
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		desc   string
+		msg    sdk.Msg
+		level  identitytypes.IdentityLevel
+		height int64
+		err    error
+	}{
+		{
+			desc: "create by operator with identity",
+			msg:  create(unattested),
+		},
+		{
+			desc: "create by operator without identity",
+			msg:  create(stranger),
+			err:  identitytypes.ErrOperatorNoIdentity,
+		},
+		{
+			desc: "edit by operator without identity",
+			msg:  edit(stranger),
+			err:  identitytypes.ErrOperatorNoIdentity,
+		},
+		{
+			desc: "edit through authz",
+			msg:  exec(edit(stranger)),
+			err:  identitytypes.ErrOperatorNoIdentity,
+		},
+		{
+			desc:  "unattested operator below level",
+			msg:   create(unattested),
+			level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+			err:   identitytypes.ErrOperatorIdentityLevel,
+		},
+		{
+			desc:  "verified operator",
+			msg:   edit(verified),
+			level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		},
+		{
+			desc:  "verified operator below enhanced",
+			msg:   create(verified),
+			level: identitytypes.IdentityLevel_IDENTITY_LEVEL_ENHANCED,
+			err:   identitytypes.ErrOperatorIdentityLevel,
+		},
+		{
+			desc:  "enhanced operator",
+			msg:   create(enhanced),
+			level: identitytypes.IdentityLevel_IDENTITY_LEVEL_ENHANCED,
+		},
+		{
+			desc:   "gentx",
+			msg:    create(stranger),
+			height: -1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			ctx = ctx.WithBlockHeight(tc.height + 1)
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: unattested, IdHash: "h1"}))
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: verified, IdHash: "h2", Verifier: sample.AccAddress(), Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_VERIFIED}))
+			require.NoError(t, k.SetIdentity(ctx, identitytypes.Identity{Address: enhanced, IdHash: "h3", Verifier: sample.AccAddress(), Level: identitytypes.IdentityLevel_IDENTITY_LEVEL_ENHANCED}))
+
+			params := k.GetParams(ctx)
+			params.ValidatorLevel = tc.level
+			require.NoError(t, k.SetParams(ctx, params))
+
+			decorator := ante.NewValidatorIdentityDecorator(k, ante.DefaultRecipientRegistry())
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{tc.msg}}, false, next)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
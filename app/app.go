@@ -1,6 +1,7 @@
 package app
 
 import (
+    "encoding/json"
     "fmt"
     "io"
 
@@ -78,6 +79,7 @@ import (
     ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
     identitymodulekeeper "Nexelra/x/identity/keeper"
+    identitymodule "Nexelra/x/identity/module"
     appante "Nexelra/app/ante"                     
     // this line is used by starport scaffolding # stargate/app/moduleImport
 
@@ -292,6 +294,16 @@ func New(
         if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()); err != nil {
             return nil, err
         }
+
+        // gentx validators must be run by operators with an identity
+        var genesisState map[string]json.RawMessage
+        if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+            return nil, err
+        }
+        if err := identitymodule.ValidateGenTxIdentities(app.appCodec, app.txConfig.TxJSONDecoder(), genesisState); err != nil {
+            return nil, err
+        }
+
         return app.App.InitChainer(ctx, req)
     })
 
@@ -424,6 +436,16 @@ func BlockedAddresses() map[string]bool {
     return result
 }
 
+// moduleAccountAddrs returns the bech32 addresses of the module accounts
+// listed by GetMaccPerms(), including those allowed to receive funds.
+func moduleAccountAddrs() map[string]bool {
+    result := make(map[string]bool)
+    for name := range GetMaccPerms() {
+        result[authtypes.NewModuleAddress(name).String()] = true
+    }
+    return result
+}
+
 func (app *App) setAnteHandler() {
     anteHandler, err := appante.NewAnteHandler(
         appante.HandlerOptions{
@@ -434,7 +456,9 @@ func (app *App) setAnteHandler() {
                 FeegrantKeeper:  app.FeeGrantKeeper,
                 SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
             },
+            Codec:          app.appCodec,
             IdentityKeeper: app.IdentityKeeper,
+            ModuleAccounts: moduleAccountAddrs(),
         },
     )
     if err != nil {
@@ -122,9 +122,14 @@ var (
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
+	// NOTE: identity module's endblocker finishes the one-person-one-vote
+	// proposals, it must come before gov's, which would tally them by stake
 	endBlockers = []string{
 		// cosmos sdk modules
 		crisistypes.ModuleName,
+		// chain modules
+		identitymoduletypes.ModuleName,
+		// cosmos sdk modules
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
@@ -136,8 +141,6 @@ var (
 		capabilitytypes.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
-		// chain modules
-		identitymoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
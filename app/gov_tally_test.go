@@ -0,0 +1,109 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// TestOnePersonOneVoteTally runs the end blockers of a chain over proposals
+// where stake and identities disagree: the only staker votes no, while most
+// identity holders vote yes.
+func TestOnePersonOneVoteTally(t *testing.T) {
+	const opov = `{"tally_mode":"one-person-one-vote"}`
+
+	tests := []struct {
+		desc     string
+		metadata string
+		// votes of the holders of identities "a", "b" and "c"; "a2" holds a
+		// second identity attested with the CCCD tag of "a"
+		votes  map[string]govv1.VoteOption
+		status govv1.ProposalStatus
+		tally  govv1.TallyResult
+	}{
+		{
+			desc:     "one person one vote passes against stake",
+			metadata: opov,
+			votes:    map[string]govv1.VoteOption{"a": govv1.OptionYes, "b": govv1.OptionYes, "c": govv1.OptionNo},
+			status:   govv1.StatusPassed,
+			tally:    govv1.TallyResult{YesCount: "2", AbstainCount: "0", NoCount: "1", NoWithVetoCount: "0"},
+		},
+		{
+			desc:     "duplicate CCCD votes once",
+			metadata: opov,
+			votes:    map[string]govv1.VoteOption{"a": govv1.OptionYes, "a2": govv1.OptionYes, "b": govv1.OptionNo, "c": govv1.OptionNo},
+			status:   govv1.StatusRejected,
+			tally:    govv1.TallyResult{YesCount: "1", AbstainCount: "0", NoCount: "2", NoWithVetoCount: "0"},
+		},
+		{
+			desc:     "quorum counts identities",
+			metadata: opov,
+			votes:    map[string]govv1.VoteOption{"a": govv1.OptionYes},
+			status:   govv1.StatusRejected,
+			tally:    govv1.TallyResult{YesCount: "1", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+		},
+		{
+			desc:     "stake weighted without the metadata",
+			metadata: `{"title":"stake"}`,
+			votes:    map[string]govv1.VoteOption{"a": govv1.OptionYes, "b": govv1.OptionYes, "c": govv1.OptionNo},
+			status:   govv1.StatusRejected,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ibctesting.DefaultTestingAppInit = setupTestingApp
+			chain := ibctesting.NewCoordinator(t, 1).GetChain(ibctesting.GetChainID(1))
+			a := identityApp(chain)
+			ctx := chain.GetContext()
+
+			voters := make(map[string]sdk.AccAddress)
+			// "b" and "c" were attested before tags, under their commitment
+			for name, cccdTag := range map[string]string{"a": "tag-a", "a2": "tag-a", "b": "", "c": ""} {
+				voters[name] = sdk.AccAddress(name + "-voter-address")
+				require.NoError(t, a.IdentityKeeper.SetIdentity(ctx, identitytypes.Identity{
+					Address: voters[name].String(),
+					IdHash:  "hash-" + name,
+					CccdTag: cccdTag,
+					Status:  identitytypes.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+				}))
+			}
+			// a voter without identity, counted by neither tally as it has no stake
+			voters["nobody"] = sdk.AccAddress("nobody-voter-address")
+
+			proposer := chain.SenderAccount.GetAddress()
+			proposal, err := a.GovKeeper.SubmitProposal(ctx, nil, tc.metadata, "title", "summary", proposer, false)
+			require.NoError(t, err)
+			require.NoError(t, a.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+			proposal, err = a.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+
+			// the staker holding all the bonded tokens votes no
+			require.NoError(t, a.GovKeeper.AddVote(ctx, proposal.Id, proposer, govv1.NewNonSplitVoteOption(govv1.OptionNo), ""))
+			require.NoError(t, a.GovKeeper.AddVote(ctx, proposal.Id, voters["nobody"], govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+			for name, option := range tc.votes {
+				require.NoError(t, a.GovKeeper.AddVote(ctx, proposal.Id, voters[name], govv1.NewNonSplitVoteOption(option), ""))
+			}
+
+			_, err = a.ModuleManager.EndBlock(ctx.WithBlockTime(*proposal.VotingEndTime))
+			require.NoError(t, err)
+
+			proposal, err = a.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, proposal.Status)
+			if tc.tally.YesCount != "" {
+				require.Equal(t, tc.tally, *proposal.FinalTallyResult)
+			}
+
+			// finished once, by exactly one of the two tallies
+			has, err := a.GovKeeper.ActiveProposalsQueue.Has(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
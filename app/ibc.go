@@ -36,6 +36,8 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	// this line is used by starport scaffolding # ibc/app/import
+
+	identitymodule "Nexelra/x/identity/module"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -142,8 +144,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Create IBC modules with ibcfee middleware. Incoming transfers go through
+	// the identity middleware before reaching the transfer application.
+	transferIBCModule := ibcfee.NewIBCMiddleware(
+		identitymodule.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IdentityKeeper, moduleAccountAddrs()),
+		app.IBCFeeKeeper,
+	)
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
@@ -0,0 +1,155 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app"
+	"Nexelra/testutil/sample"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// testingApp adds the accessors ibc-go's testing package needs to App
+type testingApp struct {
+	*app.App
+}
+
+func (a testingApp) GetBaseApp() *baseapp.BaseApp { return a.App.App.BaseApp }
+
+func (a testingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper { return a.StakingKeeper }
+
+func (a testingApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper { return a.ScopedIBCKeeper }
+
+func (a testingApp) GetTxConfig() client.TxConfig { return a.TxConfig() }
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	if err != nil {
+		panic(err)
+	}
+	return testingApp{bApp}, bApp.DefaultGenesis()
+}
+
+func identityApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(testingApp).App
+}
+
+func TestIBCTransferIdentityMiddleware(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	tests := []struct {
+		desc        string
+		hasIdentity bool
+		allowOnB    bool
+		// receiver defaults to a fresh account
+		receiver string
+		success  bool
+	}{
+		{
+			desc:        "receiver with active identity",
+			hasIdentity: true,
+			success:     true,
+		},
+		{
+			desc: "receiver without identity",
+		},
+		{
+			desc:     "receiver without identity on an allowed channel",
+			allowOnB: true,
+			success:  true,
+		},
+		{
+			// not blocked by x/bank, so the transfer application credits it
+			desc:     "module account receiver",
+			receiver: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			success:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			coordinator := ibctesting.NewCoordinator(t, 2)
+			chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+			chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+			// the ante handler's identity gate is not under test here, let the
+			// relayer and sender accounts through it
+			for _, chain := range []*ibctesting.TestChain{chainA, chainB} {
+				k := identityApp(chain).IdentityKeeper
+				params := k.GetParams(chain.GetContext())
+				params.GatingPolicy.DefaultRule = identitytypes.GatingRule_GATING_RULE_EXEMPT
+				require.NoError(t, k.SetParams(chain.GetContext(), params))
+			}
+
+			path := ibctesting.NewTransferPath(chainA, chainB)
+			coordinator.Setup(path)
+
+			receiver := tc.receiver
+			if receiver == "" {
+				receiver = sample.AccAddress()
+			}
+			identityB := identityApp(chainB).IdentityKeeper
+			if tc.hasIdentity {
+				require.NoError(t, identityB.SetIdentity(chainB.GetContext(), identitytypes.Identity{Address: receiver, IdHash: "hash"}))
+			}
+			if tc.allowOnB {
+				params := identityB.GetParams(chainB.GetContext())
+				params.IbcReceivePolicy.ChannelRules = []identitytypes.ChannelReceiveRule{{
+					ChannelId: path.EndpointB.ChannelID,
+					Rule:      identitytypes.IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW,
+				}}
+				require.NoError(t, identityB.SetParams(chainB.GetContext(), params))
+			}
+
+			sender := chainA.SenderAccount.GetAddress()
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			balanceBefore := identityApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			msg := ibctransfertypes.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				amount, sender.String(), receiver,
+				clienttypes.NewHeight(1, 110), 0, "",
+			)
+			res, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			require.NoError(t, err)
+
+			_, ackBz, err := path.RelayPacketWithResults(packet)
+			require.NoError(t, err)
+			var ack channeltypes.Acknowledgement
+			require.NoError(t, ibctransfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			require.Equal(t, tc.success, ack.Success())
+
+			voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+			)).IBCDenom()
+			received := identityApp(chainB).BankKeeper.GetBalance(chainB.GetContext(), sdk.MustAccAddressFromBech32(receiver), voucher)
+			balanceAfter := identityApp(chainA).BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			if tc.success {
+				require.Equal(t, amount.Amount, received.Amount)
+				require.Equal(t, balanceBefore.Sub(amount), balanceAfter)
+			} else {
+				// refunded on the sending chain, nothing minted on the receiving one
+				require.True(t, received.IsZero())
+				require.Equal(t, balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package app_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/client/cli"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// TestIdentityProof proves identities of a committed block and verifies the
+// proofs against the app hash of the next header, as a relying party would.
+func TestIdentityProof(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	chain := ibctesting.NewCoordinator(t, 1).GetChain(ibctesting.GetChainID(1))
+	a := identityApp(chain)
+	cdc := a.AppCodec()
+
+	holder := sdk.AccAddress("proof-holder-address")
+	stranger := sdk.AccAddress("proof-strangeraddress")
+	require.NoError(t, a.IdentityKeeper.SetIdentity(chain.GetContext(), identitytypes.Identity{
+		Address: holder.String(),
+		IdHash:  "hash-holder",
+		Status:  identitytypes.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+	}))
+	chain.NextBlock()
+
+	prove := func(addr sdk.AccAddress) cli.IdentityProof {
+		res, err := a.Query(context.Background(), &abci.RequestQuery{
+			Path:  fmt.Sprintf("store/%s/key", identitytypes.StoreKey),
+			Data:  identitytypes.IdentityStoreKey(addr),
+			Prove: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, chain.LastHeader.Header.Height, res.Height)
+
+		proof, err := cli.NewIdentityProof(cdc, addr, *res)
+		require.NoError(t, err)
+		return proof
+	}
+	appHash := chain.CurrentHeader.AppHash
+
+	t.Run("membership", func(t *testing.T) {
+		identity, err := cli.VerifyIdentityProof(cdc, prove(holder), appHash)
+		require.NoError(t, err)
+		require.NotNil(t, identity)
+		require.Equal(t, holder.String(), identity.Address)
+		require.Equal(t, identitytypes.IdentityStatus_IDENTITY_STATUS_ACTIVE, identity.Status)
+	})
+
+	t.Run("non-membership", func(t *testing.T) {
+		identity, err := cli.VerifyIdentityProof(cdc, prove(stranger), appHash)
+		require.NoError(t, err)
+		require.Nil(t, identity)
+	})
+
+	t.Run("tampered identity", func(t *testing.T) {
+		proof := prove(holder)
+		var identity identitytypes.Identity
+		require.NoError(t, cdc.Unmarshal(proof.Value, &identity))
+		identity.Status = identitytypes.IdentityStatus_IDENTITY_STATUS_SUSPENDED
+		proof.Value = cdc.MustMarshal(&identity)
+
+		_, err := cli.VerifyIdentityProof(cdc, proof, appHash)
+		require.Error(t, err)
+	})
+
+	t.Run("proof of another address", func(t *testing.T) {
+		proof := prove(holder)
+		proof.Address = stranger.String()
+
+		_, err := cli.VerifyIdentityProof(cdc, proof, appHash)
+		require.Error(t, err)
+	})
+
+	t.Run("absence claimed for a member", func(t *testing.T) {
+		proof := prove(stranger)
+		proof.Address = holder.String()
+
+		_, err := cli.VerifyIdentityProof(cdc, proof, appHash)
+		require.Error(t, err)
+	})
+
+	t.Run("other app hash", func(t *testing.T) {
+		_, err := cli.VerifyIdentityProof(cdc, prove(holder), chain.LastHeader.Header.AppHash)
+		require.Error(t, err)
+		_, err = cli.VerifyIdentityProof(cdc, prove(stranger), chain.LastHeader.Header.AppHash)
+		require.Error(t, err)
+	})
+
+	t.Run("verify-proof command", func(t *testing.T) {
+		dir := t.TempDir()
+		proofBz, err := json.Marshal(prove(holder))
+		require.NoError(t, err)
+		proofFile := filepath.Join(dir, "proof.json")
+		require.NoError(t, os.WriteFile(proofFile, proofBz, 0o600))
+
+		verify := func(height int64) (string, error) {
+			headerFile := filepath.Join(dir, "header.json")
+			header := fmt.Sprintf(`{"result":{"signed_header":{"header":{"height":"%d","app_hash":"%s"}}}}`,
+				height, strings.ToUpper(hex.EncodeToString(appHash)))
+			require.NoError(t, os.WriteFile(headerFile, []byte(header), 0o600))
+
+			cmd := cli.CmdVerifyProof()
+			cmd.SetArgs([]string{proofFile, headerFile})
+			var out strings.Builder
+			cmd.SetOut(&out)
+			cmd.SetErr(&out)
+			clientCtx := client.Context{}.WithCodec(cdc)
+			err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+			return out.String(), err
+		}
+
+		out, err := verify(chain.CurrentHeader.Height)
+		require.NoError(t, err, out)
+		require.Contains(t, out, "IDENTITY_STATUS_ACTIVE")
+
+		_, err = verify(chain.LastHeader.Header.Height)
+		require.Error(t, err)
+	})
+}
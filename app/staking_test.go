@@ -0,0 +1,44 @@
+package app_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// TestValidatorOperatorIdentity creates a validator past the ante handler,
+// as authz or a proposal would, so only the identity staking hook checks it.
+func TestValidatorOperatorIdentity(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	chain := ibctesting.NewCoordinator(t, 1).GetChain(ibctesting.GetChainID(1))
+	a := identityApp(chain)
+	ctx := chain.GetContext()
+	operator := chain.SenderAccount.GetAddress()
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+	srv := stakingkeeper.NewMsgServerImpl(a.StakingKeeper)
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = srv.CreateValidator(cacheCtx, msg)
+	require.ErrorIs(t, err, identitytypes.ErrOperatorNoIdentity)
+
+	require.NoError(t, a.IdentityKeeper.SetIdentity(ctx, identitytypes.Identity{Address: operator.String(), IdHash: "hash"}))
+	_, err = srv.CreateValidator(ctx, msg)
+	require.NoError(t, err)
+}
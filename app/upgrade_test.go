@@ -0,0 +1,54 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	v2 "Nexelra/x/identity/migrations/v2"
+	identitytypes "Nexelra/x/identity/types"
+)
+
+// TestIdentityStoreUpgrade runs the module migrations of a chain whose
+// x/identity state is still in the consensus version 4 layout, as an upgrade
+// handler would, and checks the identities are served from collections after
+// and hold their identity NFT.
+func TestIdentityStoreUpgrade(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	chain := ibctesting.NewCoordinator(t, 1).GetChain(ibctesting.GetChainID(1))
+	a := identityApp(chain)
+	ctx := chain.GetContext()
+	cdc := a.AppCodec()
+
+	// rewrite the module store as a v4 node left it
+	store := ctx.KVStore(a.GetKey(identitytypes.StoreKey))
+	params := a.IdentityKeeper.GetParams(ctx)
+	store.Delete(identitytypes.ParamsKey)
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&params))
+
+	identity := identitytypes.Identity{Address: sample.AccAddress(), IdHash: "hash", CreatedHeight: 1}
+	prefix.NewStore(store, v2.KeyPrefix(v2.IdentityKeyPrefix)).Set(v2.IdentityKey(identity.Address), cdc.MustMarshal(&identity))
+	prefix.NewStore(store, v2.KeyPrefix(v2.IdentityHashKeyPrefix)).Set(v2.IdentityHashKey(identity.IdHash), []byte(identity.Address))
+
+	_, found := a.IdentityKeeper.GetIdentity(ctx, identity.Address)
+	require.False(t, found)
+
+	fromVM := a.ModuleManager.GetVersionMap()
+	fromVM[identitytypes.ModuleName] = 4
+	toVM, err := a.ModuleManager.RunMigrations(ctx, a.Configurator(), fromVM)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), toVM[identitytypes.ModuleName])
+
+	require.Equal(t, params, a.IdentityKeeper.GetParams(ctx))
+	got, found := a.IdentityKeeper.GetIdentity(ctx, identity.Address)
+	require.True(t, found)
+	require.Equal(t, identity, got)
+	got, found = a.IdentityKeeper.GetIdentityByIdHash(ctx, identity.IdHash)
+	require.True(t, found)
+	require.Equal(t, identity.Address, got.Address)
+	require.Nil(t, store.Get(v2.ParamsKey))
+	require.True(t, a.NFTKeeper.HasNFT(ctx, identitytypes.NFTClassID, identity.Address))
+}
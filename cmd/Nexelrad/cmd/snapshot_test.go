@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/app"
+)
+
+const snapshotTestChainID = "nexelra-snapshot-test"
+
+// snapshotTestHome returns the server context of a new home directory.
+func snapshotTestHome(t *testing.T) *server.Context {
+	t.Helper()
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	v.Set(flags.FlagChainID, snapshotTestChainID)
+	v.Set(server.FlagPruning, "nothing")
+
+	config := cmtcfg.DefaultConfig()
+	config.SetRoot(home)
+	return server.NewContext(v, config, log.NewNopLogger())
+}
+
+// commitTestChain initializes a chain in the home of serverCtx and commits
+// its first blocks, returning the last commit.
+func commitTestChain(t *testing.T, serverCtx *server.Context, blocks int64) storetypes.CommitID {
+	t.Helper()
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(serverCtx.Config.RootDir, "data"))
+	require.NoError(t, err)
+	a := newApp(log.NewNopLogger(), db, nil, serverCtx.Viper).(*app.App)
+	defer a.Close()
+
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	require.NoError(t, err)
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	genesisState, err := simtestutil.GenesisStateWithValSet(a.AppCodec(), a.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = a.InitChain(&abci.RequestInitChain{
+		ChainId:         snapshotTestChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= blocks; height++ {
+		_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, NextValidatorsHash: valSet.Hash()})
+		require.NoError(t, err)
+		_, err = a.Commit()
+		require.NoError(t, err)
+	}
+
+	return a.CommitMultiStore().LastCommitID()
+}
+
+// lastCommitID returns the last commit of the application database in the
+// home of serverCtx.
+func lastCommitID(t *testing.T, serverCtx *server.Context) storetypes.CommitID {
+	t.Helper()
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(serverCtx.Config.RootDir, "data"))
+	require.NoError(t, err)
+	a := newApp(log.NewNopLogger(), db, nil, serverCtx.Viper)
+	defer a.Close()
+
+	return a.CommitMultiStore().LastCommitID()
+}
+
+func runSnapshotCmd(t *testing.T, serverCtx *server.Context, stdin string, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := GetSnapshotCmd(newApp)
+	cmd.SetArgs(args)
+	cmd.SetIn(strings.NewReader(stdin))
+	var out strings.Builder
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+
+	clientCtx := client.Context{}.WithChainID(snapshotTestChainID)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestSnapshotCreateAndRestore(t *testing.T) {
+	source := snapshotTestHome(t)
+	commitID := commitTestChain(t, source, 3)
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+
+	out, err := runSnapshotCmd(t, source, "", "create", "--archive", archive)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "Snapshot created at height 3")
+	require.FileExists(t, archive)
+
+	// a more recent snapshot already exists
+	_, err = runSnapshotCmd(t, source, "", "create", "--height", "2")
+	require.Error(t, err)
+
+	t.Run("from archive", func(t *testing.T) {
+		target := snapshotTestHome(t)
+
+		out, err := runSnapshotCmd(t, target, "", "restore", "--archive", archive, "--force")
+		require.NoError(t, err, out)
+		require.Equal(t, commitID, lastCommitID(t, target))
+
+		// the archive was added to the local snapshot store
+		require.DirExists(t, filepath.Join(target.Config.RootDir, "data", "snapshots", "3"))
+	})
+
+	t.Run("from local snapshot", func(t *testing.T) {
+		target := snapshotTestHome(t)
+		require.NoError(t, os.CopyFS(
+			filepath.Join(target.Config.RootDir, "data", "snapshots"),
+			os.DirFS(filepath.Join(source.Config.RootDir, "data", "snapshots")),
+		))
+
+		out, err := runSnapshotCmd(t, target, "y\n", "restore", "3", "3")
+		require.NoError(t, err, out)
+		require.Equal(t, commitID, lastCommitID(t, target))
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		target := snapshotTestHome(t)
+
+		out, err := runSnapshotCmd(t, target, "n\n", "restore", "--archive", archive)
+		require.NoError(t, err)
+		require.Contains(t, out, "Restore cancelled")
+		require.Zero(t, lastCommitID(t, target).Version)
+	})
+
+	t.Run("missing local snapshot", func(t *testing.T) {
+		target := snapshotTestHome(t)
+
+		_, err := runSnapshotCmd(t, target, "", "restore", "2", "3", "--force")
+		require.ErrorContains(t, err, "snapshot doesn't exist")
+	})
+}
+
+// writeTestArchive writes a snapshot archive of snap holding the given chunk
+// files, and returns its path.
+func writeTestArchive(t *testing.T, snap snapshottypes.Snapshot, chunks ...string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	fp, err := os.Create(path)
+	require.NoError(t, err)
+	defer fp.Close()
+	gzipWriter := gzip.NewWriter(fp)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	bz, err := snap.Marshal()
+	require.NoError(t, err)
+	files := append([]string{snapshot.SnapshotFileName}, chunks...)
+	for i, name := range files {
+		content := []byte("chunk")
+		if i == 0 {
+			content = bz
+		}
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tarWriter.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return path
+}
+
+func TestSnapshotRestoreMalformedArchive(t *testing.T) {
+	snap := func(chunks uint32) snapshottypes.Snapshot {
+		return snapshottypes.Snapshot{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: chunks, Hash: make([]byte, 32)}
+	}
+
+	tests := []struct {
+		desc    string
+		archive string
+		err     string
+	}{
+		{
+			desc:    "no chunks",
+			archive: writeTestArchive(t, snap(0)),
+			err:     "snapshot has no chunks",
+		},
+		{
+			desc:    "missing chunk",
+			archive: writeTestArchive(t, snap(2), "0"),
+			err:     "snapshot has 2 chunks, got 1",
+		},
+		{
+			desc:    "extra chunk",
+			archive: writeTestArchive(t, snap(1), "0", "1"),
+			err:     "snapshot has 1 chunks, got file 1 after them",
+		},
+		{
+			desc:    "chunks out of order",
+			archive: writeTestArchive(t, snap(2), "1", "0"),
+			err:     "expect file 0, got 1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			target := snapshotTestHome(t)
+
+			_, err := runSnapshotCmd(t, target, "", "restore", "--archive", tc.archive, "--force")
+			require.ErrorContains(t, err, tc.err)
+			// the archive was rejected before the restore started
+			require.Zero(t, lastCommitID(t, target).Version)
+		})
+	}
+}
+
+func TestSnapshotListAndInfo(t *testing.T) {
+	home := snapshotTestHome(t)
+	home.Viper.Set(server.FlagStateSyncSnapshotInterval, 100)
+	home.Viper.Set(server.FlagStateSyncSnapshotKeepRecent, 3)
+
+	// no snapshot store yet
+	out, err := runSnapshotCmd(t, home, "", "list", "--output", "json")
+	require.NoError(t, err, out)
+	require.JSONEq(t, "[]", out)
+
+	commitTestChain(t, home, 2)
+	for _, height := range []string{"1", "2"} {
+		out, err := runSnapshotCmd(t, home, "", "create", "--height", height)
+		require.NoError(t, err, out)
+	}
+
+	out, err = runSnapshotCmd(t, home, "", "list", "--output", "json")
+	require.NoError(t, err, out)
+	var entries []snapshotEntry
+	require.NoError(t, json.Unmarshal([]byte(out), &entries))
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(2), entries[0].Height)
+	require.Equal(t, uint64(1), entries[1].Height)
+	for _, entry := range entries {
+		require.NotZero(t, entry.Chunks)
+		require.Positive(t, entry.Size)
+		require.Len(t, entry.Hash, 64)
+	}
+
+	out, err = runSnapshotCmd(t, home, "", "list")
+	require.NoError(t, err, out)
+	require.Contains(t, out, entries[0].Hash)
+
+	// the node is not running
+	out, err = runSnapshotCmd(t, home, "", "info", "--node", "tcp://127.0.0.1:1", "--output", "json")
+	require.NoError(t, err, out)
+	var status snapshotStatus
+	require.NoError(t, json.Unmarshal([]byte(out), &status))
+	require.Equal(t, snapshotStatus{
+		Home:               home.Config.RootDir,
+		ChainID:            snapshotTestChainID,
+		SnapshotInterval:   100,
+		SnapshotKeepRecent: 3,
+		Snapshots:          entries,
+	}, status)
+
+	out, err = runSnapshotCmd(t, home, "", "info", "--node", "tcp://127.0.0.1:1")
+	require.NoError(t, err, out)
+	require.Contains(t, out, "OFFLINE")
+	require.Contains(t, out, "Latest: height 2")
+}
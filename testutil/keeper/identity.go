@@ -1,15 +1,20 @@
 package keeper
 
 import (
+	"context"
 	"testing"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,23 +26,48 @@ import (
 	"Nexelra/x/identity/types"
 )
 
+// nftAccountKeeper is the account keeper x/nft needs to be created.
+type nftAccountKeeper struct{}
+
+func (nftAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (nftAccountKeeper) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func (nftAccountKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
 func IdentityKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := IdentityKeeperWithNFT(t)
+	return k, ctx
+}
+
+// IdentityKeeperWithNFT is IdentityKeeper that also returns the NFT keeper
+// identity NFTs are issued by.
+func IdentityKeeperWithNFT(t testing.TB) (keeper.Keeper, nftkeeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	nftStoreKey := storetypes.NewKVStoreKey(nft.StoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(nftStoreKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
+	nftKeeper := nftkeeper.NewKeeper(runtime.NewKVStoreService(nftStoreKey), cdc, nftAccountKeeper{}, nil)
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		nftKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -46,6 +76,9 @@ func IdentityKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
 		panic(err)
 	}
+	if err := k.InitIdentityNFTs(ctx); err != nil {
+		panic(err)
+	}
 
-	return k, ctx
+	return k, nftKeeper, ctx
 }
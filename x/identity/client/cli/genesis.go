@@ -0,0 +1,346 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+// FlagCccdTagKey is the flag of the secret the verifiers derive CCCD tags
+// with.
+const FlagCccdTagKey = "cccd-tag-key"
+
+// GenesisIdentityRow is an identity to add to the genesis file: the address
+// of its holder and the raw CCCD ID, which is hashed before it is written,
+// with the hex encoded salt of the holder under the salted scheme.
+type GenesisIdentityRow struct {
+	// Line is the line of the row in the imported file, zero for a row given
+	// on the command line.
+	Line    int
+	Address string
+	CccdId  string
+	Salt    string
+}
+
+// RejectedRow is a row AddGenesisIdentities did not add, and why.
+type RejectedRow struct {
+	GenesisIdentityRow
+	Err error
+}
+
+func (r RejectedRow) String() string {
+	return fmt.Sprintf("line %d: %s: %s", r.Line, r.Address, r.Err)
+}
+
+// AddGenesisIdentityCmd returns the add-identity genesis command, which
+// registers a single identity in genesis.json.
+func AddGenesisIdentityCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-identity [address] [cccd-id]",
+		Short: "Add an identity to genesis.json",
+		Long: `Add an active identity to the identity list of genesis.json.
+
+The CCCD ID is hashed locally with the pepper and hash scheme of the identity
+params in genesis.json, only the commitment is written to the file. The address
+must be a valid bech32 account address, and neither it nor the CCCD ID may
+already have an identity.
+
+The salted scheme also hashes the hex encoded salt of --salt, which the holder
+must know, and tags the identity with the CCCD tag derived with --cccd-tag-key.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			rejected, err := addGenesisIdentities(cmd, []GenesisIdentityRow{{Address: args[0], CccdId: args[1], Salt: salt}})
+			if err != nil {
+				return err
+			}
+			if len(rejected) > 0 {
+				return rejected[0].Err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagSalt, "", "Hex encoded salt of the commitment, required by the salted scheme")
+	cmd.Flags().String(FlagCccdTagKey, "", "Secret the verifiers derive CCCD tags with, required by the salted scheme")
+
+	return cmd
+}
+
+// ImportGenesisIdentitiesCmd returns the import-identities genesis command,
+// which registers the identities of a CSV file in genesis.json.
+func ImportGenesisIdentitiesCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-identities [file.csv]",
+		Short: "Bulk add identities from a CSV file to genesis.json",
+		Example: `import-identities identities.csv
+where identities.csv is:
+address,cccd_id,salt
+nexelra1...,001203004567,5f1c...
+nexelra1...,079198001234,a90e...`,
+		Long: `Add the identities of a CSV file of address,cccd_id[,salt] rows to the
+identity list of genesis.json. A header row and lines starting with # are
+skipped.
+
+Each CCCD ID is hashed locally with the pepper and hash scheme of the identity
+params in genesis.json. The salted scheme also hashes the hex encoded salt of
+the row, which its holder must know, and tags the identity with the CCCD tag
+derived with --cccd-tag-key. Rows with an invalid address, an empty CCCD ID, a
+missing salt, or an address or CCCD ID that already has an identity, in
+genesis.json or earlier in the file, are rejected and reported; the other rows
+are added.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer f.Close()
+
+			rows, malformed, err := ReadIdentityCSV(f)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+
+			rejected, err := addGenesisIdentities(cmd, rows)
+			if err != nil {
+				return err
+			}
+			rejected = append(malformed, rejected...)
+			for _, row := range rejected {
+				cmd.PrintErrf("rejected %s\n", row)
+			}
+			cmd.Printf("imported %d identities, rejected %d\n", len(rows)+len(malformed)-len(rejected), len(rejected))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagCccdTagKey, "", "Secret the verifiers derive CCCD tags with, required by the salted scheme")
+
+	return cmd
+}
+
+// addGenesisIdentities adds rows to the genesis file of the node home of cmd.
+// The file is left untouched if no row is accepted.
+func addGenesisIdentities(cmd *cobra.Command, rows []GenesisIdentityRow) ([]RejectedRow, error) {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis doc from file: %w", err)
+	}
+
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	tagKey, err := cmd.Flags().GetString(FlagCccdTagKey)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := GetGenesisStateFromAppState(clientCtx.Codec, appState)
+	if genState.Params.HashScheme == types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 && tagKey == "" {
+		return nil, fmt.Errorf("hash scheme %s requires --%s", genState.Params.HashScheme, FlagCccdTagKey)
+	}
+	rejected := AddGenesisIdentities(genState, rows, appGenesis.GenesisTime.Unix(), tagKey)
+	if len(rejected) == len(rows) {
+		return rejected, nil
+	}
+	if err := genState.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid identity genesis state: %w", err)
+	}
+
+	appState[types.ModuleName], err = clientCtx.Codec.MarshalJSON(genState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal identity genesis state: %w", err)
+	}
+	appGenesis.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	return rejected, genutil.ExportGenesisFile(appGenesis, genFile)
+}
+
+// GetGenesisStateFromAppState returns the identity genesis state of appState,
+// the default one if appState has none.
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	if appState[types.ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[types.ModuleName], genState)
+	}
+	return genState
+}
+
+// AddGenesisIdentities hashes the CCCD ID of each row with the pepper and
+// hash scheme of the params of genState, and appends the resulting active
+// identities, created at createdAt, to its identity list. The identities are
+// tagged with the CCCD tag derived with tagKey, unless it is empty. Rows with
+// an invalid address, an empty CCCD ID, an invalid salt, or an address,
+// commitment or tag that already has an identity, or a CCCD ID already held
+// under an unsalted commitment, are returned instead.
+func AddGenesisIdentities(genState *types.GenesisState, rows []GenesisIdentityRow, createdAt int64, tagKey string) []RejectedRow {
+	owners := make(map[string]bool, len(genState.IdentityList))
+	hashes := make(map[string]string, len(genState.IdentityList))
+	tags := make(map[string]string, len(genState.IdentityList))
+	for _, identity := range genState.IdentityList {
+		owners[identity.Address] = true
+		if identity.IdHash != "" {
+			hashes[identity.IdHash] = identity.Address
+		}
+		if identity.CccdTag != "" {
+			tags[identity.CccdTag] = identity.Address
+		}
+	}
+
+	var rejected []RejectedRow
+	for _, row := range rows {
+		identity, err := newGenesisIdentity(genState.Params, row, createdAt, tagKey)
+		if err == nil {
+			if owners[identity.Address] {
+				err = errors.New("address already has an identity")
+			} else if owner, ok := hashes[identity.IdHash]; ok {
+				err = fmt.Errorf("CCCD ID already registered to %s", owner)
+			} else if owner, ok := tags[identity.CccdTag]; ok {
+				err = fmt.Errorf("CCCD ID already registered to %s", owner)
+			} else if owner, ok := legacyOwner(hashes, genState.Params.Pepper, row.CccdId); ok {
+				err = fmt.Errorf("CCCD ID already registered to %s", owner)
+			}
+		}
+		if err != nil {
+			rejected = append(rejected, RejectedRow{GenesisIdentityRow: row, Err: err})
+			continue
+		}
+
+		owners[identity.Address] = true
+		hashes[identity.IdHash] = identity.Address
+		if identity.CccdTag != "" {
+			tags[identity.CccdTag] = identity.Address
+		}
+		genState.IdentityList = append(genState.IdentityList, identity)
+	}
+
+	return rejected
+}
+
+// legacyOwner returns the address holding cccdId under an unsalted
+// commitment, which identities registered before CCCD tags have.
+func legacyOwner(hashes map[string]string, pepper, cccdId string) (string, bool) {
+	for _, commitment := range types.LegacyCommitments(pepper, cccdId) {
+		if owner, ok := hashes[commitment]; ok {
+			return owner, true
+		}
+	}
+	return "", false
+}
+
+func newGenesisIdentity(params types.Params, row GenesisIdentityRow, createdAt int64, tagKey string) (types.Identity, error) {
+	addr, err := sdk.AccAddressFromBech32(row.Address)
+	if err != nil {
+		return types.Identity{}, fmt.Errorf("invalid address: %w", err)
+	}
+	if row.CccdId == "" {
+		return types.Identity{}, errors.New("empty CCCD ID")
+	}
+
+	var salt []byte
+	if params.HashScheme == types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 {
+		if tagKey == "" {
+			return types.Identity{}, fmt.Errorf("hash scheme %s requires a CCCD tag key", params.HashScheme)
+		}
+		if salt, err = hex.DecodeString(row.Salt); err != nil {
+			return types.Identity{}, fmt.Errorf("invalid salt: %w", err)
+		}
+	}
+
+	commitment, err := types.ComputeCommitment(params.HashScheme, params.Pepper, salt, row.CccdId)
+	if err != nil {
+		return types.Identity{}, err
+	}
+
+	var tag string
+	if tagKey != "" {
+		if tag, err = types.ComputeCccdTag(tagKey, row.CccdId); err != nil {
+			return types.Identity{}, err
+		}
+	}
+
+	return types.Identity{
+		Address:    addr.String(),
+		IdHash:     commitment,
+		CreatedAt:  createdAt,
+		HashScheme: params.HashScheme,
+		Status:     types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		CccdTag:    tag,
+	}, nil
+}
+
+// ReadIdentityCSV reads the address,cccd_id[,salt] rows of an identity CSV
+// file, skipping an address header row and lines starting with #. Surrounding
+// spaces are trimmed. Rows without two or three fields are returned as
+// malformed rather than failing the whole file.
+func ReadIdentityCSV(r io.Reader) (rows []GenesisIdentityRow, malformed []RejectedRow, err error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, malformed, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+		if first && strings.EqualFold(record[0], "address") {
+			continue
+		}
+
+		row := GenesisIdentityRow{Line: line, Address: record[0]}
+		if len(record) != 2 && len(record) != 3 {
+			malformed = append(malformed, RejectedRow{
+				GenesisIdentityRow: row,
+				Err:                fmt.Errorf("expected 2 or 3 fields, got %d", len(record)),
+			})
+			continue
+		}
+		row.CccdId = record[1]
+		if len(record) == 3 {
+			row.Salt = record[2]
+		}
+		rows = append(rows, row)
+	}
+}
@@ -0,0 +1,139 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/client/cli"
+	"Nexelra/x/identity/types"
+)
+
+func TestAddGenesisIdentities(t *testing.T) {
+	existing := sample.AccAddress()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+	carol := sample.AccAddress()
+
+	salt := strings.Repeat("5a", types.SaltLength)
+	otherSalt := strings.Repeat("a5", types.SaltLength)
+
+	genState := types.DefaultGenesis()
+	taken, err := types.ComputeCccdTag("verifier-secret", "001203004567")
+	require.NoError(t, err)
+	legacy := types.LegacyCommitments(genState.Params.Pepper, "001203009999")[1]
+	genState.IdentityList = []types.Identity{
+		{Address: existing, IdHash: strings.Repeat("ab", 32), CccdTag: taken},
+		{Address: sample.AccAddress(), IdHash: legacy, HashScheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256},
+	}
+
+	rejected := cli.AddGenesisIdentities(genState, []cli.GenesisIdentityRow{
+		{Line: 1, Address: alice, CccdId: "079198001234", Salt: salt},
+		{Line: 2, Address: "nexelra1invalid", CccdId: "079198001235", Salt: salt},
+		{Line: 3, Address: existing, CccdId: "079198001236", Salt: salt},
+		{Line: 4, Address: bob, CccdId: "001203004567", Salt: salt},
+		{Line: 5, Address: bob, CccdId: "079198001234", Salt: otherSalt},
+		{Line: 6, Address: bob, CccdId: "", Salt: salt},
+		{Line: 7, Address: bob, CccdId: "079198001237"},
+		{Line: 8, Address: bob, CccdId: "079198001237", Salt: "zz"},
+		{Line: 9, Address: bob, CccdId: "079198001237", Salt: salt},
+		{Line: 10, Address: carol, CccdId: "001203009999", Salt: salt},
+	}, 42, "verifier-secret")
+
+	lines := make([]int, len(rejected))
+	for i, row := range rejected {
+		lines[i] = row.Line
+	}
+	require.Equal(t, []int{2, 3, 4, 5, 6, 7, 8, 10}, lines)
+
+	require.Len(t, genState.IdentityList, 4)
+	require.NoError(t, genState.Validate())
+	for _, identity := range genState.IdentityList[2:] {
+		require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_ACTIVE, identity.Status)
+		require.Equal(t, genState.Params.HashScheme, identity.HashScheme)
+		require.Equal(t, int64(42), identity.CreatedAt)
+		require.NoError(t, types.ValidateCommitment(identity.IdHash))
+		require.NoError(t, types.ValidateCommitment(identity.CccdTag))
+	}
+	require.Equal(t, alice, genState.IdentityList[2].Address)
+	require.Equal(t, bob, genState.IdentityList[3].Address)
+}
+
+func TestReadIdentityCSV(t *testing.T) {
+	rows, malformed, err := cli.ReadIdentityCSV(strings.NewReader(`address,cccd_id
+# founders
+addr1, 001203004567
+addr2
+addr3,079198001234,5a5a,extra
+addr4,079198001234
+addr5,079198001235, 5a5a
+`))
+	require.NoError(t, err)
+	require.Equal(t, []cli.GenesisIdentityRow{
+		{Line: 3, Address: "addr1", CccdId: "001203004567"},
+		{Line: 6, Address: "addr4", CccdId: "079198001234"},
+		{Line: 7, Address: "addr5", CccdId: "079198001235", Salt: "5a5a"},
+	}, rows)
+	require.Len(t, malformed, 2)
+	require.Equal(t, 4, malformed[0].Line)
+	require.Equal(t, 5, malformed[1].Line)
+}
+
+func TestImportGenesisIdentitiesCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		types.ModuleName: cdc.MustMarshalJSON(types.DefaultGenesis()),
+	})
+	require.NoError(t, err)
+	genFile := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, genutil.ExportGenesisFileWithTime(genFile, "nexelra", nil, appState, time.Unix(1700000000, 0)))
+
+	alice := sample.AccAddress()
+	csvFile := filepath.Join(home, "identities.csv")
+	salt := strings.Repeat("5a", types.SaltLength)
+	require.NoError(t, os.WriteFile(csvFile, []byte("address,cccd_id,salt\n"+alice+",001203004567,"+salt+"\nbad,001203004568,"+salt+"\n"), 0o600))
+
+	serverCtx := server.NewDefaultContext()
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+	clientCtx := client.Context{}.WithCodec(cdc).WithHomeDir(home)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+	// the salted scheme needs the key of the CCCD tags
+	cmd := cli.ImportGenesisIdentitiesCmd(home)
+	cmd.SetArgs([]string{csvFile})
+	cmd.SetErr(&strings.Builder{})
+	cmd.SetOut(&strings.Builder{})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), cli.FlagCccdTagKey)
+
+	cmd = cli.ImportGenesisIdentitiesCmd(home)
+	cmd.SetArgs([]string{csvFile, "--" + cli.FlagCccdTagKey, "verifier-secret"})
+	var stderr strings.Builder
+	cmd.SetErr(&stderr)
+	cmd.SetOut(&strings.Builder{})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Contains(t, stderr.String(), "line 3: bad")
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	require.NoError(t, err)
+	genesisState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	genState := cli.GetGenesisStateFromAppState(cdc, genesisState)
+	require.Len(t, genState.IdentityList, 1)
+	require.Equal(t, alice, genState.IdentityList[0].Address)
+	require.Equal(t, int64(1700000000), genState.IdentityList[0].CreatedAt)
+}
@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+const (
+	FlagLang = "lang"
+
+	// EnvLang selects the language of rendered errors when --lang is not set.
+	EnvLang = "NEXELRA_LANG"
+
+	LangEnglish    = "en"
+	LangVietnamese = "vi"
+)
+
+// messages are the localized texts of the identity error codes, keyed by
+// code and then language. The placeholders, such as {address} or {limit},
+// are filled from the details wrapped into the error, see placeholders.
+var messages = map[uint32]map[string]string{
+	types.ErrInvalidSigner.ABCICode(): {
+		LangEnglish:    "The signer is not the governance authority of the identity module",
+		LangVietnamese: "Người ký không phải là cơ quan quản trị của module danh tính",
+	},
+	types.ErrSample.ABCICode(): {
+		LangEnglish:    "Sample error",
+		LangVietnamese: "Lỗi mẫu",
+	},
+	types.ErrCccdAlreadyRegistered.ABCICode(): {
+		LangEnglish:    "This CCCD ID is already registered to another address",
+		LangVietnamese: "Số CCCD này đã được đăng ký cho một địa chỉ khác",
+	},
+	types.ErrUnsupportedHashScheme.ABCICode(): {
+		LangEnglish:    "The CCCD hash scheme is not supported by the chain",
+		LangVietnamese: "Chuỗi không hỗ trợ thuật toán băm CCCD này",
+	},
+	types.ErrInvalidStatus.ABCICode(): {
+		LangEnglish:    "The identity cannot move to the requested status",
+		LangVietnamese: "Không thể chuyển danh tính sang trạng thái được yêu cầu",
+	},
+	types.ErrUnknownVerifier.ABCICode(): {
+		LangEnglish:    "The signer is not a registered verifier",
+		LangVietnamese: "Người ký không phải là đơn vị xác thực đã đăng ký",
+	},
+	types.ErrReceiverNoIdentity.ABCICode(): {
+		LangEnglish:    "The receiver of the IBC transfer has no active identity",
+		LangVietnamese: "Người nhận giao dịch IBC chưa có danh tính hợp lệ",
+	},
+	types.ErrSignerNotRegistered.ABCICode(): {
+		LangEnglish:    "Signer {address} has not registered an identity (message {msg_index}, {msg_type})",
+		LangVietnamese: "Người gửi {address} chưa đăng ký danh tính (message {msg_index}, {msg_type})",
+	},
+	types.ErrIdentityPending.ABCICode(): {
+		LangEnglish:    "The identity of signer {address} is not attested by a verifier yet (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} chưa được xác thực (message {msg_index}, {msg_type})",
+	},
+	types.ErrIdentitySuspended.ABCICode(): {
+		LangEnglish:    "The identity of signer {address} is suspended (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} đang bị đình chỉ (message {msg_index}, {msg_type})",
+	},
+	types.ErrIdentityRevoked.ABCICode(): {
+		LangEnglish:    "The identity of signer {address} is revoked (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} đã bị thu hồi (message {msg_index}, {msg_type})",
+	},
+	types.ErrRecipientNotRegistered.ABCICode(): {
+		LangEnglish:    "Recipient {address} has not registered an identity (message {msg_index}, {msg_type})",
+		LangVietnamese: "Người nhận {address} chưa đăng ký danh tính (message {msg_index}, {msg_type})",
+	},
+	types.ErrRecipientNotActive.ABCICode(): {
+		LangEnglish:    "The identity of recipient {address} is not active (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người nhận {address} không còn hiệu lực (message {msg_index}, {msg_type})",
+	},
+	types.ErrRecipientUnresolvable.ABCICode(): {
+		LangEnglish:    "The recipients of message {msg_index} ({msg_type}) cannot be determined",
+		LangVietnamese: "Không xác định được người nhận của message {msg_index} ({msg_type})",
+	},
+	types.ErrSoulboundNFT.ABCICode(): {
+		LangEnglish:    "Identity NFTs cannot be transferred; {address} keeps it (message {msg_index}, {msg_type})",
+		LangVietnamese: "NFT danh tính không thể chuyển nhượng; {address} vẫn giữ nó (message {msg_index}, {msg_type})",
+	},
+	types.ErrOperatorNoIdentity.ABCICode(): {
+		LangEnglish:    "Validator operator {address} has no active identity (message {msg_index}, {msg_type})",
+		LangVietnamese: "Người vận hành validator {address} chưa có danh tính hợp lệ (message {msg_index}, {msg_type})",
+	},
+	types.ErrOperatorIdentityLevel.ABCICode(): {
+		LangEnglish:    "The identity of validator operator {address} is not verified to the level validators require (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người vận hành validator {address} chưa đạt mức xác thực yêu cầu (message {msg_index}, {msg_type})",
+	},
+	types.ErrInvalidDidDocument.ABCICode(): {
+		LangEnglish:    "The DID document is invalid",
+		LangVietnamese: "Tài liệu DID không hợp lệ",
+	},
+	types.ErrDidExists.ABCICode(): {
+		LangEnglish:    "A DID document already exists for this address",
+		LangVietnamese: "Địa chỉ này đã có tài liệu DID",
+	},
+	types.ErrDidNotFound.ABCICode(): {
+		LangEnglish:    "The DID document was not found",
+		LangVietnamese: "Không tìm thấy tài liệu DID",
+	},
+	types.ErrDidDeactivated.ABCICode(): {
+		LangEnglish:    "The DID document is deactivated",
+		LangVietnamese: "Tài liệu DID đã bị vô hiệu hóa",
+	},
+	types.ErrUnknownIssuer.ABCICode(): {
+		LangEnglish:    "The signer is not an active credential issuer",
+		LangVietnamese: "Người ký không phải là đơn vị cấp chứng nhận đang hoạt động",
+	},
+	types.ErrCredentialRevoked.ABCICode(): {
+		LangEnglish:    "The credential is revoked",
+		LangVietnamese: "Chứng nhận đã bị thu hồi",
+	},
+	types.ErrMissingAttribute.ABCICode(): {
+		LangEnglish:    "Signer {address} has not proven the attribute {attribute} (message {msg_index}, {msg_type})",
+		LangVietnamese: "Người gửi {address} chưa chứng minh thuộc tính {attribute} (message {msg_index}, {msg_type})",
+	},
+	types.ErrInvalidAttributeProof.ABCICode(): {
+		LangEnglish:    "The attribute proof is invalid",
+		LangVietnamese: "Bằng chứng thuộc tính không hợp lệ",
+	},
+	types.ErrIdentityLevelTooLow.ABCICode(): {
+		LangEnglish:    "The identity of signer {address} is {level}, the message requires {required_level} (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} ở mức {level}, message yêu cầu mức {required_level} (message {msg_index}, {msg_type})",
+	},
+	types.ErrTransferLimitExceeded.ABCICode(): {
+		LangEnglish:    "Sender {address} ({level}) sends {amount} in this transaction, over the limit of {limit} per transaction (message {msg_index}, {msg_type})",
+		LangVietnamese: "Người gửi {address} ({level}) chuyển {amount} trong giao dịch này, vượt hạn mức {limit} mỗi giao dịch (message {msg_index}, {msg_type})",
+	},
+	types.ErrSpendLimitExceeded.ABCICode(): {
+		LangEnglish:    "The identity of sender {address} ({level}) would send {amount} within {window} seconds, over its limit of {limit} (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} ({level}) sẽ chuyển {amount} trong {window} giây, vượt hạn mức {limit} (message {msg_index}, {msg_type})",
+	},
+	types.ErrTxCountLimitExceeded.ABCICode(): {
+		LangEnglish:    "The identity of sender {address} ({level}) would send {tx_count} transactions within {window} seconds, over its limit of {limit} (message {msg_index}, {msg_type})",
+		LangVietnamese: "Danh tính của người gửi {address} ({level}) sẽ thực hiện {tx_count} giao dịch trong {window} giây, vượt hạn mức {limit} giao dịch (message {msg_index}, {msg_type})",
+	},
+}
+
+// placeholders are the details messages may refer to. Details missing from
+// the error render as "?".
+var placeholders = []string{
+	types.AttributeKeyAddress,
+	types.AttributeKeyMsgIndex,
+	types.AttributeKeyMsgType,
+	types.AttributeKeyAttribute,
+	types.AttributeKeyLevel,
+	types.AttributeKeyRequiredLevel,
+	types.AttributeKeyAmount,
+	types.AttributeKeyLimit,
+	types.AttributeKeyTxCount,
+	types.AttributeKeyWindow,
+}
+
+// levelNames are the localized names of the identity levels, which the
+// level details carry as their enum names.
+var levelNames = map[string]map[string]string{
+	types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED.String(): {
+		LangEnglish:    "self-declared",
+		LangVietnamese: "tự khai báo",
+	},
+	types.IdentityLevel_IDENTITY_LEVEL_VERIFIED.String(): {
+		LangEnglish:    "verified",
+		LangVietnamese: "đã xác thực",
+	},
+	types.IdentityLevel_IDENTITY_LEVEL_ENHANCED.String(): {
+		LangEnglish:    "enhanced",
+		LangVietnamese: "xác thực nâng cao",
+	},
+}
+
+// detailPattern matches the key=value details of types.RejectionFormat.
+var detailPattern = regexp.MustCompile(`([a-z_]+)=([^\s:]+)`)
+
+// Rejection is an identity module error decoded from a transaction result.
+type Rejection struct {
+	Code    uint32
+	Log     string
+	Details map[string]string
+}
+
+// ParseRejection decodes the error of a transaction result. It returns false
+// for errors of other modules.
+func ParseRejection(codespace string, code uint32, log string) (Rejection, bool) {
+	if codespace != types.ModuleName {
+		return Rejection{}, false
+	}
+
+	details := make(map[string]string)
+	for _, match := range detailPattern.FindAllStringSubmatch(log, -1) {
+		details[match[1]] = match[2]
+	}
+	return Rejection{Code: code, Log: log, Details: details}, true
+}
+
+// Message renders r in lang, falling back to English for unknown languages
+// and to the raw log for codes without a translation.
+func (r Rejection) Message(lang string) string {
+	texts, ok := messages[r.Code]
+	if !ok {
+		return r.Log
+	}
+	text, ok := texts[lang]
+	if !ok {
+		lang, text = LangEnglish, texts[LangEnglish]
+	}
+
+	for _, key := range placeholders {
+		value, ok := r.Details[key]
+		if !ok {
+			value = "?"
+		}
+		if key == types.AttributeKeyLevel || key == types.AttributeKeyRequiredLevel {
+			if name, ok := levelNames[value][lang]; ok {
+				value = name
+			}
+		}
+		text = strings.ReplaceAll(text, "{"+key+"}", value)
+	}
+	return text
+}
+
+// Language returns the language errors are rendered in: --lang, else the
+// NEXELRA_LANG environment variable, else Vietnamese for a vi locale and
+// English otherwise.
+func Language(cmd *cobra.Command) string {
+	if lang, _ := cmd.Flags().GetString(FlagLang); lang != "" {
+		return lang
+	}
+	if lang := os.Getenv(EnvLang); lang != "" {
+		return lang
+	}
+	if strings.HasPrefix(os.Getenv("LANG"), LangVietnamese) {
+		return LangVietnamese
+	}
+	return LangEnglish
+}
+
+func addLangFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagLang, "", "Language of error messages (en or vi); defaults to $"+EnvLang+" or the locale")
+}
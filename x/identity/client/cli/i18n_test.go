@@ -0,0 +1,127 @@
+package cli_test
+
+import (
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/client/cli"
+	"Nexelra/x/identity/types"
+)
+
+func TestRejectionMessage(t *testing.T) {
+	addr := sample.AccAddress()
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+
+	tests := []struct {
+		desc string
+		err  error
+		lang string
+		want string
+	}{
+		{
+			desc: "english",
+			err:  errorsmod.Wrapf(types.ErrRecipientNotRegistered, types.RejectionFormat, addr, 1, msgType),
+			lang: cli.LangEnglish,
+			want: "Recipient " + addr + " has not registered an identity (message 1, " + msgType + ")",
+		},
+		{
+			desc: "vietnamese",
+			err:  errorsmod.Wrapf(types.ErrSignerNotRegistered, types.RejectionFormat, addr, 0, msgType),
+			lang: cli.LangVietnamese,
+			want: "Người gửi " + addr + " chưa đăng ký danh tính (message 0, " + msgType + ")",
+		},
+		{
+			desc: "unknown language falls back to english",
+			err:  errorsmod.Wrapf(types.ErrIdentityRevoked, types.RejectionFormat, addr, 0, msgType),
+			lang: "fr",
+			want: "The identity of signer " + addr + " is revoked (message 0, " + msgType + ")",
+		},
+		{
+			desc: "missing details",
+			err:  types.ErrIdentitySuspended,
+			lang: cli.LangEnglish,
+			want: "The identity of signer ? is suspended (message ?, ?)",
+		},
+		{
+			desc: "missing attribute",
+			err:  errorsmod.Wrapf(types.ErrMissingAttribute, types.RejectionFormat+" attribute=%s", addr, 0, msgType, "age-over-18"),
+			lang: cli.LangVietnamese,
+			want: "Người gửi " + addr + " chưa chứng minh thuộc tính age-over-18 (message 0, " + msgType + ")",
+		},
+		{
+			desc: "level too low",
+			err: errorsmod.Wrapf(types.ErrIdentityLevelTooLow, types.RejectionFormat+" level=%s required_level=%s", addr, 0, msgType,
+				types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED),
+			lang: cli.LangEnglish,
+			want: "The identity of signer " + addr + " is self-declared, the message requires verified (message 0, " + msgType + ")",
+		},
+		{
+			desc: "level too low in vietnamese",
+			err: errorsmod.Wrapf(types.ErrIdentityLevelTooLow, types.RejectionFormat+" level=%s required_level=%s", addr, 0, msgType,
+				types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, types.IdentityLevel_IDENTITY_LEVEL_ENHANCED),
+			lang: cli.LangVietnamese,
+			want: "Danh tính của người gửi " + addr + " ở mức đã xác thực, message yêu cầu mức xác thực nâng cao (message 0, " + msgType + ")",
+		},
+		{
+			desc: "transfer limit",
+			err: errorsmod.Wrapf(types.ErrTransferLimitExceeded, types.RejectionFormat+" level=%s amount=%s limit=%s", addr, 1, msgType,
+				types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, "101stake", "100stake"),
+			lang: cli.LangEnglish,
+			want: "Sender " + addr + " (verified) sends 101stake in this transaction, over the limit of 100stake per transaction (message 1, " + msgType + ")",
+		},
+		{
+			desc: "spend limit",
+			err: errorsmod.Wrapf(types.ErrSpendLimitExceeded, types.RejectionFormat+" level=%s amount=%s limit=%s window=%d", addr, 0, msgType,
+				types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, "101stake,5uatom", "100stake", 86400),
+			lang: cli.LangVietnamese,
+			want: "Danh tính của người gửi " + addr + " (đã xác thực) sẽ chuyển 101stake,5uatom trong 86400 giây, vượt hạn mức 100stake (message 0, " + msgType + ")",
+		},
+		{
+			desc: "transaction count limit",
+			err: errorsmod.Wrapf(types.ErrTxCountLimitExceeded, types.RejectionFormat+" level=%s tx_count=%d limit=%d window=%d", addr, 0, msgType,
+				types.IdentityLevel_IDENTITY_LEVEL_ENHANCED, 4, 3, 3600),
+			lang: cli.LangEnglish,
+			want: "The identity of sender " + addr + " (enhanced) would send 4 transactions within 3600 seconds, over its limit of 3 (message 0, " + msgType + ")",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			codespace, code, log := errorsmod.ABCIInfo(tc.err, false)
+			rejection, ok := cli.ParseRejection(codespace, code, log)
+			require.True(t, ok)
+			require.Equal(t, tc.want, rejection.Message(tc.lang))
+		})
+	}
+}
+
+// TestRejectionMessageCodes checks that every error code the module
+// registers has a message in each language.
+func TestRejectionMessageCodes(t *testing.T) {
+	code := types.ErrInvalidSigner.ABCICode()
+	for ; ; code++ {
+		var registered *errorsmod.Error
+		require.True(t, errors.As(errorsmod.ABCIError(types.ModuleName, code, "log"), &registered))
+		if registered.Error() == "unknown" {
+			break
+		}
+
+		rejection, ok := cli.ParseRejection(types.ModuleName, code, "log")
+		require.True(t, ok)
+		english, vietnamese := rejection.Message(cli.LangEnglish), rejection.Message(cli.LangVietnamese)
+		require.NotEqual(t, "log", english, "code %d", code)
+		// a missing translation would fall back to english
+		require.NotEqual(t, english, vietnamese, "code %d", code)
+	}
+	require.Greater(t, code, types.ErrTxCountLimitExceeded.ABCICode())
+}
+
+func TestParseRejectionOtherModule(t *testing.T) {
+	codespace, code, log := errorsmod.ABCIInfo(sdkerrors.ErrInsufficientFunds, false)
+	_, ok := cli.ParseRejection(codespace, code, log)
+	require.False(t, ok)
+}
@@ -0,0 +1,359 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+// IdentityProof proves, against the app hash of a block, the identity of an
+// address, or that it has none. It is what the prove command prints and what
+// the verify-proof command reads.
+type IdentityProof struct {
+	Address string `json:"address"`
+	// Height is the height of the proven state. Its app hash is the one in
+	// the header of the next block.
+	Height int64 `json:"height"`
+	// Key is the key of the identity in the identity store.
+	Key []byte `json:"key"`
+	// Value is the stored identity, empty if the address has none.
+	Value []byte `json:"value,omitempty"`
+	// Identity is Value decoded, for reading only: verify-proof decodes
+	// Value itself.
+	Identity json.RawMessage `json:"identity,omitempty"`
+	// Proof is the ICS-23 merkle proof of Key, from the identity store up to
+	// the app hash: a membership proof if Value is set, a non-membership
+	// proof otherwise.
+	Proof json.RawMessage `json:"proof"`
+}
+
+// CmdProveIdentity queries the identity of an address with a merkle proof.
+func CmdProveIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove [address]",
+		Short: "Query the identity of an address with an ICS-23 proof",
+		Long: `Query the identity record of an address together with an ICS-23 merkle proof
+of it, or of its absence, against the app hash of the next block header.
+
+Save the output and check it with verify-proof against a header obtained from
+a trusted source, such as a light client, to rely on the answer without
+trusting the node that served it.`,
+		Example: fmt.Sprintf("%s query %s prove [address] --height 100 > proof.json", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("store/%s/key", types.StoreKey),
+				Data:   types.IdentityStoreKey(addr),
+				Height: clientCtx.Height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+
+			proof, err := NewIdentityProof(clientCtx.Codec, addr, res)
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(proof)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdVerifyProof checks an identity proof against a block header. It does
+// not contact the node.
+func CmdVerifyProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [proof-file] [header-file]",
+		Short: "Verify an identity proof against a block header, offline",
+		Long: `Verify an identity proof printed by the prove command against the app hash
+of a block header, the one of the block after the proven height.
+
+The header is JSON: a CometBFT header, as served by the /commit or /block RPC
+endpoints with or without their envelope, or the header printed by the block
+query command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var proof IdentityProof
+			if err := readJSONFile(args[0], &proof); err != nil {
+				return err
+			}
+			var header map[string]json.RawMessage
+			if err := readJSONFile(args[1], &header); err != nil {
+				return err
+			}
+
+			height, appHash, err := ParseHeader(header)
+			if err != nil {
+				return fmt.Errorf("invalid header: %w", err)
+			}
+			if height != proof.Height+1 {
+				return fmt.Errorf("the proof of height %d must be verified against the header of height %d, got %d", proof.Height, proof.Height+1, height)
+			}
+
+			identity, err := VerifyIdentityProof(clientCtx.Codec, proof, appHash)
+			if err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+
+			out := cmd.OutOrStdout()
+			if identity == nil {
+				fmt.Fprintf(out, "verified: %s has no identity at height %d\n", proof.Address, proof.Height)
+				return nil
+			}
+			fmt.Fprintf(out, "verified: %s has an identity with status %s at height %d\n", proof.Address, identity.Status, proof.Height)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewIdentityProof builds the identity proof of addr from the response of a
+// proven query of its key in the identity store.
+func NewIdentityProof(cdc codec.Codec, addr sdk.AccAddress, res abci.ResponseQuery) (IdentityProof, error) {
+	if res.ProofOps == nil {
+		return IdentityProof{}, errors.New("the node returned no proof")
+	}
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	if err != nil {
+		return IdentityProof{}, err
+	}
+
+	proof := IdentityProof{
+		Address: addr.String(),
+		Height:  res.Height,
+		Key:     types.IdentityStoreKey(addr),
+		Value:   res.Value,
+	}
+	if proof.Proof, err = cdc.MarshalJSON(&merkleProof); err != nil {
+		return IdentityProof{}, err
+	}
+
+	if len(res.Value) > 0 {
+		var identity types.Identity
+		if err := cdc.Unmarshal(res.Value, &identity); err != nil {
+			return IdentityProof{}, fmt.Errorf("invalid identity: %w", err)
+		}
+		if proof.Identity, err = cdc.MarshalJSON(&identity); err != nil {
+			return IdentityProof{}, err
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyIdentityProof verifies proof against appHash and returns the proven
+// identity, or nil if proof proves that the address has none.
+func VerifyIdentityProof(cdc codec.Codec, proof IdentityProof, appHash []byte) (*types.Identity, error) {
+	addr, err := sdk.AccAddressFromBech32(proof.Address)
+	if err != nil {
+		return nil, err
+	}
+	// the key is derived again so the proof cannot be about another address
+	key := types.IdentityStoreKey(addr)
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.UnmarshalJSON(proof.Proof, &merkleProof); err != nil {
+		return nil, err
+	}
+
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	if len(proof.Value) == 0 {
+		return nil, verifyIdentityAbsence(merkleProof, root, key)
+	}
+	path := commitmenttypes.NewMerklePath(types.StoreKey, string(key))
+	if err := merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, proof.Value); err != nil {
+		return nil, err
+	}
+
+	var identity types.Identity
+	if err := cdc.Unmarshal(proof.Value, &identity); err != nil {
+		return nil, fmt.Errorf("invalid identity: %w", err)
+	}
+	return &identity, nil
+}
+
+// verifyIdentityAbsence verifies that merkleProof proves the absence of key
+// from the identity store committed in root.
+//
+// MerkleProof.VerifyNonMembership cannot be used: the neighbours of an absent
+// identity are often entries of the store indexes, which have empty values,
+// and ics23 refuses to hash a leaf with an empty value. The non-existence
+// proof of the identity store is thus checked here as ics23 does, and only the
+// membership of the store root in the app hash is left to MerkleProof.
+func verifyIdentityAbsence(merkleProof commitmenttypes.MerkleProof, root commitmenttypes.MerkleRoot, key []byte) error {
+	specs := commitmenttypes.GetSDKSpecs()
+	if len(merkleProof.Proofs) != len(specs) {
+		return fmt.Errorf("expected %d proofs, got %d", len(specs), len(merkleProof.Proofs))
+	}
+	nonExist := merkleProof.Proofs[0].GetNonexist()
+	if nonExist == nil {
+		return errors.New("not a non-membership proof")
+	}
+
+	storeRoot, err := verifyNonExistence(specs[0], nonExist, key)
+	if err != nil {
+		return err
+	}
+
+	storeProof := commitmenttypes.MerkleProof{Proofs: merkleProof.Proofs[1:]}
+	return storeProof.VerifyMembership(specs[1:], root, commitmenttypes.NewMerklePath(types.StoreKey), storeRoot)
+}
+
+// verifyNonExistence checks proof against spec like
+// ics23.NonExistenceProof.Verify does, and returns the root it proves key
+// absent from.
+func verifyNonExistence(spec *ics23.ProofSpec, proof *ics23.NonExistenceProof, key []byte) ([]byte, error) {
+	if proof.Left == nil && proof.Right == nil {
+		return nil, errors.New("both left and right proofs missing")
+	}
+
+	var root []byte
+	for _, neighbour := range []*ics23.ExistenceProof{proof.Left, proof.Right} {
+		if neighbour == nil {
+			continue
+		}
+		calc, err := calculateExistence(spec, neighbour)
+		if err != nil {
+			return nil, err
+		}
+		if root != nil && !bytes.Equal(root, calc) {
+			return nil, errors.New("left and right proofs have different roots")
+		}
+		root = calc
+	}
+
+	switch {
+	case proof.Left != nil && bytes.Compare(key, proof.Left.Key) <= 0:
+		return nil, errors.New("key is not right of left proof")
+	case proof.Right != nil && bytes.Compare(key, proof.Right.Key) >= 0:
+		return nil, errors.New("key is not left of right proof")
+	case proof.Left == nil && !ics23.IsLeftMost(spec.InnerSpec, proof.Right.Path):
+		return nil, errors.New("left proof missing, right proof must be left-most")
+	case proof.Right == nil && !ics23.IsRightMost(spec.InnerSpec, proof.Left.Path):
+		return nil, errors.New("right proof missing, left proof must be right-most")
+	case proof.Left != nil && proof.Right != nil && !ics23.IsLeftNeighbor(spec.InnerSpec, proof.Left.Path, proof.Right.Path):
+		return nil, errors.New("left and right proofs are not neighbours")
+	}
+
+	return root, nil
+}
+
+// calculateExistence checks proof against spec and returns the root it
+// proves its key and value in, even if the value is empty.
+func calculateExistence(spec *ics23.ProofSpec, proof *ics23.ExistenceProof) ([]byte, error) {
+	if err := proof.CheckAgainstSpec(spec); err != nil {
+		return nil, err
+	}
+	if len(proof.Value) > 0 {
+		return proof.Calculate()
+	}
+
+	// The IAVL leaf op, which CheckAgainstSpec enforced, prefixes the value
+	// hash with its length; passing the hash of the empty value to the same
+	// op without prehashing yields the same leaf hash.
+	leaf := *proof.Leaf
+	leaf.PrehashValue = ics23.HashOp_NO_HASH
+	emptyHash := sha256.Sum256(nil)
+	res, err := leaf.Apply(proof.Key, emptyHash[:])
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range proof.Path {
+		if res, err = step.Apply(res); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// ParseHeader returns the height and app hash of a block header in JSON. The
+// header may be wrapped in a result, signed_header or block object, and its
+// app hash hex encoded, as CometBFT serves it, or base64 encoded, as the SDK
+// prints it.
+func ParseHeader(header map[string]json.RawMessage) (int64, []byte, error) {
+	for _, field := range []string{"result", "signed_header", "block", "header"} {
+		if _, ok := header["app_hash"]; ok {
+			break
+		}
+		if nested, ok := header[field]; ok {
+			header = nil
+			if err := json.Unmarshal(nested, &header); err != nil {
+				return 0, nil, fmt.Errorf("invalid %s: %w", field, err)
+			}
+		}
+	}
+
+	var appHashStr string
+	if err := json.Unmarshal(header["app_hash"], &appHashStr); err != nil {
+		return 0, nil, errors.New("no app_hash")
+	}
+	appHash, err := hex.DecodeString(appHashStr)
+	if err != nil {
+		if appHash, err = base64.StdEncoding.DecodeString(appHashStr); err != nil {
+			return 0, nil, fmt.Errorf("app_hash %q is neither hex nor base64", appHashStr)
+		}
+	}
+
+	// heights are JSON strings, as int64 are in CometBFT and proto JSON
+	var heightStr string
+	if err := json.Unmarshal(header["height"], &heightStr); err != nil {
+		return 0, nil, errors.New("no height")
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid height: %w", err)
+	}
+
+	return height, appHash, nil
+}
+
+func readJSONFile(path string, v any) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return fmt.Errorf("invalid %s: %w", path, err)
+	}
+	return nil
+}
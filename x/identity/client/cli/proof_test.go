@@ -0,0 +1,33 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/client/cli"
+)
+
+func TestParseHeader(t *testing.T) {
+	for name, header := range map[string]string{
+		"rpc commit":   `{"jsonrpc":"2.0","result":{"signed_header":{"header":{"height":"12","app_hash":"0A0B"}}}}`,
+		"rpc block":    `{"block":{"header":{"height":"12","app_hash":"0a0b"}}}`,
+		"block query":  `{"header":{"height":"12","app_hash":"Cgs="}}`,
+		"plain header": `{"height":"12","app_hash":"0A0B"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var v map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(header), &v))
+			height, appHash, err := cli.ParseHeader(v)
+			require.NoError(t, err)
+			require.Equal(t, int64(12), height)
+			require.Equal(t, []byte{0x0a, 0x0b}, appHash)
+		})
+	}
+
+	var v map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(`{"header":{"height":"12"}}`), &v))
+	_, _, err := cli.ParseHeader(v)
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+// GetQueryCmd returns the query commands for this module that need more
+// than autocli offers. The remaining commands are generated by autocli.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdExplainError())
+	cmd.AddCommand(CmdExplainTx())
+	cmd.AddCommand(CmdProveIdentity())
+	cmd.AddCommand(CmdVerifyProof())
+
+	return cmd
+}
+
+// CmdExplainError renders an identity error code, and the log it came with,
+// as a localized message. It does not contact the node.
+func CmdExplainError() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-error [code] [log]",
+		Short: "Explain an identity error code in English or Vietnamese",
+		Example: fmt.Sprintf(`%s query %s explain-error 1111 "address=cosmos1... msg_index=0 msg_type=/cosmos.bank.v1beta1.MsgSend" --%s vi`,
+			version.AppName, types.ModuleName, FlagLang),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			code, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid error code %q: %w", args[0], err)
+			}
+			var log string
+			if len(args) > 1 {
+				log = args[1]
+			}
+
+			rejection, _ := ParseRejection(types.ModuleName, uint32(code), log)
+			fmt.Fprintln(cmd.OutOrStdout(), rejection.Message(Language(cmd)))
+			return nil
+		},
+	}
+
+	addLangFlag(cmd)
+
+	return cmd
+}
+
+// CmdExplainTx looks up a transaction and renders its identity error, if it
+// failed with one, as a localized message.
+func CmdExplainTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain-tx [hash]",
+		Short: "Explain why a transaction was rejected by the identity gate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := authtx.QueryTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if res.Code == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "transaction succeeded")
+				return nil
+			}
+
+			rejection, ok := ParseRejection(res.Codespace, res.Code, res.RawLog)
+			if !ok {
+				fmt.Fprintf(cmd.OutOrStdout(), "not an identity error: %s (codespace %s, code %d)\n", res.RawLog, res.Codespace, res.Code)
+				return nil
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), rejection.Message(Language(cmd)))
+			return nil
+		},
+	}
+
+	addLangFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+// GetTxCmd returns the transaction commands for this module that need more
+// than autocli offers. The remaining commands are generated by autocli.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdCreateIdentity())
+	cmd.AddCommand(CmdUpdateIdentity())
+	// this line is used by starport scaffolding # 1
+
+	return cmd
+}
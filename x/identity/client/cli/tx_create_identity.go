@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+const (
+	FlagPepper     = "pepper"
+	FlagHashScheme = "hash-scheme"
+	FlagSalt       = "salt"
+)
+
+// CmdCreateIdentity registers an identity. The CCCD ID is turned into a
+// commitment locally, only the commitment is put in the transaction.
+func CmdCreateIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-identity [cccd-id]",
+		Short: "Create identity with CCCD ID",
+		Long: `Create identity with CCCD ID.
+
+The CCCD ID never leaves this machine: it is hashed with the chain pepper and
+hash scheme from the module params, which are queried from the node unless
+--pepper and --hash-scheme are given.
+
+The salted scheme also hashes a 32-byte salt, generated and printed unless
+--salt is given. Keep it: the verifier needs it to attest the commitment.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheme, commitment, err := commitmentFromFlags(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateIdentity(
+				clientCtx.GetFromAddress().String(),
+				commitment,
+				scheme,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.AddCommand(CmdComputeCommitment())
+	addCommitmentFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdComputeCommitment prints the commitment of a CCCD ID without sending a
+// transaction.
+func CmdComputeCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commitment [cccd-id]",
+		Short: "Compute the commitment of a CCCD ID locally",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, commitment, err := commitmentFromFlags(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(commitment + "\n")
+		},
+	}
+
+	addCommitmentFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addCommitmentFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagPepper, "", "Chain pepper; queried from the node when empty")
+	cmd.Flags().String(FlagHashScheme, "", fmt.Sprintf("Hash scheme (%s, %s or %s); queried from the node when empty",
+		types.HashScheme_HASH_SCHEME_SHA256, types.HashScheme_HASH_SCHEME_HMAC_SHA256, types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256))
+	cmd.Flags().String(FlagSalt, "", "Hex encoded salt of a salted commitment; generated and printed when empty")
+}
+
+// commitmentFromFlags resolves the pepper and hash scheme, from the flags or
+// else the node, and computes the commitment of cccdId. A salt it generates
+// is printed to stderr.
+func commitmentFromFlags(cmd *cobra.Command, clientCtx client.Context, cccdId string) (types.HashScheme, string, error) {
+	pepper, err := cmd.Flags().GetString(FlagPepper)
+	if err != nil {
+		return 0, "", err
+	}
+	schemeName, err := cmd.Flags().GetString(FlagHashScheme)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var scheme types.HashScheme
+	if pepper == "" || schemeName == "" {
+		res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to query params, set --%s and --%s to work offline: %w", FlagPepper, FlagHashScheme, err)
+		}
+		if pepper == "" {
+			pepper = res.Params.Pepper
+		}
+		scheme = res.Params.HashScheme
+	}
+	if schemeName != "" {
+		value, ok := types.HashScheme_value[schemeName]
+		if !ok {
+			return 0, "", fmt.Errorf("unknown hash scheme %q", schemeName)
+		}
+		scheme = types.HashScheme(value)
+	}
+
+	var salt []byte
+	if scheme == types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 {
+		if salt, err = saltFromFlags(cmd); err != nil {
+			return 0, "", err
+		}
+	}
+
+	commitment, err := types.ComputeCommitment(scheme, pepper, salt, cccdId)
+	if err != nil {
+		return 0, "", err
+	}
+	return scheme, commitment, nil
+}
+
+// saltFromFlags decodes the salt flag, or generates a salt and prints it if
+// the flag is empty.
+func saltFromFlags(cmd *cobra.Command) ([]byte, error) {
+	saltHex, err := cmd.Flags().GetString(FlagSalt)
+	if err != nil {
+		return nil, err
+	}
+	if saltHex != "" {
+		salt, err := hex.DecodeString(saltHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid salt: %w", err)
+		}
+		return salt, nil
+	}
+
+	salt := make([]byte, types.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	cmd.PrintErrf("salt: %x\nkeep it, the verifier needs it to attest the commitment\n", salt)
+	return salt, nil
+}
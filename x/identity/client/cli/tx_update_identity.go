@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"Nexelra/x/identity/types"
+)
+
+// CmdUpdateIdentity re-verifies the sender's identity with a new CCCD
+// commitment, computed locally like in create-identity.
+func CmdUpdateIdentity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-identity [cccd-id]",
+		Short: "Re-verify your identity with a CCCD ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheme, commitment, err := commitmentFromFlags(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateIdentity(
+				clientCtx.GetFromAddress().String(),
+				commitment,
+				scheme,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addCommitmentFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,199 @@
+// Package groth16 verifies Groth16 proofs over the BN254 curve, the curve
+// gnark and circom use by default, so attribute proofs can be checked inside
+// the state machine.
+//
+// The curve arithmetic and the pairing are gnark-crypto's ecc/bn254, the
+// library gnark's own verifier is built on. Points use the uncompressed
+// big-endian encoding of EIP-197, which is also what gnark's MarshalSolidity
+// writes: a G1 point is x‖y and a G2 point is x.A1‖x.A0‖y.A1‖y.A0, with 32
+// bytes per coordinate and the point at infinity encoded as zeros.
+package groth16
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const (
+	// G1Size and G2Size are the encoded sizes of G1 and G2 points.
+	G1Size = 64
+	G2Size = 128
+
+	// ProofSize is the encoded size of a proof, A‖B‖C.
+	ProofSize = 2*G1Size + G2Size
+)
+
+// Order is the number of elements in both G₁ and G₂, the modulus of the
+// scalar field public inputs live in.
+var Order = fr.Modulus()
+
+// ErrInvalidProof is returned for proofs that do not satisfy the pairing
+// equation of their verifying key.
+var ErrInvalidProof = errors.New("invalid groth16 proof")
+
+// VerifyingKey is a Groth16 verifying key.
+type VerifyingKey struct {
+	alpha              bn254.G1Affine
+	beta, gamma, delta bn254.G2Affine
+	// ic are the commitments to the public inputs, preceded by the constant
+	// term.
+	ic []bn254.G1Affine
+}
+
+// NewVerifyingKey decodes a verifying key from the encodings of its points.
+// ic holds one point more than the circuit has public inputs.
+func NewVerifyingKey(alpha, beta, gamma, delta []byte, ic [][]byte) (*VerifyingKey, error) {
+	var (
+		vk  VerifyingKey
+		err error
+	)
+	if vk.alpha, err = decodeG1(alpha); err != nil {
+		return nil, fmt.Errorf("alpha: %w", err)
+	}
+	if vk.beta, err = decodeG2(beta); err != nil {
+		return nil, fmt.Errorf("beta: %w", err)
+	}
+	if vk.gamma, err = decodeG2(gamma); err != nil {
+		return nil, fmt.Errorf("gamma: %w", err)
+	}
+	if vk.delta, err = decodeG2(delta); err != nil {
+		return nil, fmt.Errorf("delta: %w", err)
+	}
+
+	if len(ic) == 0 {
+		return nil, errors.New("ic: missing constant term")
+	}
+	vk.ic = make([]bn254.G1Affine, len(ic))
+	for i, bz := range ic {
+		if vk.ic[i], err = decodeG1(bz); err != nil {
+			return nil, fmt.Errorf("ic %d: %w", i, err)
+		}
+	}
+
+	return &vk, nil
+}
+
+// NumPublicInputs returns the number of public inputs of the circuit.
+func (vk *VerifyingKey) NumPublicInputs() int {
+	return len(vk.ic) - 1
+}
+
+// Proof is a Groth16 proof.
+type Proof struct {
+	a bn254.G1Affine
+	b bn254.G2Affine
+	c bn254.G1Affine
+}
+
+// ParseProof decodes a proof encoded as A‖B‖C.
+func ParseProof(bz []byte) (*Proof, error) {
+	if len(bz) != ProofSize {
+		return nil, fmt.Errorf("proof must have %d bytes, got %d", ProofSize, len(bz))
+	}
+
+	var (
+		proof Proof
+		err   error
+	)
+	if proof.a, err = decodeG1(bz[:G1Size]); err != nil {
+		return nil, fmt.Errorf("proof A: %w", err)
+	}
+	if proof.b, err = decodeG2(bz[G1Size : G1Size+G2Size]); err != nil {
+		return nil, fmt.Errorf("proof B: %w", err)
+	}
+	if proof.c, err = decodeG1(bz[G1Size+G2Size:]); err != nil {
+		return nil, fmt.Errorf("proof C: %w", err)
+	}
+
+	return &proof, nil
+}
+
+// Verify checks proof against vk for the given public inputs, which must be
+// elements of the scalar field.
+func (vk *VerifyingKey) Verify(proof *Proof, publicInputs []*big.Int) error {
+	if len(publicInputs) != vk.NumPublicInputs() {
+		return fmt.Errorf("circuit has %d public inputs, got %d", vk.NumPublicInputs(), len(publicInputs))
+	}
+
+	// l = ic₀ + Σ inputᵢ·icᵢ₊₁
+	var l, term bn254.G1Jac
+	l.FromAffine(&vk.ic[0])
+	for i, input := range publicInputs {
+		if input.Sign() < 0 || input.Cmp(Order) >= 0 {
+			return fmt.Errorf("public input %d is not in the scalar field", i)
+		}
+		term.FromAffine(&vk.ic[i+1])
+		term.ScalarMultiplication(&term, input)
+		l.AddAssign(&term)
+	}
+	var lAffine bn254.G1Affine
+	lAffine.FromJacobian(&l)
+
+	// e(A, B) = e(α, β)·e(l, γ)·e(C, δ)
+	var minusA bn254.G1Affine
+	minusA.Neg(&proof.a)
+	ok, err := bn254.PairingCheck(
+		[]bn254.G1Affine{minusA, vk.alpha, lAffine, proof.c},
+		[]bn254.G2Affine{proof.b, vk.beta, vk.gamma, vk.delta},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidProof
+	}
+
+	return nil
+}
+
+// decodeG1 decodes a point of G1, checking that it is on the curve. G1 has a
+// cofactor of one, so this also places it in the group.
+func decodeG1(bz []byte) (bn254.G1Affine, error) {
+	var c bn254.G1Affine
+	if len(bz) != G1Size {
+		return c, fmt.Errorf("G1 point must have %d bytes, got %d", G1Size, len(bz))
+	}
+
+	for i, element := range []*fp.Element{&c.X, &c.Y} {
+		if err := element.SetBytesCanonical(bz[32*i : 32*(i+1)]); err != nil {
+			return c, errors.New("coordinate is not in the base field")
+		}
+	}
+
+	// the point at infinity, zeros, passes the check
+	if !c.IsOnCurve() {
+		return c, errors.New("point is not on the curve")
+	}
+
+	return c, nil
+}
+
+// decodeG2 decodes a point of G2, checking that it is on the twist and in its
+// subgroup of order Order.
+func decodeG2(bz []byte) (bn254.G2Affine, error) {
+	var c bn254.G2Affine
+	if len(bz) != G2Size {
+		return c, fmt.Errorf("G2 point must have %d bytes, got %d", G2Size, len(bz))
+	}
+
+	// EIP-197 puts the imaginary part of each coordinate first
+	for i, element := range []*fp.Element{&c.X.A1, &c.X.A0, &c.Y.A1, &c.Y.A0} {
+		if err := element.SetBytesCanonical(bz[32*i : 32*(i+1)]); err != nil {
+			return c, errors.New("coordinate is not in the base field")
+		}
+	}
+
+	if !c.IsOnCurve() {
+		return c, errors.New("point is not on the curve")
+	}
+	if !c.IsInSubGroup() {
+		return c, errors.New("point is not in the G2 subgroup")
+	}
+
+	return c, nil
+}
@@ -0,0 +1,202 @@
+package groth16
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/stretchr/testify/require"
+)
+
+func scalar(t *testing.T) *big.Int {
+	t.Helper()
+	k, err := randomScalar(rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func readJSON(t *testing.T, name string, v any) {
+	t.Helper()
+	bz, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, v))
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+// TestEIP197Pairing runs the pairing test vectors of the EIP-197 precompile,
+// as shipped with go-ethereum's core/vm/testdata/precompiles, through the
+// point decoding and the pairing check.
+func TestEIP197Pairing(t *testing.T) {
+	var vectors []struct {
+		Name     string `json:"name"`
+		Input    string `json:"input"`
+		Expected bool   `json:"expected"`
+	}
+	readJSON(t, "testdata/eip197_pairing.json", &vectors)
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			input := decodeHex(t, v.Input)
+			require.Zero(t, len(input)%(G1Size+G2Size))
+
+			var (
+				g1 []bn254.G1Affine
+				g2 []bn254.G2Affine
+			)
+			for len(input) > 0 {
+				p, err := decodeG1(input[:G1Size])
+				require.NoError(t, err)
+				q, err := decodeG2(input[G1Size : G1Size+G2Size])
+				require.NoError(t, err)
+				g1, g2 = append(g1, p), append(g2, q)
+				input = input[G1Size+G2Size:]
+			}
+
+			// the empty product is one, gnark-crypto refuses to compute it and
+			// Verify always pairs four points
+			if len(g1) == 0 {
+				require.True(t, v.Expected)
+				return
+			}
+			ok, err := bn254.PairingCheck(g1, g2)
+			require.NoError(t, err)
+			require.Equal(t, v.Expected, ok)
+		})
+	}
+}
+
+// TestVerifyGnark checks a proof made by gnark against the verifying key of
+// its setup, see testdata/gnark.
+func TestVerifyGnark(t *testing.T) {
+	var v struct {
+		Alpha, Beta, Gamma, Delta string
+		IC                        []string
+		Proof                     string
+		PublicInputs              []string
+	}
+	readJSON(t, "testdata/gnark.json", &v)
+
+	var ic [][]byte
+	for _, s := range v.IC {
+		ic = append(ic, decodeHex(t, s))
+	}
+	vk, err := NewVerifyingKey(decodeHex(t, v.Alpha), decodeHex(t, v.Beta), decodeHex(t, v.Gamma), decodeHex(t, v.Delta), ic)
+	require.NoError(t, err)
+	require.Equal(t, len(v.PublicInputs), vk.NumPublicInputs())
+
+	proof, err := ParseProof(decodeHex(t, v.Proof))
+	require.NoError(t, err)
+
+	var inputs []*big.Int
+	for _, s := range v.PublicInputs {
+		input, ok := new(big.Int).SetString(s, 10)
+		require.True(t, ok)
+		inputs = append(inputs, input)
+	}
+	require.NoError(t, vk.Verify(proof, inputs))
+
+	// a later birth year limit than the proof was made for
+	other := append([]*big.Int(nil), inputs...)
+	other[3] = big.NewInt(2008)
+	require.ErrorIs(t, vk.Verify(proof, other), ErrInvalidProof)
+}
+
+func TestVerify(t *testing.T) {
+	inputs := []*big.Int{big.NewInt(2007), big.NewInt(79), scalar(t)}
+	simulator, err := NewSimulator(rand.Reader, len(inputs))
+	require.NoError(t, err)
+	vk, err := NewVerifyingKey(simulator.Alpha, simulator.Beta, simulator.Gamma, simulator.Delta, simulator.IC)
+	require.NoError(t, err)
+	require.Equal(t, 3, vk.NumPublicInputs())
+
+	proofBz, err := simulator.Prove(rand.Reader, inputs)
+	require.NoError(t, err)
+
+	proof, err := ParseProof(proofBz)
+	require.NoError(t, err)
+	require.NoError(t, vk.Verify(proof, inputs))
+
+	t.Run("other inputs", func(t *testing.T) {
+		other := []*big.Int{big.NewInt(2008), inputs[1], inputs[2]}
+		require.ErrorIs(t, vk.Verify(proof, other), ErrInvalidProof)
+	})
+
+	t.Run("input count", func(t *testing.T) {
+		require.ErrorContains(t, vk.Verify(proof, inputs[:2]), "public inputs")
+	})
+
+	t.Run("input out of the scalar field", func(t *testing.T) {
+		other := []*big.Int{new(big.Int).Add(inputs[0], Order), inputs[1], inputs[2]}
+		require.ErrorContains(t, vk.Verify(proof, other), "scalar field")
+	})
+
+	t.Run("tampered proof", func(t *testing.T) {
+		_, _, g1Gen, _ := bn254.Generators()
+		tampered, err := ParseProof(append(encodeG1(&g1Gen), proofBz[G1Size:]...))
+		require.NoError(t, err)
+		require.ErrorIs(t, vk.Verify(tampered, inputs), ErrInvalidProof)
+	})
+
+	t.Run("malformed proof", func(t *testing.T) {
+		_, err := ParseProof(proofBz[1:])
+		require.Error(t, err)
+
+		offCurve := append([]byte(nil), proofBz...)
+		offCurve[G1Size-1] ^= 1
+		_, err = ParseProof(offCurve)
+		require.ErrorContains(t, err, "not on the curve")
+
+		outOfField := append([]byte(nil), proofBz...)
+		copy(outOfField[:32], fp.Modulus().Bytes())
+		_, err = ParseProof(outOfField)
+		require.ErrorContains(t, err, "base field")
+	})
+}
+
+func TestDecodeG2(t *testing.T) {
+	_, _, _, g2Gen := bn254.Generators()
+
+	// the point at infinity
+	_, err := decodeG2(make([]byte, G2Size))
+	require.NoError(t, err)
+
+	// EIP-197 orders the coordinates imaginary part first
+	raw := g2Gen.RawBytes()
+	swapped := append(append([]byte(nil), raw[32:64]...), raw[:32]...)
+	swapped = append(append(swapped, raw[96:]...), raw[64:96]...)
+	_, err = decodeG2(swapped)
+	require.ErrorContains(t, err, "not on the curve")
+
+	// a point of the twist outside of the subgroup: b' = y² - x³ for the
+	// generator, then the first x with a square root
+	var b, y2 bn254.G2Affine
+	b.Y.Square(&g2Gen.Y)
+	y2.X.Square(&g2Gen.X).Mul(&y2.X, &g2Gen.X)
+	b.Y.Sub(&b.Y, &y2.X)
+
+	var c bn254.G2Affine
+	for i := uint64(1); ; i++ {
+		c.X.A0.SetUint64(i)
+		y2.Y.Square(&c.X).Mul(&y2.Y, &c.X).Add(&y2.Y, &b.Y)
+		if y2.Y.Legendre() == 1 {
+			c.Y.Sqrt(&y2.Y)
+			break
+		}
+	}
+	require.True(t, c.IsOnCurve())
+	require.False(t, c.IsInSubGroup())
+	_, err = decodeG2(encodeG2(&c))
+	require.ErrorContains(t, err, "not in the G2 subgroup")
+}
@@ -0,0 +1,113 @@
+package groth16
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+)
+
+// Simulator is a verifying key set up with a known trapdoor, which it uses to
+// simulate proofs of arbitrary public inputs. A real trusted setup destroys
+// the trapdoor; Simulator is meant for tests that need valid proofs without
+// a circuit and a prover.
+type Simulator struct {
+	alpha, beta, gamma, delta *big.Int
+	ic                        []*big.Int
+
+	// Alpha, Beta, Gamma, Delta and IC are the encoded verifying key.
+	Alpha, Beta, Gamma, Delta []byte
+	IC                        [][]byte
+}
+
+// NewSimulator sets up a verifying key for numPublicInputs public inputs,
+// reading its trapdoor from r.
+func NewSimulator(r io.Reader, numPublicInputs int) (*Simulator, error) {
+	var (
+		s   Simulator
+		err error
+	)
+	for _, k := range []**big.Int{&s.alpha, &s.beta, &s.gamma, &s.delta} {
+		if *k, err = randomScalar(r); err != nil {
+			return nil, err
+		}
+	}
+	s.ic = make([]*big.Int, numPublicInputs+1)
+	for i := range s.ic {
+		if s.ic[i], err = randomScalar(r); err != nil {
+			return nil, err
+		}
+	}
+
+	s.Alpha = encodeG1(new(bn254.G1Affine).ScalarMultiplicationBase(s.alpha))
+	s.Beta = encodeG2(new(bn254.G2Affine).ScalarMultiplicationBase(s.beta))
+	s.Gamma = encodeG2(new(bn254.G2Affine).ScalarMultiplicationBase(s.gamma))
+	s.Delta = encodeG2(new(bn254.G2Affine).ScalarMultiplicationBase(s.delta))
+	for _, k := range s.ic {
+		s.IC = append(s.IC, encodeG1(new(bn254.G1Affine).ScalarMultiplicationBase(k)))
+	}
+
+	return &s, nil
+}
+
+// Prove returns the encoding of a proof of publicInputs, which must be
+// elements of the scalar field.
+func (s *Simulator) Prove(r io.Reader, publicInputs []*big.Int) ([]byte, error) {
+	a, err := randomScalar(r)
+	if err != nil {
+		return nil, err
+	}
+	b, err := randomScalar(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// l = ic₀ + Σ inputᵢ·icᵢ₊₁
+	l := new(big.Int).Set(s.ic[0])
+	for i, input := range publicInputs {
+		l.Add(l, new(big.Int).Mul(input, s.ic[i+1]))
+	}
+
+	// c = (a·b - α·β - l·γ) / δ, so that e(A, B) = e(α, β)·e(l, γ)·e(C, δ)
+	c := new(big.Int).Mul(a, b)
+	c.Sub(c, new(big.Int).Mul(s.alpha, s.beta))
+	c.Sub(c, new(big.Int).Mul(l, s.gamma))
+	c.Mul(c, new(big.Int).ModInverse(s.delta, Order))
+	c.Mod(c, Order)
+
+	proof := encodeG1(new(bn254.G1Affine).ScalarMultiplicationBase(a))
+	proof = append(proof, encodeG2(new(bn254.G2Affine).ScalarMultiplicationBase(b))...)
+	return append(proof, encodeG1(new(bn254.G1Affine).ScalarMultiplicationBase(c))...), nil
+}
+
+// randomScalar reads a non-zero element of the scalar field from r.
+func randomScalar(r io.Reader) (*big.Int, error) {
+	for {
+		k, err := rand.Int(r, Order)
+		if err != nil || k.Sign() > 0 {
+			return k, err
+		}
+	}
+}
+
+// encodeG1 encodes a point of G1.
+func encodeG1(c *bn254.G1Affine) []byte {
+	if c.IsInfinity() {
+		return make([]byte, G1Size)
+	}
+
+	// gnark-crypto's uncompressed encoding is EIP-197's for finite points
+	raw := c.RawBytes()
+	return raw[:]
+}
+
+// encodeG2 encodes a point of G2.
+func encodeG2(c *bn254.G2Affine) []byte {
+	if c.IsInfinity() {
+		return make([]byte, G2Size)
+	}
+
+	raw := c.RawBytes()
+	return raw[:]
+}
@@ -0,0 +1,144 @@
+// Command gnark writes the gnark test vector of the groth16 package: a
+// verifying key, a proof and its public inputs produced by gnark itself, in
+// the encoding attribute circuits are registered with.
+//
+// It lives in testdata so that gnark stays out of the module's dependencies.
+// To regenerate ../gnark.json, copy it into a module requiring
+// github.com/consensys/gnark v0.13.0 and run it from this directory.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	nativemimc "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/hash/mimc"
+)
+
+// ageCircuit proves a birth year in or before MaxYear, bound to a MiMC
+// commitment split over IdHashHi and IdHashLo. It has the public input layout
+// of the attribute circuits, not their commitment scheme.
+type ageCircuit struct {
+	IdHashHi frontend.Variable `gnark:",public"`
+	IdHashLo frontend.Variable `gnark:",public"`
+	Address  frontend.Variable `gnark:",public"`
+	MaxYear  frontend.Variable `gnark:",public"`
+
+	BirthYear frontend.Variable
+	Salt      frontend.Variable
+}
+
+func (c *ageCircuit) Define(api frontend.API) error {
+	api.AssertIsLessOrEqual(c.BirthYear, c.MaxYear)
+
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	h.Write(c.BirthYear, c.Salt, c.IdHashLo)
+	api.AssertIsEqual(h.Sum(), c.IdHashHi)
+	api.AssertIsDifferent(c.Address, 0)
+	return nil
+}
+
+type vector struct {
+	Alpha        string   `json:"alpha"`
+	Beta         string   `json:"beta"`
+	Gamma        string   `json:"gamma"`
+	Delta        string   `json:"delta"`
+	IC           []string `json:"ic"`
+	Proof        string   `json:"proof"`
+	PublicInputs []string `json:"publicInputs"`
+}
+
+func main() {
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &ageCircuit{})
+	check(err)
+	pk, vk, err := groth16.Setup(ccs)
+	check(err)
+
+	// the commitment the circuit expects, computed by running it once
+	assignment := &ageCircuit{
+		IdHashLo:  "123456789",
+		Address:   "0x1c3a9f0e5b7d2c4a6e8f0a1b3c5d7e9f01234567",
+		MaxYear:   2007,
+		BirthYear: 1990,
+		Salt:      "987654321987654321",
+	}
+	assignment.IdHashHi = mimcOf(1990, "987654321987654321", "123456789")
+
+	witness, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	check(err)
+	proof, err := groth16.Prove(ccs, pk, witness)
+	check(err)
+	public, err := witness.Public()
+	check(err)
+	check(groth16.Verify(proof, vk, public))
+
+	bnVk := vk.(*groth16bn254.VerifyingKey)
+	out := vector{
+		Alpha: g1Hex(bnVk.G1.Alpha),
+		Beta:  g2Hex(bnVk.G2.Beta),
+		Gamma: g2Hex(bnVk.G2.Gamma),
+		Delta: g2Hex(bnVk.G2.Delta),
+		Proof: hex.EncodeToString(proof.(*groth16bn254.Proof).MarshalSolidity()),
+	}
+	for _, k := range bnVk.G1.K {
+		out.IC = append(out.IC, g1Hex(k))
+	}
+	for _, input := range []frontend.Variable{assignment.IdHashHi, assignment.IdHashLo, assignment.Address, assignment.MaxYear} {
+		var e fr.Element
+		_, err := e.SetInterface(input)
+		check(err)
+		out.PublicInputs = append(out.PublicInputs, e.String())
+	}
+
+	f, err := os.Create("../gnark.json")
+	check(err)
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	check(enc.Encode(out))
+	check(f.Close())
+}
+
+// mimcOf computes the MiMC hash of elements outside of a circuit.
+func mimcOf(elements ...interface{}) string {
+	h := nativemimc.NewMiMC()
+	for _, element := range elements {
+		var e fr.Element
+		_, err := e.SetInterface(element)
+		check(err)
+		b := e.Bytes()
+		_, err = h.Write(b[:])
+		check(err)
+	}
+	var sum fr.Element
+	sum.SetBytes(h.Sum(nil))
+	return sum.String()
+}
+
+// g1Hex and g2Hex encode finite points, where gnark-crypto's uncompressed
+// encoding is EIP-197's.
+func g1Hex(p bn254.G1Affine) string {
+	raw := p.RawBytes()
+	return hex.EncodeToString(raw[:])
+}
+
+func g2Hex(p bn254.G2Affine) string {
+	raw := p.RawBytes()
+	return hex.EncodeToString(raw[:])
+}
+
+func check(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
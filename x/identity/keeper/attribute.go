@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// SetAttributeCircuit stores the circuit of an attribute.
+func (k Keeper) SetAttributeCircuit(ctx context.Context, circuit types.AttributeCircuit) error {
+	return k.attributeCircuits.Set(ctx, circuit.Attribute, circuit)
+}
+
+// GetAttributeCircuit returns the circuit of attribute.
+func (k Keeper) GetAttributeCircuit(ctx context.Context, attribute string) (val types.AttributeCircuit, found bool) {
+	val, err := k.attributeCircuits.Get(ctx, attribute)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// RemoveAttributeCircuit removes the circuit of attribute.
+func (k Keeper) RemoveAttributeCircuit(ctx context.Context, attribute string) error {
+	return k.attributeCircuits.Remove(ctx, attribute)
+}
+
+// GetAllAttributeCircuit returns all attribute circuits.
+func (k Keeper) GetAllAttributeCircuit(ctx context.Context) (list []types.AttributeCircuit) {
+	err := k.attributeCircuits.Walk(ctx, nil, func(_ string, circuit types.AttributeCircuit) (bool, error) {
+		list = append(list, circuit)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetAttributeFlag stores an attribute flag.
+func (k Keeper) SetAttributeFlag(ctx context.Context, flag types.AttributeFlag) error {
+	addr, err := sdk.AccAddressFromBech32(flag.Address)
+	if err != nil {
+		return err
+	}
+
+	return k.attributeFlags.Set(ctx, collections.Join(addr, flag.Attribute), flag)
+}
+
+// GetAttributeFlag returns the flag of attribute on the identity at address.
+func (k Keeper) GetAttributeFlag(ctx context.Context, address string, attribute string) (val types.AttributeFlag, found bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return val, false
+	}
+
+	val, err = k.attributeFlags.Get(ctx, collections.Join(addr, attribute))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetAllAttributeFlag returns all attribute flags.
+func (k Keeper) GetAllAttributeFlag(ctx context.Context) (list []types.AttributeFlag) {
+	err := k.attributeFlags.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], flag types.AttributeFlag) (bool, error) {
+		list = append(list, flag)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// IsAttributeFlagActive reports whether flag holds at the block time of ctx:
+// it has not expired, it was proven against the circuit its attribute has now
+// and its identity is active with the commitment it was proven against.
+func (k Keeper) IsAttributeFlagActive(ctx context.Context, flag types.AttributeFlag) bool {
+	// a circuit registered again, or removed and registered again, replaces
+	// the key the flag was proven with. Governance registers circuits at the
+	// end of a block, after the proofs of its transactions, so a flag of the
+	// registration height is one of the previous circuit.
+	circuit, found := k.GetAttributeCircuit(ctx, flag.Attribute)
+	if !found || flag.ProvenHeight <= circuit.RegisteredHeight {
+		return false
+	}
+	identity, found := k.GetIdentity(ctx, flag.Address)
+	if !found {
+		return false
+	}
+
+	return flag.ActiveFor(identity, sdk.UnwrapSDKContext(ctx).BlockTime())
+}
+
+// HasAttribute reports whether the identity at address holds an active flag
+// of attribute.
+func (k Keeper) HasAttribute(ctx context.Context, address string, attribute string) bool {
+	flag, found := k.GetAttributeFlag(ctx, address, attribute)
+	return found && k.IsAttributeFlagActive(ctx, flag)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// SetIssuer stores a credential issuer.
+func (k Keeper) SetIssuer(ctx context.Context, issuer types.Issuer) error {
+	addr, err := sdk.AccAddressFromBech32(issuer.Address)
+	if err != nil {
+		return err
+	}
+
+	return k.issuers.Set(ctx, addr, issuer)
+}
+
+// GetIssuer returns the credential issuer at address.
+func (k Keeper) GetIssuer(ctx context.Context, address string) (val types.Issuer, found bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return val, false
+	}
+
+	val, err = k.issuers.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetAllIssuer returns all credential issuers.
+func (k Keeper) GetAllIssuer(ctx context.Context) (list []types.Issuer) {
+	err := k.issuers.Walk(ctx, nil, func(_ sdk.AccAddress, issuer types.Issuer) (bool, error) {
+		list = append(list, issuer)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetCredentialSchema stores a credential schema. Later schemas are given
+// ids above it.
+func (k Keeper) SetCredentialSchema(ctx context.Context, schema types.CredentialSchema) error {
+	if err := k.credentialSchemas.Set(ctx, schema.Id, schema); err != nil {
+		return err
+	}
+
+	return reserveId(ctx, k.credentialSchemaSeq, schema.Id)
+}
+
+// GetCredentialSchema returns the credential schema with the given id.
+func (k Keeper) GetCredentialSchema(ctx context.Context, id uint64) (val types.CredentialSchema, found bool) {
+	val, err := k.credentialSchemas.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetAllCredentialSchema returns all credential schemas.
+func (k Keeper) GetAllCredentialSchema(ctx context.Context) (list []types.CredentialSchema) {
+	err := k.credentialSchemas.Walk(ctx, nil, func(_ uint64, schema types.CredentialSchema) (bool, error) {
+		list = append(list, schema)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetCredential stores a credential, and sets its bit in the status list of
+// its issuer if it is revoked. Later credentials are given ids above it.
+func (k Keeper) SetCredential(ctx context.Context, credential types.Credential) error {
+	if err := k.credentials.Set(ctx, credential.Id, credential); err != nil {
+		return err
+	}
+	if err := reserveId(ctx, k.credentialSeq, credential.Id); err != nil {
+		return err
+	}
+
+	if !credential.Revoked {
+		return nil
+	}
+	issuer, err := sdk.AccAddressFromBech32(credential.Issuer)
+	if err != nil {
+		return err
+	}
+	statusList := k.GetCredentialStatusList(ctx, issuer)
+	statusList.Set(credential.StatusListIndex)
+	return k.statusLists.Set(ctx, issuer, statusList)
+}
+
+// GetCredential returns the credential with the given id.
+func (k Keeper) GetCredential(ctx context.Context, id uint64) (val types.Credential, found bool) {
+	val, err := k.credentials.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetCredentialsByHolder returns the credentials of holder using the holder
+// index.
+func (k Keeper) GetCredentialsByHolder(ctx context.Context, holder sdk.AccAddress) []types.Credential {
+	iter, err := k.credentials.Indexes.Holder.MatchExact(ctx, holder)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err := indexes.CollectValues(ctx, k.credentials, iter)
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// GetAllCredential returns all credentials.
+func (k Keeper) GetAllCredential(ctx context.Context) (list []types.Credential) {
+	err := k.credentials.Walk(ctx, nil, func(_ uint64, credential types.Credential) (bool, error) {
+		list = append(list, credential)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetCredentialStatusList returns the revocation status list of issuer,
+// empty if it never revoked a credential.
+func (k Keeper) GetCredentialStatusList(ctx context.Context, issuer sdk.AccAddress) types.CredentialStatusList {
+	statusList, err := k.statusLists.Get(ctx, issuer)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return statusList
+}
+
+// reserveId moves seq past id, so the ids it hands out next never collide
+// with an entry stored under id.
+func reserveId(ctx context.Context, seq collections.Sequence, id uint64) error {
+	next, err := seq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	if id < next {
+		return nil
+	}
+	return seq.Set(ctx, id+1)
+}
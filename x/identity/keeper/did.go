@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// SetDidRecord stores a DID document and its metadata under the address of
+// its DID.
+func (k Keeper) SetDidRecord(ctx context.Context, record types.DidRecord) error {
+	addr, err := types.ParseDid(record.Document.Id)
+	if err != nil {
+		return err
+	}
+
+	return k.dids.Set(ctx, addr, record)
+}
+
+// GetDidRecord returns the DID document of the identity held by address.
+func (k Keeper) GetDidRecord(ctx context.Context, address string) (val types.DidRecord, found bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return val, false
+	}
+
+	val, err = k.dids.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetAllDidRecord returns all DID documents.
+func (k Keeper) GetAllDidRecord(ctx context.Context) (list []types.DidRecord) {
+	err := k.dids.Walk(ctx, nil, func(_ sdk.AccAddress, record types.DidRecord) (bool, error) {
+		list = append(list, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// updateDidRecord stores record as a new version of its document, changed
+// in the current block.
+func (k Keeper) updateDidRecord(ctx sdk.Context, record *types.DidRecord) error {
+	record.Metadata.Updated = types.FormatDidTime(ctx.BlockTime())
+	record.Metadata.VersionId = strconv.FormatUint(record.Metadata.Version()+1, 10)
+	return k.SetDidRecord(ctx, *record)
+}
+
+// deactivateDid deactivates the DID document of address, if it has an
+// active one. The verification methods and services of a deactivated
+// document are removed so none of its keys can be used anymore.
+func (k Keeper) deactivateDid(ctx sdk.Context, address string) error {
+	record, found := k.GetDidRecord(ctx, address)
+	if !found || record.Metadata.Deactivated {
+		return nil
+	}
+
+	record.Document = types.DidDocument{
+		Id:         record.Document.Id,
+		Controller: record.Document.Controller,
+	}
+	record.Metadata.Deactivated = true
+	if err := k.updateDidRecord(ctx, &record); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDidDeactivated{
+		Did:       record.Document.Id,
+		VersionId: record.Metadata.VersionId,
+	})
+}
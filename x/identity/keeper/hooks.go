@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"Nexelra/x/identity/types"
+)
+
+// hooksRef holds the keeper's hooks. It is shared by every copy of the
+// keeper, so hooks set after depinject handed the keeper out still apply.
+type hooksRef struct {
+	hooks types.IdentityHooks
+}
+
+// SetHooks sets the identity hooks. It panics if they are already set.
+func (k Keeper) SetHooks(ih types.IdentityHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set identity hooks twice")
+	}
+
+	k.hooks.hooks = ih
+}
+
+// Hooks returns the identity hooks, which do nothing if none are set.
+func (k Keeper) Hooks() types.IdentityHooks {
+	if k.hooks.hooks == nil {
+		return types.MultiIdentityHooks{}
+	}
+
+	return k.hooks.hooks
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+// recordingHooks records the hook calls it receives and fails them with err.
+type recordingHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h recordingHooks) record(hook string, address sdk.AccAddress) error {
+	*h.calls = append(*h.calls, h.name+"."+hook+":"+address.String())
+	return h.err
+}
+
+func (h recordingHooks) AfterIdentityCreated(_ context.Context, address sdk.AccAddress) error {
+	return h.record("AfterIdentityCreated", address)
+}
+
+func (h recordingHooks) AfterIdentityRevoked(_ context.Context, address sdk.AccAddress) error {
+	return h.record("AfterIdentityRevoked", address)
+}
+
+func (h recordingHooks) BeforeIdentityUpdated(_ context.Context, address sdk.AccAddress) error {
+	return h.record("BeforeIdentityUpdated", address)
+}
+
+func TestIdentityHooks(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	var calls []string
+	k.SetHooks(types.NewMultiIdentityHooks(
+		recordingHooks{name: "a", calls: &calls},
+		recordingHooks{name: "b", calls: &calls},
+	))
+	// hooks set on the keeper reach the copy held by the msg server
+	srv := keeper.NewMsgServerImpl(k)
+	holder := sample.AccAddress()
+
+	_, err := srv.CreateIdentity(ctx, newMsgCreateIdentity(t, holder, "001099000001"))
+	require.NoError(t, err)
+	_, err = srv.UpdateIdentity(ctx, newMsgUpdateIdentity(t, holder, "001099000002"))
+	require.NoError(t, err)
+	require.NoError(t, revoke(srv, ctx, k.GetAuthority(), holder))
+
+	require.Equal(t, []string{
+		"a.AfterIdentityCreated:" + holder,
+		"b.AfterIdentityCreated:" + holder,
+		"a.BeforeIdentityUpdated:" + holder,
+		"b.BeforeIdentityUpdated:" + holder,
+		"a.AfterIdentityRevoked:" + holder,
+		"b.AfterIdentityRevoked:" + holder,
+	}, calls)
+
+	require.Panics(t, func() { k.SetHooks(types.MultiIdentityHooks{}) })
+}
+
+func TestIdentityHooksError(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	var calls []string
+	hookErr := errors.New("rejected by hook")
+	k.SetHooks(types.NewMultiIdentityHooks(
+		recordingHooks{name: "a", calls: &calls, err: hookErr},
+		recordingHooks{name: "b", calls: &calls},
+	))
+	srv := keeper.NewMsgServerImpl(k)
+	holder := sample.AccAddress()
+
+	// the first failing hook stops the fan-out and fails the message
+	_, err := srv.CreateIdentity(ctx, newMsgCreateIdentity(t, holder, "001099000001"))
+	require.ErrorIs(t, err, hookErr)
+	require.Equal(t, []string{"a.AfterIdentityCreated:" + holder}, calls)
+}
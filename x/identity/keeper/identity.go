@@ -2,22 +2,31 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
-	"Nexelra/x/identity/types"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"Nexelra/x/identity/types"
 )
 
-// SetIdentity set a specific identity in the store from its index
-func (k Keeper) SetIdentity(ctx context.Context, identity types.Identity) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IdentityKeyPrefix))
-	b := k.cdc.MustMarshal(&identity)
-	store.Set(types.IdentityKey(
-		identity.Address,
-	), b)
+// SetIdentity set a specific identity in the store from its index. The
+// idHash, status and verifier indexes, and the active CCCD count, follow the
+// stored value.
+func (k Keeper) SetIdentity(ctx context.Context, identity types.Identity) error {
+	addr, err := sdk.AccAddressFromBech32(identity.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := k.uncountActiveCccd(ctx, addr); err != nil {
+		return err
+	}
+	if err := k.identities.Set(ctx, addr, identity); err != nil {
+		return err
+	}
+	return k.countActiveCccd(ctx, identity, true)
 }
 
 // GetIdentity returns a identity from its index
@@ -26,45 +35,138 @@ func (k Keeper) GetIdentity(
 	address string,
 
 ) (val types.Identity, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IdentityKeyPrefix))
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return val, false
+	}
+
+	val, err = k.identities.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// HasActiveIdentity reports whether address holds an identity in ACTIVE status
+func (k Keeper) HasActiveIdentity(
+	ctx context.Context,
+	address string,
+
+) bool {
+	identity, found := k.GetIdentity(ctx, address)
+	return found && identity.Status == types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+}
+
+// GetIdentityByIdHash returns the identity bound to a CCCD hash using the
+// idHash index. Pending identities only claim their commitment until a
+// verifier attests it, so several may share it and none of them is bound.
+func (k Keeper) GetIdentityByIdHash(
+	ctx context.Context,
+	idHash string,
+
+) (val types.Identity, found bool) {
+	for _, identity := range k.GetIdentitiesByIdHash(ctx, idHash) {
+		if identity.Status != types.IdentityStatus_IDENTITY_STATUS_PENDING {
+			return identity, true
+		}
+	}
+	return val, false
+}
 
-	b := store.Get(types.IdentityKey(
-		address,
-	))
-	if b == nil {
+// GetIdentitiesByIdHash returns every identity committed to a CCCD hash
+// using the idHash index: the bound one, if any, and the pending claims.
+func (k Keeper) GetIdentitiesByIdHash(ctx context.Context, idHash string) []types.Identity {
+	if idHash == "" {
+		return nil
+	}
+
+	iter, err := k.identities.Indexes.IdHash.MatchExact(ctx, idHash)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err := indexes.CollectValues(ctx, k.identities, iter)
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// GetIdentityByCccdTag returns the identity holding a CCCD tag using the
+// cccdTag index
+func (k Keeper) GetIdentityByCccdTag(ctx context.Context, cccdTag string) (val types.Identity, found bool) {
+	if cccdTag == "" {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
+	iter, err := k.identities.Indexes.CccdTag.MatchExact(ctx, cccdTag)
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return val, false
+	}
+	addr, err := iter.PrimaryKey()
+	if err != nil {
+		panic(err)
+	}
+
+	val, err = k.identities.Get(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
 	return val, true
 }
 
+// GetIdentitiesByStatus returns the identities in status using the status
+// index
+func (k Keeper) GetIdentitiesByStatus(ctx context.Context, status types.IdentityStatus) []types.Identity {
+	iter, err := k.identities.Indexes.Status.MatchExact(ctx, int32(status))
+	if err != nil {
+		panic(err)
+	}
+
+	list, err := indexes.CollectValues(ctx, k.identities, iter)
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
 // RemoveIdentity removes a identity from the store
 func (k Keeper) RemoveIdentity(
 	ctx context.Context,
 	address string,
 
-) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IdentityKeyPrefix))
-	store.Delete(types.IdentityKey(
-		address,
-	))
+) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	if err := k.uncountActiveCccd(ctx, addr); err != nil {
+		return err
+	}
+	err = k.identities.Remove(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	return err
 }
 
 // GetAllIdentity returns all identity
 func (k Keeper) GetAllIdentity(ctx context.Context) (list []types.Identity) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.IdentityKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Identity
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	err := k.identities.Walk(ctx, nil, func(_ sdk.AccAddress, identity types.Identity) (bool, error) {
+		list = append(list, identity)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 	return
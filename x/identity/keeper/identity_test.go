@@ -7,6 +7,7 @@ import (
 
 	keepertest "Nexelra/testutil/keeper"
 	"Nexelra/testutil/nullify"
+	"Nexelra/testutil/sample"
 	"Nexelra/x/identity/keeper"
 	"Nexelra/x/identity/types"
 
@@ -16,19 +17,19 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
-func createNIdentity(keeper keeper.Keeper, ctx context.Context, n int) []types.Identity {
+func createNIdentity(t testing.TB, keeper keeper.Keeper, ctx context.Context, n int) []types.Identity {
 	items := make([]types.Identity, n)
 	for i := range items {
-		items[i].Address = strconv.Itoa(i)
+		items[i].Address = sample.AccAddress()
 
-		keeper.SetIdentity(ctx, items[i])
+		require.NoError(t, keeper.SetIdentity(ctx, items[i]))
 	}
 	return items
 }
 
 func TestIdentityGet(t *testing.T) {
 	keeper, ctx := keepertest.IdentityKeeper(t)
-	items := createNIdentity(keeper, ctx, 10)
+	items := createNIdentity(t, keeper, ctx, 10)
 	for _, item := range items {
 		rst, found := keeper.GetIdentity(ctx,
 			item.Address,
@@ -42,11 +43,11 @@ func TestIdentityGet(t *testing.T) {
 }
 func TestIdentityRemove(t *testing.T) {
 	keeper, ctx := keepertest.IdentityKeeper(t)
-	items := createNIdentity(keeper, ctx, 10)
+	items := createNIdentity(t, keeper, ctx, 10)
 	for _, item := range items {
-		keeper.RemoveIdentity(ctx,
+		require.NoError(t, keeper.RemoveIdentity(ctx,
 			item.Address,
-		)
+		))
 		_, found := keeper.GetIdentity(ctx,
 			item.Address,
 		)
@@ -56,9 +57,109 @@ func TestIdentityRemove(t *testing.T) {
 
 func TestIdentityGetAll(t *testing.T) {
 	keeper, ctx := keepertest.IdentityKeeper(t)
-	items := createNIdentity(keeper, ctx, 10)
+	items := createNIdentity(t, keeper, ctx, 10)
 	require.ElementsMatch(t,
 		nullify.Fill(items),
 		nullify.Fill(keeper.GetAllIdentity(ctx)),
 	)
 }
+
+func TestIdentityGetByIdHash(t *testing.T) {
+	keeper, ctx := keepertest.IdentityKeeper(t)
+	items := createNIdentity(t, keeper, ctx, 10)
+	for i := range items {
+		items[i].IdHash = "hash" + strconv.Itoa(i)
+		require.NoError(t, keeper.SetIdentity(ctx, items[i]))
+	}
+	for _, item := range items {
+		rst, found := keeper.GetIdentityByIdHash(ctx,
+			item.IdHash,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+	_, found := keeper.GetIdentityByIdHash(ctx, "missing")
+	require.False(t, found)
+}
+
+func TestIdentityHashIndexMaintained(t *testing.T) {
+	keeper, ctx := keepertest.IdentityKeeper(t)
+	identity := types.Identity{Address: sample.AccAddress(), IdHash: "old"}
+	require.NoError(t, keeper.SetIdentity(ctx, identity))
+
+	// Re-binding the identity to a new hash drops the old index entry
+	identity.IdHash = "new"
+	require.NoError(t, keeper.SetIdentity(ctx, identity))
+	_, found := keeper.GetIdentityByIdHash(ctx, "old")
+	require.False(t, found)
+	rst, found := keeper.GetIdentityByIdHash(ctx, "new")
+	require.True(t, found)
+	require.Equal(t, identity.Address, rst.Address)
+
+	require.NoError(t, keeper.RemoveIdentity(ctx, identity.Address))
+	_, found = keeper.GetIdentityByIdHash(ctx, "new")
+	require.False(t, found)
+}
+
+func TestIdentityInvalidAddress(t *testing.T) {
+	keeper, ctx := keepertest.IdentityKeeper(t)
+	require.Error(t, keeper.SetIdentity(ctx, types.Identity{Address: "0"}))
+	_, found := keeper.GetIdentity(ctx, "0")
+	require.False(t, found)
+}
+
+func TestIdentityStatusIndexMaintained(t *testing.T) {
+	keeper, ctx := keepertest.IdentityKeeper(t)
+	items := createNIdentity(t, keeper, ctx, 4)
+	pending := items[:2]
+	for i := range pending {
+		pending[i].Status = types.IdentityStatus_IDENTITY_STATUS_PENDING
+		require.NoError(t, keeper.SetIdentity(ctx, pending[i]))
+	}
+	require.ElementsMatch(t, pending, keeper.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING))
+	require.ElementsMatch(t, items[2:], keeper.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_ACTIVE))
+
+	// a status change moves the identity between index entries
+	pending[0].Status = types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+	require.NoError(t, keeper.SetIdentity(ctx, pending[0]))
+	require.ElementsMatch(t, pending[1:], keeper.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING))
+
+	require.NoError(t, keeper.RemoveIdentity(ctx, pending[1].Address))
+	require.Empty(t, keeper.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING))
+	require.Empty(t, keeper.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_REVOKED))
+}
+
+func TestActiveCccdCountMaintained(t *testing.T) {
+	keeper, ctx := keepertest.IdentityKeeper(t)
+	active := types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+	tagged := types.Identity{Address: sample.AccAddress(), IdHash: "h1", CccdTag: "t1", Status: active}
+	// a second address attested with the same tag
+	linked := types.Identity{Address: sample.AccAddress(), IdHash: "h2", CccdTag: "t1", Status: active}
+	legacy := types.Identity{Address: sample.AccAddress(), IdHash: "h3", Status: active}
+	pending := types.Identity{Address: sample.AccAddress(), IdHash: "h4", Status: types.IdentityStatus_IDENTITY_STATUS_PENDING}
+	for _, identity := range []types.Identity{tagged, linked, legacy, pending} {
+		require.NoError(t, keeper.SetIdentity(ctx, identity))
+	}
+	require.Equal(t, uint64(2), keeper.ActiveCccdCount(ctx))
+
+	// the tag counts while one of its identities is active
+	tagged.Status = types.IdentityStatus_IDENTITY_STATUS_SUSPENDED
+	require.NoError(t, keeper.SetIdentity(ctx, tagged))
+	require.Equal(t, uint64(2), keeper.ActiveCccdCount(ctx))
+	require.NoError(t, keeper.RemoveIdentity(ctx, linked.Address))
+	require.Equal(t, uint64(1), keeper.ActiveCccdCount(ctx))
+
+	// tagging a legacy identity moves it to its tag
+	legacy.CccdTag = "t3"
+	require.NoError(t, keeper.SetIdentity(ctx, legacy))
+	pending.Status = active
+	require.NoError(t, keeper.SetIdentity(ctx, pending))
+	require.Equal(t, uint64(2), keeper.ActiveCccdCount(ctx))
+
+	// a recount over the store agrees
+	require.NoError(t, keeper.InitActiveCccdCount(ctx))
+	require.Equal(t, uint64(2), keeper.ActiveCccdCount(ctx))
+}
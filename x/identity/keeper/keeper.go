@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +13,84 @@ import (
 	"Nexelra/x/identity/types"
 )
 
+// IdentityIndexes are the secondary indexes of the identity collection.
+type IdentityIndexes struct {
+	// IdHash maps a CCCD commitment to the address holding it
+	IdHash *indexes.Multi[string, sdk.AccAddress, types.Identity]
+	// Status maps an IdentityStatus to the addresses in that status
+	Status *indexes.Multi[int32, sdk.AccAddress, types.Identity]
+	// Verifier maps a verifier to the addresses it attested
+	Verifier *indexes.Multi[sdk.AccAddress, sdk.AccAddress, types.Identity]
+	// CccdTag maps a CCCD tag to the address holding it
+	CccdTag *indexes.Multi[string, sdk.AccAddress, types.Identity]
+}
+
+func (i IdentityIndexes) IndexesList() []collections.Index[sdk.AccAddress, types.Identity] {
+	return []collections.Index[sdk.AccAddress, types.Identity]{i.IdHash, i.Status, i.Verifier, i.CccdTag}
+}
+
+// NewIdentityIndexes creates the identity indexes in sb.
+func NewIdentityIndexes(sb *collections.SchemaBuilder) IdentityIndexes {
+	return IdentityIndexes{
+		IdHash: indexes.NewMulti(
+			sb, types.IdentityIdHashIndexPrefix, "identities_by_id_hash",
+			collections.StringKey, sdk.AccAddressKey,
+			func(_ sdk.AccAddress, identity types.Identity) (string, error) {
+				return identity.IdHash, nil
+			},
+		),
+		Status: indexes.NewMulti(
+			sb, types.IdentityStatusIndexPrefix, "identities_by_status",
+			collections.Int32Key, sdk.AccAddressKey,
+			func(_ sdk.AccAddress, identity types.Identity) (int32, error) {
+				return int32(identity.Status), nil
+			},
+		),
+		Verifier: indexes.NewMulti(
+			sb, types.IdentityVerifierIndexPrefix, "identities_by_verifier",
+			sdk.AccAddressKey, sdk.AccAddressKey,
+			func(_ sdk.AccAddress, identity types.Identity) (sdk.AccAddress, error) {
+				// identities nobody attested yet share the empty verifier
+				if identity.Verifier == "" {
+					return sdk.AccAddress{}, nil
+				}
+				return sdk.AccAddressFromBech32(identity.Verifier)
+			},
+		),
+		CccdTag: indexes.NewMulti(
+			sb, types.IdentityCccdTagIndexPrefix, "identities_by_cccd_tag",
+			collections.StringKey, sdk.AccAddressKey,
+			func(_ sdk.AccAddress, identity types.Identity) (string, error) {
+				// identities without a tag share the empty one
+				return identity.CccdTag, nil
+			},
+		),
+	}
+}
+
+// CredentialIndexes are the secondary indexes of the credential collection.
+type CredentialIndexes struct {
+	// Holder maps a holder to the ids of its credentials
+	Holder *indexes.Multi[sdk.AccAddress, uint64, types.Credential]
+}
+
+func (i CredentialIndexes) IndexesList() []collections.Index[uint64, types.Credential] {
+	return []collections.Index[uint64, types.Credential]{i.Holder}
+}
+
+// NewCredentialIndexes creates the credential indexes in sb.
+func NewCredentialIndexes(sb *collections.SchemaBuilder) CredentialIndexes {
+	return CredentialIndexes{
+		Holder: indexes.NewMulti(
+			sb, types.CredentialHolderIndexPrefix, "credentials_by_holder",
+			sdk.AccAddressKey, collections.Uint64Key,
+			func(_ uint64, credential types.Credential) (sdk.AccAddress, error) {
+				return sdk.AccAddressFromBech32(credential.Holder)
+			},
+		),
+	}
+}
+
 type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
@@ -20,6 +100,39 @@ type (
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		nftKeeper types.NFTKeeper
+
+		hooks *hooksRef
+
+		Schema     collections.Schema
+		params     collections.Item[types.Params]
+		identities *collections.IndexedMap[sdk.AccAddress, types.Identity, IdentityIndexes]
+		dids       collections.Map[sdk.AccAddress, types.DidRecord]
+
+		issuers             collections.Map[sdk.AccAddress, types.Issuer]
+		credentialSchemas   collections.Map[uint64, types.CredentialSchema]
+		credentialSchemaSeq collections.Sequence
+		credentials         *collections.IndexedMap[uint64, types.Credential, CredentialIndexes]
+		credentialSeq       collections.Sequence
+		statusLists         collections.Map[sdk.AccAddress, types.CredentialStatusList]
+
+		attributeCircuits collections.Map[string, types.AttributeCircuit]
+		attributeFlags    collections.Map[collections.Pair[sdk.AccAddress, string], types.AttributeFlag]
+
+		identitySpends collections.Map[string, types.IdentitySpend]
+
+		// activeCccds and activeCccdCount follow the active identities of
+		// each CCCD as SetIdentity stores them, see ActiveCccdCount.
+		activeCccds     collections.Map[string, uint64]
+		activeCccdCount collections.Item[uint64]
+
+		// verifierIndex reads the entries of the verifier index for
+		// query.CollectionPaginate, which cannot page through a Multi index
+		// itself. It is outside Schema as it shares the index's prefix.
+		verifierIndex collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+		// holderIndex does the same for the holder index of the credentials.
+		holderIndex collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
 	}
 )
 
@@ -28,18 +141,65 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	logger log.Logger,
 	authority string,
-
+	nftKeeper types.NFTKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
 		logger:       logger,
+		nftKeeper:    nftKeeper,
+		hooks:        &hooksRef{},
+
+		params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		identities: collections.NewIndexedMap(
+			sb, types.IdentityKeyPrefix, "identities",
+			sdk.AccAddressKey, codec.CollValue[types.Identity](cdc),
+			NewIdentityIndexes(sb),
+		),
+		dids: collections.NewMap(sb, types.DidKeyPrefix, "dids", sdk.AccAddressKey, codec.CollValue[types.DidRecord](cdc)),
+
+		issuers:             collections.NewMap(sb, types.IssuerKeyPrefix, "issuers", sdk.AccAddressKey, codec.CollValue[types.Issuer](cdc)),
+		credentialSchemas:   collections.NewMap(sb, types.CredentialSchemaKeyPrefix, "credential_schemas", collections.Uint64Key, codec.CollValue[types.CredentialSchema](cdc)),
+		credentialSchemaSeq: collections.NewSequence(sb, types.CredentialSchemaSeqKey, "credential_schema_seq"),
+		credentials: collections.NewIndexedMap(
+			sb, types.CredentialKeyPrefix, "credentials",
+			collections.Uint64Key, codec.CollValue[types.Credential](cdc),
+			NewCredentialIndexes(sb),
+		),
+		credentialSeq: collections.NewSequence(sb, types.CredentialSeqKey, "credential_seq"),
+		statusLists:   collections.NewMap(sb, types.CredentialStatusListKeyPrefix, "credential_status_lists", sdk.AccAddressKey, codec.CollValue[types.CredentialStatusList](cdc)),
+
+		attributeCircuits: collections.NewMap(sb, types.AttributeCircuitKeyPrefix, "attribute_circuits", collections.StringKey, codec.CollValue[types.AttributeCircuit](cdc)),
+		attributeFlags: collections.NewMap(
+			sb, types.AttributeFlagKeyPrefix, "attribute_flags",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.AttributeFlag](cdc),
+		),
+		identitySpends: collections.NewMap(sb, types.IdentitySpendKeyPrefix, "identity_spends", collections.StringKey, codec.CollValue[types.IdentitySpend](cdc)),
+		activeCccds:     collections.NewMap(sb, types.ActiveCccdKeyPrefix, "active_cccds", collections.StringKey, collections.Uint64Value),
+		activeCccdCount: collections.NewItem(sb, types.ActiveCccdCountKey, "active_cccd_count", collections.Uint64Value),
+		verifierIndex: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.IdentityVerifierIndexPrefix, "identities_by_verifier",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		holderIndex: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.CredentialHolderIndexPrefix, "credentials_by_holder",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	v3 "Nexelra/x/identity/migrations/v3"
+	v4 "Nexelra/x/identity/migrations/v4"
+	v5 "Nexelra/x/identity/migrations/v5"
+	v7 "Nexelra/x/identity/migrations/v7"
+	v8 "Nexelra/x/identity/migrations/v8"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates x/identity storage from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates x/identity storage from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates x/identity storage from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates x/identity storage from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.params, m.keeper.identities)
+}
+
+// Migrate5to6 creates the identity NFT class and issues the NFTs of the
+// existing identities.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return m.keeper.InitIdentityNFTs(ctx)
+}
+
+// Migrate6to7 sets the level of the attested identities to VERIFIED.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.identities)
+}
+
+// Migrate7to8 makes salted commitments the default scheme, sets a pepper on
+// chains that ran without one, burns the identity NFTs of identities pending
+// re-verification and counts the CCCDs with an active identity.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	if err := v8.MigrateStore(ctx, m.keeper.params); err != nil {
+		return err
+	}
+	if err := m.keeper.BurnPendingIdentityNFTs(ctx); err != nil {
+		return err
+	}
+	return m.keeper.InitActiveCccdCount(ctx)
+}
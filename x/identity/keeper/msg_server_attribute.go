@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) RegisterAttributeCircuit(goCtx context.Context, msg *types.MsgRegisterAttributeCircuit) (*types.MsgRegisterAttributeCircuitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	circuit := types.AttributeCircuit{
+		Attribute:        msg.Attribute,
+		Description:      msg.Description,
+		VerifyingKey:     msg.VerifyingKey,
+		Parameters:       msg.Parameters,
+		FlagDuration:     msg.FlagDuration,
+		RegisteredHeight: ctx.BlockHeight(),
+	}
+	if err := k.SetAttributeCircuit(ctx, circuit); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttributeCircuitRegistered{
+		Attribute:   circuit.Attribute,
+		Description: circuit.Description,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAttributeCircuitResponse{}, nil
+}
+
+func (k msgServer) RemoveAttributeCircuit(goCtx context.Context, msg *types.MsgRemoveAttributeCircuit) (*types.MsgRemoveAttributeCircuitResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAttributeCircuit(ctx, msg.Attribute); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no circuit for attribute %s", msg.Attribute)
+	}
+
+	// the flags it granted lapse with the circuit, and do not come back if it
+	// is registered again, see IsAttributeFlagActive
+	if err := k.Keeper.RemoveAttributeCircuit(ctx, msg.Attribute); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttributeCircuitRemoved{
+		Attribute: msg.Attribute,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAttributeCircuitResponse{}, nil
+}
+
+func (k msgServer) ProveAttribute(goCtx context.Context, msg *types.MsgProveAttribute) (*types.MsgProveAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	circuit, found := k.GetAttributeCircuit(ctx, msg.Attribute)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no circuit for attribute %s", msg.Attribute)
+	}
+
+	// the proof is about the data the identity committed to, which only
+	// counts once a verifier attested it
+	identity, found := k.GetIdentity(ctx, msg.Creator)
+	if !found || identity.Status != types.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s has no active identity", msg.Creator)
+	}
+
+	ctx.GasMeter().ConsumeGas(types.AttributeProofGas, "attribute proof verification")
+	if err := circuit.Verify(identity, msg.Proof); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAttributeProof, "attribute %s: %s", msg.Attribute, err)
+	}
+
+	flag := types.AttributeFlag{
+		Address:      identity.Address,
+		Attribute:    circuit.Attribute,
+		IdHash:       identity.IdHash,
+		ProvenAt:     ctx.BlockTime().Unix(),
+		ProvenHeight: ctx.BlockHeight(),
+		ExpiresAt:    ctx.BlockTime().Unix() + circuit.FlagDuration,
+	}
+	if err := k.SetAttributeFlag(ctx, flag); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttributeProven{
+		Address:   flag.Address,
+		Attribute: flag.Attribute,
+		ExpiresAt: flag.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProveAttributeResponse{ExpiresAt: flag.ExpiresAt}, nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/groth16"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestAttributeMsgServer(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0)).WithBlockHeight(5)
+
+	holder := sample.AccAddress()
+	identity := types.Identity{Address: holder, IdHash: strings.Repeat("ab", 32), Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}
+	require.NoError(t, k.SetIdentity(ctx, identity))
+
+	// birth year ≤ 2007
+	parameters := []string{"2007"}
+	simulator, err := groth16.NewSimulator(rand.Reader, 3+len(parameters))
+	require.NoError(t, err)
+	vk := types.Groth16VerifyingKey{Alpha: simulator.Alpha, Beta: simulator.Beta, Gamma: simulator.Gamma, Delta: simulator.Delta, Ic: simulator.IC}
+	register := types.NewMsgRegisterAttributeCircuit(k.GetAuthority(), "age-over-18", "birth year at most 2007", vk, parameters, 3600)
+	require.NoError(t, register.ValidateBasic())
+
+	circuit := types.AttributeCircuit{Attribute: register.Attribute, Parameters: parameters}
+	inputs, err := circuit.PublicInputs(identity)
+	require.NoError(t, err)
+	proof, err := simulator.Prove(rand.Reader, inputs)
+	require.NoError(t, err)
+
+	// circuits are registered by the authority only
+	_, err = srv.ProveAttribute(ctx, types.NewMsgProveAttribute(holder, "age-over-18", proof))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.RegisterAttributeCircuit(ctx, types.NewMsgRegisterAttributeCircuit(sample.AccAddress(), "age-over-18", "birth year at most 2007", vk, parameters, 3600))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RegisterAttributeCircuit(ctx, register)
+	require.NoError(t, err)
+
+	res, err := k.AttributeCircuit(ctx, &types.QueryGetAttributeCircuitRequest{Attribute: "age-over-18"})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.AttributeCircuit.RegisteredHeight)
+
+	// a proof of the registration height counts for the previous circuit
+	_, err = srv.ProveAttribute(ctx, types.NewMsgProveAttribute(holder, "age-over-18", proof))
+	require.NoError(t, err)
+	require.False(t, k.HasAttribute(ctx, holder, "age-over-18"))
+	ctx = ctx.WithBlockHeight(6)
+
+	// the proof is bound to the identity and the parameters
+	other := sample.AccAddress()
+	require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: other, IdHash: identity.IdHash, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}))
+	_, err = srv.ProveAttribute(ctx, types.NewMsgProveAttribute(other, "age-over-18", proof))
+	require.ErrorIs(t, err, types.ErrInvalidAttributeProof)
+	_, err = srv.ProveAttribute(ctx, types.NewMsgProveAttribute(sample.AccAddress(), "age-over-18", proof))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	proven, err := srv.ProveAttribute(ctx, types.NewMsgProveAttribute(holder, "age-over-18", proof))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Unix()+3600, proven.ExpiresAt)
+	require.True(t, k.HasAttribute(ctx, holder, "age-over-18"))
+	require.False(t, k.HasAttribute(ctx, other, "age-over-18"))
+
+	flag, err := k.AttributeFlag(ctx, &types.QueryGetAttributeFlagRequest{Address: holder, Attribute: "age-over-18"})
+	require.NoError(t, err)
+	require.True(t, flag.Active)
+	require.Equal(t, identity.IdHash, flag.AttributeFlag.IdHash)
+
+	flags, err := k.AttributeFlags(ctx, &types.QueryAttributeFlagsRequest{Address: holder})
+	require.NoError(t, err)
+	require.Len(t, flags.AttributeFlag, 1)
+
+	// the flag lapses when it expires
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	flag, err = k.AttributeFlag(later, &types.QueryGetAttributeFlagRequest{Address: holder, Attribute: "age-over-18"})
+	require.NoError(t, err)
+	require.False(t, flag.Active)
+
+	// and when its circuit is removed
+	_, err = srv.RemoveAttributeCircuit(ctx, types.NewMsgRemoveAttributeCircuit(sample.AccAddress(), "age-over-18"))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RemoveAttributeCircuit(ctx, types.NewMsgRemoveAttributeCircuit(k.GetAuthority(), "age-over-18"))
+	require.NoError(t, err)
+	_, err = srv.RemoveAttributeCircuit(ctx, types.NewMsgRemoveAttributeCircuit(k.GetAuthority(), "age-over-18"))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.False(t, k.HasAttribute(ctx, holder, "age-over-18"))
+
+	// registering it again does not bring the flag back
+	ctx = ctx.WithBlockHeight(7)
+	_, err = srv.RegisterAttributeCircuit(ctx, register)
+	require.NoError(t, err)
+	require.False(t, k.HasAttribute(ctx, holder, "age-over-18"))
+}
+
+func TestAttributeFlagCircuitReplaced(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0)).WithBlockHeight(5)
+
+	holder := sample.AccAddress()
+	identity := types.Identity{Address: holder, IdHash: strings.Repeat("ab", 32), Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}
+	require.NoError(t, k.SetIdentity(ctx, identity))
+
+	parameters := []string{"2007"}
+	circuit := types.AttributeCircuit{Attribute: "age-over-18", Parameters: parameters}
+	inputs, err := circuit.PublicInputs(identity)
+	require.NoError(t, err)
+
+	register := func(height int64) *groth16.Simulator {
+		simulator, err := groth16.NewSimulator(rand.Reader, len(inputs))
+		require.NoError(t, err)
+		vk := types.Groth16VerifyingKey{Alpha: simulator.Alpha, Beta: simulator.Beta, Gamma: simulator.Gamma, Delta: simulator.Delta, Ic: simulator.IC}
+		_, err = srv.RegisterAttributeCircuit(ctx.WithBlockHeight(height), types.NewMsgRegisterAttributeCircuit(k.GetAuthority(), "age-over-18", "birth year at most 2007", vk, parameters, 3600))
+		require.NoError(t, err)
+		return simulator
+	}
+	prove := func(simulator *groth16.Simulator, height int64) {
+		proof, err := simulator.Prove(rand.Reader, inputs)
+		require.NoError(t, err)
+		_, err = srv.ProveAttribute(ctx.WithBlockHeight(height), types.NewMsgProveAttribute(holder, "age-over-18", proof))
+		require.NoError(t, err)
+	}
+
+	first := register(5)
+	prove(first, 6)
+	require.True(t, k.HasAttribute(ctx, holder, "age-over-18"))
+
+	// a new key for the attribute, proofs of the old one no longer verify
+	// and the flag proven with it lapses
+	second := register(7)
+	require.False(t, k.HasAttribute(ctx, holder, "age-over-18"))
+	proof, err := first.Prove(rand.Reader, inputs)
+	require.NoError(t, err)
+	_, err = srv.ProveAttribute(ctx.WithBlockHeight(8), types.NewMsgProveAttribute(holder, "age-over-18", proof))
+	require.ErrorIs(t, err, types.ErrInvalidAttributeProof)
+
+	prove(second, 8)
+	require.True(t, k.HasAttribute(ctx, holder, "age-over-18"))
+}
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) RegisterIssuer(goCtx context.Context, msg *types.MsgRegisterIssuer) (*types.MsgRegisterIssuerResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, found := k.GetIssuer(ctx, msg.Address)
+	if !found {
+		issuer = types.Issuer{
+			Address:          msg.Address,
+			RegisteredHeight: ctx.BlockHeight(),
+		}
+	}
+	issuer.Name = msg.Name
+	issuer.Active = true
+	if err := k.SetIssuer(ctx, issuer); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuerRegistered{
+		Address: msg.Address,
+		Name:    msg.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterIssuerResponse{}, nil
+}
+
+func (k msgServer) RemoveIssuer(goCtx context.Context, msg *types.MsgRemoveIssuer) (*types.MsgRemoveIssuerResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, found := k.GetIssuer(ctx, msg.Address)
+	if !found || !issuer.Active {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no active issuer %s", msg.Address)
+	}
+
+	issuer.Active = false
+	if err := k.SetIssuer(ctx, issuer); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuerRemoved{
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveIssuerResponse{}, nil
+}
+
+func (k msgServer) RegisterCredentialSchema(goCtx context.Context, msg *types.MsgRegisterCredentialSchema) (*types.MsgRegisterCredentialSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.activeIssuer(ctx, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	id, err := k.credentialSchemaSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schema := types.CredentialSchema{
+		Id:            id,
+		Issuer:        msg.Issuer,
+		Name:          msg.Name,
+		Uri:           msg.Uri,
+		Hash:          strings.ToLower(msg.Hash),
+		CreatedHeight: ctx.BlockHeight(),
+	}
+	if err := k.SetCredentialSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialSchemaRegistered{
+		Id:     schema.Id,
+		Issuer: schema.Issuer,
+		Hash:   schema.Hash,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCredentialSchemaResponse{Id: id}, nil
+}
+
+func (k msgServer) IssueCredential(goCtx context.Context, msg *types.MsgIssueCredential) (*types.MsgIssueCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issuer, err := k.activeIssuer(ctx, msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetCredentialSchema(ctx, msg.SchemaId); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no credential schema %d", msg.SchemaId)
+	}
+
+	// credentials are claims about the holder of an identity
+	if !k.HasActiveIdentity(ctx, msg.Holder) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s has no active identity", msg.Holder)
+	}
+
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= ctx.BlockTime().Unix() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "credential expires in the past")
+	}
+
+	id, err := k.credentialSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credential := types.Credential{
+		Id:              id,
+		Issuer:          msg.Issuer,
+		Holder:          msg.Holder,
+		SchemaId:        msg.SchemaId,
+		Hash:            strings.ToLower(msg.Hash),
+		IssuedAt:        ctx.BlockTime().Unix(),
+		IssuedHeight:    ctx.BlockHeight(),
+		ExpiresAt:       msg.ExpiresAt,
+		StatusListIndex: issuer.StatusListSize,
+	}
+	if err := k.SetCredential(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	issuer.StatusListSize++
+	if err := k.SetIssuer(ctx, issuer); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialIssued{
+		Id:              credential.Id,
+		Issuer:          credential.Issuer,
+		Holder:          credential.Holder,
+		SchemaId:        credential.SchemaId,
+		Hash:            credential.Hash,
+		StatusListIndex: credential.StatusListIndex,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIssueCredentialResponse{Id: id, StatusListIndex: credential.StatusListIndex}, nil
+}
+
+func (k msgServer) RevokeCredential(goCtx context.Context, msg *types.MsgRevokeCredential) (*types.MsgRevokeCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	credential, found := k.GetCredential(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no credential %d", msg.Id)
+	}
+
+	// removed issuers may still revoke what they issued
+	if credential.Issuer != msg.Issuer {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "credential %d was issued by %s", msg.Id, credential.Issuer)
+	}
+
+	if credential.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrCredentialRevoked, "credential %d", msg.Id)
+	}
+
+	credential.Revoked = true
+	credential.RevokedAt = ctx.BlockTime().Unix()
+	credential.RevokedHeight = ctx.BlockHeight()
+	credential.RevocationReason = msg.Reason
+	if err := k.SetCredential(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialRevoked{
+		Id:              credential.Id,
+		Issuer:          credential.Issuer,
+		StatusListIndex: credential.StatusListIndex,
+		Reason:          msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeCredentialResponse{}, nil
+}
+
+// activeIssuer returns the issuer at address, which must be active.
+func (k msgServer) activeIssuer(ctx sdk.Context, address string) (types.Issuer, error) {
+	issuer, found := k.GetIssuer(ctx, address)
+	if !found || !issuer.Active {
+		return issuer, errorsmod.Wrap(types.ErrUnknownIssuer, address)
+	}
+
+	return issuer, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestCredentialMsgServer(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0)).WithBlockHeight(5)
+
+	issuer, holder := sample.AccAddress(), sample.AccAddress()
+	schemaHash := strings.Repeat("ab", 32)
+	credentialHash := strings.Repeat("CD", 32)
+	require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: holder, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}))
+
+	// issuers are registered by the authority only
+	_, err := srv.RegisterIssuer(ctx, types.NewMsgRegisterIssuer(sample.AccAddress(), issuer, "Hanoi police"))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RegisterCredentialSchema(ctx, types.NewMsgRegisterCredentialSchema(issuer, "over-18", "https://example.com/over-18.json", schemaHash))
+	require.ErrorIs(t, err, types.ErrUnknownIssuer)
+	_, err = srv.RegisterIssuer(ctx, types.NewMsgRegisterIssuer(k.GetAuthority(), issuer, "Hanoi police"))
+	require.NoError(t, err)
+
+	schema, err := srv.RegisterCredentialSchema(ctx, types.NewMsgRegisterCredentialSchema(issuer, "over-18", "https://example.com/over-18.json", schemaHash))
+	require.NoError(t, err)
+
+	_, err = srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id+1, credentialHash, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, sample.AccAddress(), schema.Id, credentialHash, 0))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id, credentialHash, ctx.BlockTime().Unix()))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	first, err := srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id, credentialHash, 0))
+	require.NoError(t, err)
+	second, err := srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id, credentialHash, ctx.BlockTime().Unix()+60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), first.StatusListIndex)
+	require.Equal(t, uint64(1), second.StatusListIndex)
+
+	res, err := k.Credential(ctx, &types.QueryGetCredentialRequest{Id: first.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_VALID, res.Status)
+	require.Equal(t, strings.ToLower(credentialHash), res.Credential.Hash)
+	require.Equal(t, int64(5), res.Credential.IssuedHeight)
+
+	// only the issuer of a credential revokes it, once
+	_, err = srv.RevokeCredential(ctx, types.NewMsgRevokeCredential(sample.AccAddress(), second.Id, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeCredential(ctx, types.NewMsgRevokeCredential(issuer, second.Id+1, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// removed issuers cannot issue anymore, but still revoke
+	_, err = srv.RemoveIssuer(ctx, types.NewMsgRemoveIssuer(k.GetAuthority(), issuer))
+	require.NoError(t, err)
+	_, err = srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id, credentialHash, 0))
+	require.ErrorIs(t, err, types.ErrUnknownIssuer)
+	_, err = srv.RevokeCredential(ctx, types.NewMsgRevokeCredential(issuer, second.Id, "license withdrawn"))
+	require.NoError(t, err)
+	_, err = srv.RevokeCredential(ctx, types.NewMsgRevokeCredential(issuer, second.Id, ""))
+	require.ErrorIs(t, err, types.ErrCredentialRevoked)
+
+	res, err = k.Credential(ctx, &types.QueryGetCredentialRequest{Id: second.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_REVOKED, res.Status)
+	require.Equal(t, "license withdrawn", res.Credential.RevocationReason)
+
+	statusList := k.GetCredentialStatusList(ctx, sdk.MustAccAddressFromBech32(issuer))
+	require.False(t, statusList.IsSet(first.StatusListIndex))
+	require.True(t, statusList.IsSet(second.StatusListIndex))
+
+	list, err := k.CredentialStatusList(ctx, &types.QueryCredentialStatusListRequest{Issuer: issuer})
+	require.NoError(t, err)
+	require.Equal(t, types.StatusPurposeRevocation, list.StatusPurpose)
+	require.Equal(t, uint64(2), list.StatusListSize)
+	require.NotEmpty(t, list.EncodedList)
+
+	byHolder, err := k.CredentialsByHolder(ctx, &types.QueryCredentialsByHolderRequest{Holder: holder})
+	require.NoError(t, err)
+	require.Len(t, byHolder.Credential, 2)
+
+	// the first credential never expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = k.Credential(ctx, &types.QueryGetCredentialRequest{Id: first.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_VALID, res.Status)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*types.MsgCreateDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	doc, err := msg.Document()
+	if err != nil {
+		return nil, err
+	}
+
+	// a did:nexelra DID names the holder of an identity
+	if !k.HasActiveIdentity(ctx, msg.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s has no active identity", msg.Creator)
+	}
+	if _, found := k.GetDidRecord(ctx, msg.Creator); found {
+		return nil, errorsmod.Wrap(types.ErrDidExists, doc.Id)
+	}
+
+	if err := k.SetDidRecord(ctx, types.DidRecord{
+		Document: doc,
+		Metadata: types.DidDocumentMetadata{
+			Created:   types.FormatDidTime(ctx.BlockTime()),
+			VersionId: "1",
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidCreated{
+		Did:     doc.Id,
+		Address: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDidResponse{Did: doc.Id}, nil
+}
+
+func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*types.MsgUpdateDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	doc, err := msg.Document()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.activeDidRecord(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Document = doc
+	if err := k.updateDidRecord(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidUpdated{
+		Did:       doc.Id,
+		VersionId: record.Metadata.VersionId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDidResponse{}, nil
+}
+
+func (k msgServer) RotateDidKey(goCtx context.Context, msg *types.MsgRotateDidKey) (*types.MsgRotateDidKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.activeDidRecord(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	methodId, err := types.DidURL(record.Document.Id, msg.MethodId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDidDocument, err.Error())
+	}
+	i := record.Document.VerificationMethodIndex(methodId)
+	if i < 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no verification method %s", methodId)
+	}
+
+	method := &record.Document.VerificationMethod[i]
+	previous := method.PublicKeyMultibase
+	if previous == msg.PublicKeyMultibase {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the new key is the current one")
+	}
+	method.PublicKeyMultibase = msg.PublicKeyMultibase
+	if err := k.updateDidRecord(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidKeyRotated{
+		Did:                        record.Document.Id,
+		MethodId:                   methodId,
+		PreviousPublicKeyMultibase: previous,
+		PublicKeyMultibase:         msg.PublicKeyMultibase,
+		VersionId:                  record.Metadata.VersionId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateDidKeyResponse{}, nil
+}
+
+func (k msgServer) DeactivateDid(goCtx context.Context, msg *types.MsgDeactivateDid) (*types.MsgDeactivateDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.activeDidRecord(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.deactivateDid(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeactivateDidResponse{}, nil
+}
+
+// activeDidRecord returns the DID document of address, which must exist and
+// not be deactivated.
+func (k msgServer) activeDidRecord(ctx sdk.Context, address string) (types.DidRecord, error) {
+	record, found := k.GetDidRecord(ctx, address)
+	if !found {
+		return record, errorsmod.Wrap(types.ErrDidNotFound, types.DidFromAddress(address))
+	}
+	if record.Metadata.Deactivated {
+		return record, errorsmod.Wrap(types.ErrDidDeactivated, record.Document.Id)
+	}
+
+	return record, nil
+}
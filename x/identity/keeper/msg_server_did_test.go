@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+const (
+	testDidKey     = "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"
+	testDidRotated = "z6MkjchhfUsD6mmvni8mCdXHw216Xrm9bQe2mBH1P5RDjVJG"
+)
+
+func TestDidMsgServer(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	holder := sample.AccAddress()
+	did := types.DidFromAddress(holder)
+	methods := []types.VerificationMethod{{Id: "#key-1", Type: "Multikey", PublicKeyMultibase: testDidKey}}
+	create := types.NewMsgCreateDid(holder, methods, []string{"#key-1"}, []string{"#key-1"}, nil)
+
+	// only the holder of an active identity has a DID
+	_, err := srv.CreateDid(ctx, create)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: holder, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}))
+
+	res, err := srv.CreateDid(ctx, create)
+	require.NoError(t, err)
+	require.Equal(t, did, res.Did)
+	_, err = srv.CreateDid(ctx, create)
+	require.ErrorIs(t, err, types.ErrDidExists)
+
+	resolved, err := k.ResolveDid(ctx, &types.QueryResolveDidRequest{Did: did})
+	require.NoError(t, err)
+	require.Equal(t, types.DidContentType, resolved.DidResolutionMetadata.ContentType)
+	require.Equal(t, did+"#key-1", resolved.DidDocument.VerificationMethod[0].Id)
+	require.Equal(t, types.DidDocumentMetadata{Created: "2024-01-02T03:04:05Z", VersionId: "1"}, resolved.DidDocumentMetadata)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	services := []types.Service{{Id: "#kyc", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"}}
+	_, err = srv.UpdateDid(ctx, types.NewMsgUpdateDid(holder, methods, []string{"#key-1"}, nil, services))
+	require.NoError(t, err)
+
+	_, err = srv.RotateDidKey(ctx, types.NewMsgRotateDidKey(holder, "#key-2", testDidRotated))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.RotateDidKey(ctx, types.NewMsgRotateDidKey(holder, "#key-1", testDidKey))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.RotateDidKey(ctx, types.NewMsgRotateDidKey(holder, did+"#key-1", testDidRotated))
+	require.NoError(t, err)
+
+	resolved, err = k.ResolveDid(ctx, &types.QueryResolveDidRequest{Did: did})
+	require.NoError(t, err)
+	require.Equal(t, testDidRotated, resolved.DidDocument.VerificationMethod[0].PublicKeyMultibase)
+	require.Empty(t, resolved.DidDocument.AssertionMethod)
+	require.Equal(t, did+"#kyc", resolved.DidDocument.Service[0].Id)
+	require.Equal(t, types.DidDocumentMetadata{Created: "2024-01-02T03:04:05Z", Updated: "2024-01-02T04:04:05Z", VersionId: "3"}, resolved.DidDocumentMetadata)
+
+	_, err = srv.DeactivateDid(ctx, types.NewMsgDeactivateDid(holder))
+	require.NoError(t, err)
+	resolved, err = k.ResolveDid(ctx, &types.QueryResolveDidRequest{Did: did})
+	require.NoError(t, err)
+	require.True(t, resolved.DidDocumentMetadata.Deactivated)
+	require.Empty(t, resolved.DidDocument.VerificationMethod)
+	require.Empty(t, resolved.DidDocument.Service)
+
+	_, err = srv.RotateDidKey(ctx, types.NewMsgRotateDidKey(holder, "#key-1", testDidKey))
+	require.ErrorIs(t, err, types.ErrDidDeactivated)
+	_, err = srv.DeactivateDid(ctx, types.NewMsgDeactivateDid(holder))
+	require.ErrorIs(t, err, types.ErrDidDeactivated)
+	_, err = srv.UpdateDid(ctx, types.NewMsgUpdateDid(sample.AccAddress(), methods, nil, nil, nil))
+	require.ErrorIs(t, err, types.ErrDidNotFound)
+}
+
+func TestDidDeactivatedOnRevoke(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	holder := sample.AccAddress()
+	require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: holder, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}))
+	_, err := srv.CreateDid(ctx, types.NewMsgCreateDid(holder,
+		[]types.VerificationMethod{{Id: "#key-1", Type: "Multikey", PublicKeyMultibase: testDidKey}}, []string{"#key-1"}, nil, nil))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.RevokeIdentity(ctx, types.NewMsgRevokeIdentity(k.GetAuthority(), holder, "fraud"))
+	require.NoError(t, err)
+
+	record, found := k.GetDidRecord(ctx, holder)
+	require.True(t, found)
+	require.True(t, record.Metadata.Deactivated)
+	require.Empty(t, record.Document.VerificationMethod)
+	require.Equal(t, "2", record.Metadata.VersionId)
+
+	var deactivated bool
+	for _, event := range ctx.EventManager().Events() {
+		deactivated = deactivated || event.Type == "nexelra.identity.EventDidDeactivated"
+	}
+	require.True(t, deactivated)
+}
+
+func TestResolveDidErrors(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+
+	for did, resolutionError := range map[string]string{
+		"did:example:123":                         types.DidResolutionErrorInvalidDid,
+		types.DidFromAddress(sample.AccAddress()): types.DidResolutionErrorNotFound,
+	} {
+		res, err := k.ResolveDid(ctx, &types.QueryResolveDidRequest{Did: did})
+		require.NoError(t, err)
+		require.Equal(t, resolutionError, res.DidResolutionMetadata.Error)
+		require.Nil(t, res.DidDocument)
+	}
+
+	_, err := k.ResolveDid(ctx, nil)
+	require.Error(t, err)
+}
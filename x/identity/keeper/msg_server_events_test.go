@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+// TestIdentityMsgServerEvents walks an identity through its lifecycle and
+// checks the typed event of every step.
+func TestIdentityMsgServerEvents(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	holder := sample.AccAddress()
+	verifier := sample.AccAddress()
+
+	params := k.GetParams(ctx)
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+	update := newMsgUpdateIdentity(t, holder, "001099000002")
+
+	steps := []struct {
+		desc     string
+		run      func(ctx sdk.Context) error
+		expected proto.Message
+	}{
+		{
+			desc: "Create",
+			run: func(ctx sdk.Context) error {
+				_, err := srv.CreateIdentity(ctx, create)
+				return err
+			},
+			expected: &types.EventIdentityCreated{
+				Address:    holder,
+				IdHash:     create.Commitment,
+				HashScheme: create.HashScheme,
+				Status:     types.IdentityStatus_IDENTITY_STATUS_PENDING,
+			},
+		},
+		{
+			desc: "Update",
+			run: func(ctx sdk.Context) error {
+				_, err := srv.UpdateIdentity(ctx, update)
+				return err
+			},
+			expected: &types.EventIdentityUpdated{
+				Address:    holder,
+				IdHash:     update.Commitment,
+				HashScheme: update.HashScheme,
+			},
+		},
+		{
+			desc: "Attest",
+			run: func(ctx sdk.Context) error {
+				_, err := srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, holder, update.Commitment, "001099000002"))
+				return err
+			},
+			expected: &types.EventIdentityAttested{
+				Address:  holder,
+				Verifier: verifier,
+				IdHash:   update.Commitment,
+				Level:    types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+			},
+		},
+		{
+			desc: "SetLevel",
+			run: func(ctx sdk.Context) error {
+				_, err := srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, holder, types.IdentityLevel_IDENTITY_LEVEL_ENHANCED))
+				return err
+			},
+			expected: &types.EventIdentityLevelChanged{
+				Address:       holder,
+				Verifier:      verifier,
+				PreviousLevel: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+				Level:         types.IdentityLevel_IDENTITY_LEVEL_ENHANCED,
+			},
+		},
+		{
+			desc: "Suspend",
+			run: func(ctx sdk.Context) error {
+				return suspend(srv, ctx, authority, holder)
+			},
+			expected: &types.EventIdentitySuspended{
+				Address:   holder,
+				Authority: authority,
+				Reason:    "under investigation",
+			},
+		},
+		{
+			desc: "Reinstate",
+			run: func(ctx sdk.Context) error {
+				return reinstate(srv, ctx, authority, holder)
+			},
+			expected: &types.EventIdentityReinstated{
+				Address:   holder,
+				Authority: authority,
+			},
+		},
+		{
+			desc: "Revoke",
+			run: func(ctx sdk.Context) error {
+				return revoke(srv, ctx, authority, holder)
+			},
+			expected: &types.EventIdentityRevoked{
+				Address:        holder,
+				Authority:      authority,
+				Reason:         "forged document",
+				PreviousStatus: types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+			},
+		},
+		{
+			desc: "UpdateParams",
+			run: func(ctx sdk.Context) error {
+				_, err := srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+				return err
+			},
+			expected: &types.EventParamsUpdated{
+				Authority: authority,
+				Params:    params,
+			},
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.desc, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(t, step.run(ctx))
+
+			// x/nft's events of the identity NFT precede the identity event
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			event := events[len(events)-1]
+			require.Equal(t, proto.MessageName(step.expected), event.Type)
+
+			got, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			// unset and empty repeated fields decode alike, compare as protos
+			require.Truef(t, proto.Equal(step.expected, got), "expected %v, got %v", step.expected, got)
+		})
+	}
+}
+
+func TestIdentityMsgServerEventAttributes(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	holder := sample.AccAddress()
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+
+	// attributes hold JSON values, which is what websocket subscriptions such as
+	// nexelra.identity.EventIdentityCreated.address='"<address>"' match on
+	attrs := make(map[string]string)
+	for _, attr := range ctx.EventManager().Events()[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, `"`+holder+`"`, attrs["address"])
+	require.Equal(t, `"`+create.Commitment+`"`, attrs["idHash"])
+	require.Equal(t, `"HASH_SCHEME_SALTED_HMAC_SHA256"`, attrs["hashScheme"])
+	require.Equal(t, `"IDENTITY_STATUS_PENDING"`, attrs["status"])
+}
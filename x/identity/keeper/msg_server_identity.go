@@ -2,9 +2,7 @@ package keeper
 
 import (
     "context"
-    "crypto/sha256"
-    "fmt"
-    "time"
+    "strings"
 
     "Nexelra/x/identity/types"
 
@@ -16,23 +14,124 @@ import (
 func (k msgServer) CreateIdentity(goCtx context.Context, msg *types.MsgCreateIdentity) (*types.MsgCreateIdentityResponse, error) {
     ctx := sdk.UnwrapSDKContext(goCtx)
 
+    creator, err := sdk.AccAddressFromBech32(msg.Creator)
+    if err != nil {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+    }
+
     // Kiểm tra xem address đã có identity chưa (1 địa chỉ = 1 định danh)
     _, found := k.GetIdentity(ctx, msg.Creator)
     if found {
         return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "address already has an identity")
     }
 
-    // Hash CCCD ID để bảo mật
-    hash := sha256.Sum256([]byte(msg.CccdId))
-    idHash := fmt.Sprintf("%x", hash)
+    // CCCD được cam kết (commitment) phía client, chuỗi chỉ nhận giá trị đã hash
+    params := k.GetParams(ctx)
+    if msg.HashScheme != params.HashScheme {
+        return nil, errorsmod.Wrapf(types.ErrUnsupportedHashScheme, "expected %s, got %s", params.HashScheme, msg.HashScheme)
+    }
+    idHash := strings.ToLower(msg.Commitment)
+
+    // Một CCCD chỉ được gắn với một địa chỉ. Định danh đang chờ xác thực chưa
+    // giữ commitment, nếu không ai cũng có thể chiếm trước commitment của
+    // người khác; tính duy nhất được kiểm tra lại khi xác thực
+    if existing, found := k.GetIdentityByIdHash(ctx, idHash); found {
+        return nil, errorsmod.Wrapf(types.ErrCccdAlreadyRegistered, "bound to %s", existing.Address)
+    }
 
+    // Thời gian lấy từ block header để mọi validator ghi cùng một giá trị.
+    // Định danh chỉ có hiệu lực sau khi được một verifier xác thực.
     var identity = types.Identity{
-        Address:   msg.Creator,
-        IdHash:    idHash,
-        CreatedAt: time.Now().Unix(),
+        Address:       msg.Creator,
+        IdHash:        idHash,
+        CreatedAt:     ctx.BlockTime().Unix(),
+        CreatedHeight: ctx.BlockHeight(),
+        HashScheme:    msg.HashScheme,
+        Status:        types.IdentityStatus_IDENTITY_STATUS_PENDING,
     }
 
-    k.SetIdentity(ctx, identity)
+    if err := k.SetIdentity(ctx, identity); err != nil {
+        return nil, err
+    }
+
+    if err := ctx.EventManager().EmitTypedEvent(types.NewEventIdentityCreated(identity)); err != nil {
+        return nil, err
+    }
+
+    if err := k.Hooks().AfterIdentityCreated(ctx, creator); err != nil {
+        return nil, err
+    }
 
     return &types.MsgCreateIdentityResponse{}, nil
 }
+
+func (k msgServer) UpdateIdentity(goCtx context.Context, msg *types.MsgUpdateIdentity) (*types.MsgUpdateIdentityResponse, error) {
+    ctx := sdk.UnwrapSDKContext(goCtx)
+
+    identity, found := k.GetIdentity(ctx, msg.Creator)
+    if !found {
+        return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "address has no identity")
+    }
+
+    // Chỉ định danh đang hoạt động hoặc đang chờ xác thực mới được đổi commitment
+    if identity.Status != types.IdentityStatus_IDENTITY_STATUS_ACTIVE &&
+        identity.Status != types.IdentityStatus_IDENTITY_STATUS_PENDING {
+        return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "identity is %s", identity.Status)
+    }
+
+    params := k.GetParams(ctx)
+    if msg.HashScheme != params.HashScheme {
+        return nil, errorsmod.Wrapf(types.ErrUnsupportedHashScheme, "expected %s, got %s", params.HashScheme, msg.HashScheme)
+    }
+    idHash := strings.ToLower(msg.Commitment)
+
+    if existing, found := k.GetIdentityByIdHash(ctx, idHash); found && existing.Address != msg.Creator {
+        return nil, errorsmod.Wrapf(types.ErrCccdAlreadyRegistered, "bound to %s", existing.Address)
+    }
+
+    creator, err := sdk.AccAddressFromBech32(msg.Creator)
+    if err != nil {
+        return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+    }
+    if err := k.Hooks().BeforeIdentityUpdated(ctx, creator); err != nil {
+        return nil, err
+    }
+
+    // Commitment mới phải được verifier xác thực lại
+    cccdKey := identity.CccdKey()
+    identity.IdHash = idHash
+    identity.HashScheme = msg.HashScheme
+    identity.Status = types.IdentityStatus_IDENTITY_STATUS_PENDING
+    identity.Verifier = ""
+    identity.AttestedAt = 0
+    identity.AttestedHeight = 0
+    identity.Level = types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+    // CccdTag được giữ lại để địa chỉ khác không chiếm được CCCD trong lúc chờ
+    // xác thực lại; attestation mới sẽ ghi đè nó
+    identity.UpdatedAt = ctx.BlockTime().Unix()
+    identity.UpdatedHeight = ctx.BlockHeight()
+
+    // Hạn mức chi tiêu đi theo CCCD, không reset khi đổi commitment
+    if err := k.MoveIdentitySpend(ctx, cccdKey, identity.CccdKey()); err != nil {
+        return nil, err
+    }
+
+    if err := k.SetIdentity(ctx, identity); err != nil {
+        return nil, err
+    }
+
+    // NFT chỉ dành cho định danh đã được xác thực, attestation mới sẽ cấp lại
+    if err := k.burnIdentityNFT(ctx, identity.Address); err != nil {
+        return nil, err
+    }
+
+    if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentityUpdated{
+        Address:    identity.Address,
+        IdHash:     identity.IdHash,
+        HashScheme: identity.HashScheme,
+    }); err != nil {
+        return nil, err
+    }
+
+    return &types.MsgUpdateIdentityResponse{}, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) AttestIdentity(goCtx context.Context, msg *types.MsgAttestIdentity) (*types.MsgAttestIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsVerifier(msg.Verifier) {
+		return nil, errorsmod.Wrap(types.ErrUnknownVerifier, msg.Verifier)
+	}
+
+	identity, found := k.GetIdentity(ctx, msg.Address)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no identity for %s", msg.Address)
+	}
+
+	if identity.Status != types.IdentityStatus_IDENTITY_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "identity is %s, not pending", identity.Status)
+	}
+
+	// The commitment may have been replaced after the verifier checked it
+	if identity.IdHash != strings.ToLower(msg.IdHash) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commitment does not match the pending identity")
+	}
+
+	// Pending identities do not hold their commitment, so the first to be
+	// attested binds it
+	if existing, found := k.GetIdentityByIdHash(ctx, identity.IdHash); found && existing.Address != identity.Address {
+		return nil, errorsmod.Wrapf(types.ErrCccdAlreadyRegistered, "bound to %s", existing.Address)
+	}
+
+	// Salted commitments differ for each holder of a CCCD, the tag is what
+	// keeps it on one address
+	cccdTag := strings.ToLower(msg.CccdTag)
+	if identity.HashScheme == types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 && cccdTag == "" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s commitments are attested with a CCCD tag", identity.HashScheme)
+	}
+	if existing, found := k.GetIdentityByCccdTag(ctx, cccdTag); found && existing.Address != identity.Address {
+		return nil, errorsmod.Wrapf(types.ErrCccdAlreadyRegistered, "bound to %s", existing.Address)
+	}
+	// Identities registered before tags existed are only found by their
+	// unsalted commitment
+	for _, idHash := range msg.LegacyIdHashes {
+		if existing, found := k.GetIdentityByIdHash(ctx, strings.ToLower(idHash)); found && existing.Address != identity.Address {
+			return nil, errorsmod.Wrapf(types.ErrCccdAlreadyRegistered, "bound to %s", existing.Address)
+		}
+	}
+	// The spend follows the CCCD once the identity is tagged
+	if cccdTag != "" {
+		if err := k.MoveIdentitySpend(ctx, identity.CccdKey(), cccdTag); err != nil {
+			return nil, err
+		}
+		identity.CccdTag = cccdTag
+	}
+
+	identity.Status = types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+	identity.StatusReason = ""
+	identity.Verifier = msg.Verifier
+	identity.AttestedAt = ctx.BlockTime().Unix()
+	identity.AttestedHeight = ctx.BlockHeight()
+	identity.Level = types.AttestedLevel(msg.Level)
+	if err := k.SetIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	if err := k.issueIdentityNFT(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentityAttested{
+		Address:  identity.Address,
+		Verifier: msg.Verifier,
+		IdHash:   identity.IdHash,
+		Level:    identity.Level,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestIdentityResponse{}, nil
+}
@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestIdentityMsgServerAttest(t *testing.T) {
+	verifier := sample.AccAddress()
+	holder := sample.AccAddress()
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+
+	tests := []struct {
+		desc    string
+		request *types.MsgAttestIdentity
+		status  types.IdentityStatus
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: newMsgAttestIdentity(t, verifier, holder, create.Commitment, "001099000001"),
+			status:  types.IdentityStatus_IDENTITY_STATUS_PENDING,
+		},
+		{
+			desc:    "UnknownVerifier",
+			request: newMsgAttestIdentity(t, sample.AccAddress(), holder, create.Commitment, "001099000001"),
+			status:  types.IdentityStatus_IDENTITY_STATUS_PENDING,
+			err:     types.ErrUnknownVerifier,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: newMsgAttestIdentity(t, verifier, sample.AccAddress(), create.Commitment, "001099000001"),
+			status:  types.IdentityStatus_IDENTITY_STATUS_PENDING,
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "CommitmentMismatch",
+			request: types.NewMsgAttestIdentity(verifier, holder, newMsgCreateIdentity(t, holder, "001099000002").Commitment),
+			status:  types.IdentityStatus_IDENTITY_STATUS_PENDING,
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "AlreadyActive",
+			request: newMsgAttestIdentity(t, verifier, holder, create.Commitment, "001099000001"),
+			status:  types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+			err:     types.ErrInvalidStatus,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			srv := keeper.NewMsgServerImpl(k)
+			params := types.DefaultParams()
+			params.Verifiers = []string{verifier}
+			require.NoError(t, k.SetParams(ctx, params))
+
+			_, err := srv.CreateIdentity(ctx, create)
+			require.NoError(t, err)
+			identity, _ := k.GetIdentity(ctx, holder)
+			require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_PENDING, identity.Status)
+			identity.Status = tc.status
+			require.NoError(t, k.SetIdentity(ctx, identity))
+
+			ctx = ctx.WithBlockHeight(7)
+			_, err = srv.AttestIdentity(ctx, tc.request)
+			rst, found := k.GetIdentity(ctx, holder)
+			require.True(t, found)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, tc.status, rst.Status)
+				require.Empty(t, rst.Verifier)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_ACTIVE, rst.Status)
+				require.Equal(t, verifier, rst.Verifier)
+				require.Equal(t, int64(7), rst.AttestedHeight)
+				require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, rst.Level)
+			}
+		})
+	}
+}
+
+// TestIdentityMsgServerAttestCccdTag registers one CCCD ID from two
+// addresses with different salts: the commitments differ, the CCCD tag the
+// verifier attests does not.
+func TestIdentityMsgServerAttestCccdTag(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verifier := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	commit := func(salt byte) string {
+		commitment, err := types.ComputeCommitment(params.HashScheme, params.Pepper, bytes.Repeat([]byte{salt}, types.SaltLength), "001099000001")
+		require.NoError(t, err)
+		return commitment
+	}
+	holder, other := sample.AccAddress(), sample.AccAddress()
+	_, err := srv.CreateIdentity(ctx, types.NewMsgCreateIdentity(holder, commit(1), params.HashScheme))
+	require.NoError(t, err)
+	_, err = srv.CreateIdentity(ctx, types.NewMsgCreateIdentity(other, commit(2), params.HashScheme))
+	require.NoError(t, err)
+
+	// salted commitments need the tag
+	_, err = srv.AttestIdentity(ctx, types.NewMsgAttestIdentity(verifier, holder, commit(1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	attest := newMsgAttestIdentity(t, verifier, holder, commit(1), "001099000001")
+	_, err = srv.AttestIdentity(ctx, attest)
+	require.NoError(t, err)
+	identity, found := k.GetIdentityByCccdTag(ctx, attest.CccdTag)
+	require.True(t, found)
+	require.Equal(t, holder, identity.Address)
+
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, other, commit(2), "001099000001"))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+	identity, _ = k.GetIdentity(ctx, other)
+	require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_PENDING, identity.Status)
+	require.Empty(t, identity.CccdTag)
+
+	// the holder keeps the tag while a new commitment waits for attestation
+	_, err = srv.UpdateIdentity(ctx, types.NewMsgUpdateIdentity(holder, commit(3), params.HashScheme))
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, other, commit(2), "001099000001"))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, holder, commit(3), "001099000001"))
+	require.NoError(t, err)
+}
+
+func TestIdentityMsgServerAttestLegacyCommitment(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verifier := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// an identity attested under the unsalted scheme before tags existed
+	cccdId := "001099000001"
+	legacy := types.Identity{
+		Address:    sample.AccAddress(),
+		IdHash:     types.LegacyCommitments(params.Pepper, cccdId)[1],
+		HashScheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256,
+		Status:     types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		Verifier:   verifier,
+	}
+	require.NoError(t, k.SetIdentity(ctx, legacy))
+
+	// the same CCCD under a salted commitment from a new address
+	other := sample.AccAddress()
+	create := newMsgCreateIdentity(t, other, cccdId)
+	_, err := srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, other, create.Commitment, cccdId))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+	identity, _ := k.GetIdentity(ctx, other)
+	require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_PENDING, identity.Status)
+
+	// the legacy holder moves to a salted commitment and keeps the CCCD
+	salted, err := types.ComputeCommitment(params.HashScheme, params.Pepper, bytes.Repeat([]byte{1}, types.SaltLength), cccdId)
+	require.NoError(t, err)
+	_, err = srv.UpdateIdentity(ctx, types.NewMsgUpdateIdentity(legacy.Address, salted, params.HashScheme))
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, legacy.Address, salted, cccdId))
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, other, create.Commitment, cccdId))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+}
+
+func TestIdentityMsgServerAttestSquattedCommitment(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verifier := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a squatter registers the victim's commitment first, copied from the
+	// mempool
+	victim, squatter := sample.AccAddress(), sample.AccAddress()
+	create := newMsgCreateIdentity(t, victim, "001099000001")
+	_, err := srv.CreateIdentity(ctx, types.NewMsgCreateIdentity(squatter, create.Commitment, create.HashScheme))
+	require.NoError(t, err)
+
+	// the pending claim does not lock the victim out
+	_, err = srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, victim, create.Commitment, "001099000001"))
+	require.NoError(t, err)
+	identity, found := k.GetIdentityByIdHash(ctx, create.Commitment)
+	require.True(t, found)
+	require.Equal(t, victim, identity.Address)
+
+	// once attested, the commitment is bound
+	_, err = srv.AttestIdentity(ctx, types.NewMsgAttestIdentity(verifier, squatter, create.Commitment))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+	_, err = srv.CreateIdentity(ctx, types.NewMsgCreateIdentity(sample.AccAddress(), create.Commitment, create.HashScheme))
+	require.ErrorIs(t, err, types.ErrCccdAlreadyRegistered)
+	identity, _ = k.GetIdentity(ctx, squatter)
+	require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_PENDING, identity.Status)
+}
+
+func TestIdentityQueryByVerifier(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verifiers := []string{sample.AccAddress(), sample.AccAddress()}
+	params := types.DefaultParams()
+	params.Verifiers = verifiers
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// three identities attested by the first verifier, one by the second
+	var holders []string
+	for i, cccdId := range []string{"001099000001", "001099000002", "001099000003", "001099000004"} {
+		holder := sample.AccAddress()
+		msg := newMsgCreateIdentity(t, holder, cccdId)
+		_, err := srv.CreateIdentity(ctx, msg)
+		require.NoError(t, err)
+		_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifiers[i/3], holder, msg.Commitment, cccdId))
+		require.NoError(t, err)
+		holders = append(holders, holder)
+	}
+
+	addresses := func(verifier string) []string {
+		resp, err := k.IdentitiesByVerifier(ctx, &types.QueryIdentitiesByVerifierRequest{
+			Verifier:   verifier,
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(resp.Identity)), resp.Pagination.Total)
+		var list []string
+		for _, identity := range resp.Identity {
+			list = append(list, identity.Address)
+		}
+		return list
+	}
+
+	require.ElementsMatch(t, holders[:3], addresses(verifiers[0]))
+	require.ElementsMatch(t, holders[3:], addresses(verifiers[1]))
+
+	// re-verification drops the identity from its old verifier's list
+	_, err := srv.UpdateIdentity(ctx, newMsgUpdateIdentity(t, holders[0], "001099000009"))
+	require.NoError(t, err)
+	require.ElementsMatch(t, holders[1:3], addresses(verifiers[0]))
+
+	_, err = k.IdentitiesByVerifier(ctx, &types.QueryIdentitiesByVerifierRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) SetIdentityLevel(goCtx context.Context, msg *types.MsgSetIdentityLevel) (*types.MsgSetIdentityLevelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsVerifier(msg.Verifier) {
+		return nil, errorsmod.Wrap(types.ErrUnknownVerifier, msg.Verifier)
+	}
+
+	identity, found := k.GetIdentity(ctx, msg.Address)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no identity for %s", msg.Address)
+	}
+
+	if identity.Status != types.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStatus, "identity is %s, not active", identity.Status)
+	}
+
+	// The level vouches for a commitment some verifier checked
+	if identity.Verifier == "" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "identity of %s was never attested", msg.Address)
+	}
+
+	previous := identity.Level
+	identity.Level = msg.Level
+	identity.UpdatedAt = ctx.BlockTime().Unix()
+	identity.UpdatedHeight = ctx.BlockHeight()
+	if err := k.SetIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	// the NFT carries the level, reissue it
+	if err := k.issueIdentityNFT(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentityLevelChanged{
+		Address:       identity.Address,
+		Verifier:      msg.Verifier,
+		PreviousLevel: previous,
+		Level:         identity.Level,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetIdentityLevelResponse{}, nil
+}
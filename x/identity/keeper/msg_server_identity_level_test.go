@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestIdentityMsgServerSetLevel(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	verifier := sample.AccAddress()
+	holder := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	level := func() types.IdentityLevel {
+		t.Helper()
+		identity, found := k.GetIdentity(ctx, holder)
+		require.True(t, found)
+		return identity.Level
+	}
+	enhanced := types.IdentityLevel_IDENTITY_LEVEL_ENHANCED
+
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+	_, err := srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, level())
+
+	// pending identities get their level from the attestation
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, holder, enhanced))
+	require.ErrorIs(t, err, types.ErrInvalidStatus)
+	attest := newMsgAttestIdentity(t, verifier, holder, create.Commitment, "001099000001")
+	attest.Level = enhanced
+	_, err = srv.AttestIdentity(ctx, attest)
+	require.NoError(t, err)
+	require.Equal(t, enhanced, level())
+
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(sample.AccAddress(), holder, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED))
+	require.ErrorIs(t, err, types.ErrUnknownVerifier)
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, sample.AccAddress(), types.IdentityLevel_IDENTITY_LEVEL_VERIFIED))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	ctx = ctx.WithBlockHeight(9)
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, holder, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED))
+	require.NoError(t, err)
+	identity, _ := k.GetIdentity(ctx, holder)
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, identity.Level)
+	require.Equal(t, int64(9), identity.UpdatedHeight)
+
+	// a new commitment is self-declared until attested again
+	_, err = srv.UpdateIdentity(ctx, newMsgUpdateIdentity(t, holder, "001099000002"))
+	require.NoError(t, err)
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, level())
+
+	// identities nobody attested cannot be raised without an attestation
+	legacy := sample.AccAddress()
+	require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: legacy, IdHash: "legacy"}))
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, legacy, enhanced))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMsgRequirementsQuery(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	limits := []types.LevelSpendLimit{
+		{Level: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, MaxTxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+	}
+
+	params := k.GetParams(ctx)
+	params.GatingPolicy.LevelRules = []types.MsgLevelRule{{MsgTypeUrl: sendType, MinLevel: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED}}
+	params.GatingPolicy.AttributeRules = []types.MsgAttributeRule{{MsgTypeUrl: sendType, Attributes: []string{"age-over-18"}}}
+	params.SpendPolicy.Limits = limits
+	require.NoError(t, k.SetParams(ctx, params))
+
+	res, err := k.MsgRequirements(ctx, &types.QueryMsgRequirementsRequest{MsgTypeUrl: sendType})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMsgRequirementsResponse{
+		Rule:        types.GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT,
+		MinLevel:    types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		Attributes:  []string{"age-over-18"},
+		SpendLimits: limits,
+	}, res)
+
+	// exempt messages are not checked at all
+	res, err = k.MsgRequirements(ctx, &types.QueryMsgRequirementsRequest{MsgTypeUrl: sdk.MsgTypeURL(&types.MsgCreateIdentity{})})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMsgRequirementsResponse{Rule: types.GatingRule_GATING_RULE_EXEMPT}, res)
+
+	_, err = k.MsgRequirements(ctx, &types.QueryMsgRequirementsRequest{})
+	require.Error(t, err)
+}
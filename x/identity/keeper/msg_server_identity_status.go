@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k msgServer) RevokeIdentity(goCtx context.Context, msg *types.MsgRevokeIdentity) (*types.MsgRevokeIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	previous, err := k.setIdentityStatus(ctx, msg.Authority, msg.Address, types.IdentityStatus_IDENTITY_STATUS_REVOKED, msg.Reason,
+		types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		types.IdentityStatus_IDENTITY_STATUS_SUSPENDED,
+		types.IdentityStatus_IDENTITY_STATUS_PENDING,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.burnIdentityNFT(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	// the DID of a revoked identity no longer names anyone
+	if err := k.deactivateDid(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentityRevoked{
+		Address:        msg.Address,
+		Authority:      msg.Authority,
+		Reason:         msg.Reason,
+		PreviousStatus: previous,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterIdentityRevoked(ctx, sdk.MustAccAddressFromBech32(msg.Address)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeIdentityResponse{}, nil
+}
+
+func (k msgServer) SuspendIdentity(goCtx context.Context, msg *types.MsgSuspendIdentity) (*types.MsgSuspendIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.setIdentityStatus(ctx, msg.Authority, msg.Address, types.IdentityStatus_IDENTITY_STATUS_SUSPENDED, msg.Reason,
+		types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentitySuspended{
+		Address:   msg.Address,
+		Authority: msg.Authority,
+		Reason:    msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendIdentityResponse{}, nil
+}
+
+func (k msgServer) ReinstateIdentity(goCtx context.Context, msg *types.MsgReinstateIdentity) (*types.MsgReinstateIdentityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.setIdentityStatus(ctx, msg.Authority, msg.Address, types.IdentityStatus_IDENTITY_STATUS_ACTIVE, "",
+		types.IdentityStatus_IDENTITY_STATUS_SUSPENDED,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIdentityReinstated{
+		Address:   msg.Address,
+		Authority: msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReinstateIdentityResponse{}, nil
+}
+
+// setIdentityStatus moves the identity at address to status on behalf of
+// authority, provided its current status is one of from. It returns the
+// status the identity had before.
+func (k msgServer) setIdentityStatus(
+	ctx sdk.Context,
+	authority string,
+	address string,
+	status types.IdentityStatus,
+	reason string,
+	from ...types.IdentityStatus,
+) (types.IdentityStatus, error) {
+	if k.GetAuthority() != authority {
+		return 0, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), authority)
+	}
+
+	identity, found := k.GetIdentity(ctx, address)
+	if !found {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no identity for %s", address)
+	}
+
+	allowed := false
+	for _, s := range from {
+		if identity.Status == s {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStatus, "cannot move identity from %s to %s", identity.Status, status)
+	}
+
+	previous := identity.Status
+	identity.Status = status
+	identity.StatusReason = reason
+	identity.UpdatedAt = ctx.BlockTime().Unix()
+	identity.UpdatedHeight = ctx.BlockHeight()
+	if err := k.SetIdentity(ctx, identity); err != nil {
+		return 0, err
+	}
+
+	return previous, nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestIdentityMsgServerStatus(t *testing.T) {
+	holder := sample.AccAddress()
+
+	const (
+		active    = types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+		suspended = types.IdentityStatus_IDENTITY_STATUS_SUSPENDED
+		revoked   = types.IdentityStatus_IDENTITY_STATUS_REVOKED
+		pending   = types.IdentityStatus_IDENTITY_STATUS_PENDING
+	)
+
+	tests := []struct {
+		desc      string
+		from      types.IdentityStatus
+		authority string
+		address   string
+		run       func(srv types.MsgServer, ctx context.Context, authority, address string) error
+		expected  types.IdentityStatus
+		err       error
+	}{
+		{
+			desc:     "SuspendActive",
+			from:     active,
+			run:      suspend,
+			expected: suspended,
+		},
+		{
+			desc:     "ReinstateSuspended",
+			from:     suspended,
+			run:      reinstate,
+			expected: active,
+		},
+		{
+			desc:     "RevokeActive",
+			from:     active,
+			run:      revoke,
+			expected: revoked,
+		},
+		{
+			desc:     "RevokeSuspended",
+			from:     suspended,
+			run:      revoke,
+			expected: revoked,
+		},
+		{
+			desc:     "RevokePending",
+			from:     pending,
+			run:      revoke,
+			expected: revoked,
+		},
+		{
+			desc: "SuspendPending",
+			from: pending,
+			run:  suspend,
+			err:  types.ErrInvalidStatus,
+		},
+		{
+			desc: "ReinstateActive",
+			from: active,
+			run:  reinstate,
+			err:  types.ErrInvalidStatus,
+		},
+		{
+			desc: "ReinstateRevoked",
+			from: revoked,
+			run:  reinstate,
+			err:  types.ErrInvalidStatus,
+		},
+		{
+			desc: "SuspendRevoked",
+			from: revoked,
+			run:  suspend,
+			err:  types.ErrInvalidStatus,
+		},
+		{
+			desc:      "Unauthorized",
+			from:      active,
+			authority: sample.AccAddress(),
+			run:       revoke,
+			err:       types.ErrInvalidSigner,
+		},
+		{
+			desc:    "KeyNotFound",
+			from:    active,
+			address: sample.AccAddress(),
+			run:     suspend,
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+			srv := keeper.NewMsgServerImpl(k)
+			require.NoError(t, k.SetIdentity(ctx, types.Identity{Address: holder, IdHash: "hash", Status: tc.from}))
+
+			authority, address := tc.authority, tc.address
+			if authority == "" {
+				authority = k.GetAuthority()
+			}
+			if address == "" {
+				address = holder
+			}
+
+			err := tc.run(srv, ctx, authority, address)
+			rst, found := k.GetIdentity(ctx, holder)
+			require.True(t, found)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Equal(t, tc.from, rst.Status)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, rst.Status)
+			}
+		})
+	}
+}
+
+func suspend(srv types.MsgServer, ctx context.Context, authority, address string) error {
+	_, err := srv.SuspendIdentity(ctx, types.NewMsgSuspendIdentity(authority, address, "under investigation"))
+	return err
+}
+
+func reinstate(srv types.MsgServer, ctx context.Context, authority, address string) error {
+	_, err := srv.ReinstateIdentity(ctx, types.NewMsgReinstateIdentity(authority, address))
+	return err
+}
+
+func revoke(srv types.MsgServer, ctx context.Context, authority, address string) error {
+	_, err := srv.RevokeIdentity(ctx, types.NewMsgRevokeIdentity(authority, address, "forged document"))
+	return err
+}
@@ -1,13 +1,18 @@
 package keeper_test
 
 import (
+	"bytes"
+	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
 	"Nexelra/x/identity/keeper"
 	"Nexelra/x/identity/types"
 )
@@ -15,125 +20,241 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
+// testSalt is the salt test holders commit with, and testCccdTagKey the
+// secret test verifiers derive CCCD tags with.
+var testSalt = bytes.Repeat([]byte{0x5a}, types.SaltLength)
+
+const testCccdTagKey = "verifier-secret"
+
+// newMsgCreateIdentity builds a registration committing to cccdId under the
+// default params.
+func newMsgCreateIdentity(t testing.TB, creator, cccdId string) *types.MsgCreateIdentity {
+	params := types.DefaultParams()
+	commitment, err := types.ComputeCommitment(params.HashScheme, params.Pepper, testSalt, cccdId)
+	require.NoError(t, err)
+	return types.NewMsgCreateIdentity(creator, commitment, params.HashScheme)
+}
+
+// newMsgAttestIdentity builds the attestation of a commitment to cccdId,
+// tagged with the CCCD tag of cccdId and listing its unsalted commitments
+// under the default params.
+func newMsgAttestIdentity(t testing.TB, verifier, holder, commitment, cccdId string) *types.MsgAttestIdentity {
+	tag, err := types.ComputeCccdTag(testCccdTagKey, cccdId)
+	require.NoError(t, err)
+	msg := types.NewMsgAttestIdentity(verifier, holder, commitment)
+	msg.CccdTag = tag
+	msg.LegacyIdHashes = types.LegacyCommitments(types.DefaultParams().Pepper, cccdId)
+	return msg
+}
+
+// newMsgUpdateIdentity builds a re-verification committing to cccdId under the
+// default params.
+func newMsgUpdateIdentity(t testing.TB, creator, cccdId string) *types.MsgUpdateIdentity {
+	msg := newMsgCreateIdentity(t, creator, cccdId)
+	return types.NewMsgUpdateIdentity(msg.Creator, msg.Commitment, msg.HashScheme)
+}
+
 func TestIdentityMsgServerCreate(t *testing.T) {
 	k, ctx := keepertest.IdentityKeeper(t)
 	srv := keeper.NewMsgServerImpl(k)
-	creator := "A"
 	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateIdentity{Creator: creator,
-			Address: strconv.Itoa(i),
-		}
+		expected := newMsgCreateIdentity(t, sample.AccAddress(), fmt.Sprintf("0010990%05d", i))
 		_, err := srv.CreateIdentity(ctx, expected)
 		require.NoError(t, err)
 		rst, found := k.GetIdentity(ctx,
-			expected.Address,
+			expected.Creator,
 		)
 		require.True(t, found)
-		require.Equal(t, expected.Creator, rst.Creator)
+		require.Equal(t, expected.Creator, rst.Address)
+		require.Equal(t, expected.Commitment, rst.IdHash)
+		require.Equal(t, expected.HashScheme, rst.HashScheme)
 	}
 }
 
-func TestIdentityMsgServerUpdate(t *testing.T) {
-	creator := "A"
+func TestIdentityMsgServerCreateDeterministic(t *testing.T) {
+	creator := sample.AccAddress()
+	create := newMsgCreateIdentity(t, creator, "001099000001")
+	update := newMsgUpdateIdentity(t, creator, "001099000002")
+
+	// Header times well before and well after the wall clock: a handler
+	// stamping time.Now() instead of the header time cannot match both, nor
+	// write the same state in two runs.
+	for _, blockTime := range []time.Time{
+		time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2121, time.January, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		t.Run(blockTime.Format(time.DateOnly), func(t *testing.T) {
+			var states [][]types.Identity
+			var events []sdk.Events
+			for i := 0; i < 2; i++ {
+				k, ctx := keepertest.IdentityKeeper(t)
+				ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(42).WithEventManager(sdk.NewEventManager())
+				srv := keeper.NewMsgServerImpl(k)
+
+				_, err := srv.CreateIdentity(ctx, create)
+				require.NoError(t, err)
+				identity, found := k.GetIdentity(ctx, creator)
+				require.True(t, found)
+				require.Equal(t, blockTime.Unix(), identity.CreatedAt)
+				require.Equal(t, int64(42), identity.CreatedHeight)
+
+				// a re-verification in a later block stamps that block
+				ctx = ctx.WithBlockTime(blockTime.Add(time.Hour)).WithBlockHeight(43)
+				_, err = srv.UpdateIdentity(ctx, update)
+				require.NoError(t, err)
+				identity, found = k.GetIdentity(ctx, creator)
+				require.True(t, found)
+				require.Equal(t, blockTime.Unix(), identity.CreatedAt)
+				require.Equal(t, blockTime.Add(time.Hour).Unix(), identity.UpdatedAt)
+				require.Equal(t, int64(43), identity.UpdatedHeight)
+
+				states = append(states, k.GetAllIdentity(ctx))
+				events = append(events, ctx.EventManager().Events())
+			}
+
+			require.Equal(t, states[0], states[1])
+			require.Equal(t, events[0], events[1])
+			require.NotEmpty(t, events[0])
+		})
+	}
+}
+
+func TestIdentityMsgServerCreateDuplicate(t *testing.T) {
+	creator := sample.AccAddress()
 
 	tests := []struct {
 		desc    string
-		request *types.MsgUpdateIdentity
+		request *types.MsgCreateIdentity
 		err     error
 	}{
 		{
-			desc: "Completed",
-			request: &types.MsgUpdateIdentity{Creator: creator,
-				Address: strconv.Itoa(0),
-			},
+			desc:    "Completed",
+			request: newMsgCreateIdentity(t, sample.AccAddress(), "001099000002"),
+		},
+		{
+			desc:    "AddressAlreadyRegistered",
+			request: newMsgCreateIdentity(t, creator, "001099000002"),
+			err:     sdkerrors.ErrInvalidRequest,
 		},
 		{
-			desc: "Unauthorized",
-			request: &types.MsgUpdateIdentity{Creator: "B",
-				Address: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
+			desc:    "CccdAlreadyRegistered",
+			request: newMsgCreateIdentity(t, sample.AccAddress(), "001099000001"),
+			err:     types.ErrCccdAlreadyRegistered,
 		},
 		{
-			desc: "KeyNotFound",
-			request: &types.MsgUpdateIdentity{Creator: creator,
-				Address: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
+			desc: "HashSchemeMismatch",
+			request: types.NewMsgCreateIdentity(sample.AccAddress(),
+				newMsgCreateIdentity(t, creator, "001099000003").Commitment,
+				types.HashScheme_HASH_SCHEME_SHA256,
+			),
+			err: types.ErrUnsupportedHashScheme,
 		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
 			k, ctx := keepertest.IdentityKeeper(t)
 			srv := keeper.NewMsgServerImpl(k)
-			expected := &types.MsgCreateIdentity{Creator: creator,
-				Address: strconv.Itoa(0),
-			}
-			_, err := srv.CreateIdentity(ctx, expected)
+			_, err := srv.CreateIdentity(ctx, newMsgCreateIdentity(t, creator, "001099000001"))
 			require.NoError(t, err)
+			// attested, the identity binds its commitment
+			original, found := k.GetIdentity(ctx, creator)
+			require.True(t, found)
+			original.Status = types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+			require.NoError(t, k.SetIdentity(ctx, original))
 
-			_, err = srv.UpdateIdentity(ctx, tc.request)
+			_, err = srv.CreateIdentity(ctx, tc.request)
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
+				_, found := k.GetIdentity(ctx, tc.request.Creator)
+				require.Equal(t, tc.request.Creator == creator, found)
+
+				// the original record and its commitment index entry are untouched
+				require.Equal(t, []types.Identity{original}, k.GetAllIdentity(ctx))
+				indexed, found := k.GetIdentityByIdHash(ctx, original.IdHash)
+				require.True(t, found)
+				require.Equal(t, original, indexed)
+				// and the rejected commitment is not indexed to the new address
+				if indexed, found := k.GetIdentityByIdHash(ctx, tc.request.Commitment); found {
+					require.Equal(t, original, indexed)
+				}
 			} else {
 				require.NoError(t, err)
 				rst, found := k.GetIdentity(ctx,
-					expected.Address,
+					tc.request.Creator,
 				)
 				require.True(t, found)
-				require.Equal(t, expected.Creator, rst.Creator)
+				require.Equal(t, tc.request.Creator, rst.Address)
 			}
 		})
 	}
 }
 
-func TestIdentityMsgServerDelete(t *testing.T) {
-	creator := "A"
+func TestIdentityMsgServerUpdate(t *testing.T) {
+	creator := sample.AccAddress()
+	other := sample.AccAddress()
 
 	tests := []struct {
 		desc    string
-		request *types.MsgDeleteIdentity
+		request *types.MsgUpdateIdentity
+		status  types.IdentityStatus
 		err     error
 	}{
 		{
-			desc: "Completed",
-			request: &types.MsgDeleteIdentity{Creator: creator,
-				Address: strconv.Itoa(0),
-			},
+			desc:    "Completed",
+			request: newMsgUpdateIdentity(t, creator, "001099000009"),
+		},
+		{
+			desc:    "SameCommitment",
+			request: newMsgUpdateIdentity(t, creator, "001099000001"),
+		},
+		{
+			desc:    "KeyNotFound",
+			request: newMsgUpdateIdentity(t, sample.AccAddress(), "001099000009"),
+			err:     sdkerrors.ErrKeyNotFound,
 		},
 		{
-			desc: "Unauthorized",
-			request: &types.MsgDeleteIdentity{Creator: "B",
-				Address: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
+			desc:    "CccdAlreadyRegistered",
+			request: newMsgUpdateIdentity(t, creator, "001099000002"),
+			err:     types.ErrCccdAlreadyRegistered,
 		},
 		{
-			desc: "KeyNotFound",
-			request: &types.MsgDeleteIdentity{Creator: creator,
-				Address: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
+			desc:    "Suspended",
+			request: newMsgUpdateIdentity(t, creator, "001099000009"),
+			status:  types.IdentityStatus_IDENTITY_STATUS_SUSPENDED,
+			err:     types.ErrInvalidStatus,
 		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
 			k, ctx := keepertest.IdentityKeeper(t)
 			srv := keeper.NewMsgServerImpl(k)
-
-			_, err := srv.CreateIdentity(ctx, &types.MsgCreateIdentity{Creator: creator,
-				Address: strconv.Itoa(0),
-			})
+			_, err := srv.CreateIdentity(ctx, newMsgCreateIdentity(t, creator, "001099000001"))
+			require.NoError(t, err)
+			_, err = srv.CreateIdentity(ctx, newMsgCreateIdentity(t, other, "001099000002"))
 			require.NoError(t, err)
-			_, err = srv.DeleteIdentity(ctx, tc.request)
+			bound, _ := k.GetIdentity(ctx, other)
+			bound.Status = types.IdentityStatus_IDENTITY_STATUS_ACTIVE
+			require.NoError(t, k.SetIdentity(ctx, bound))
+			if tc.status != types.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+				identity, _ := k.GetIdentity(ctx, creator)
+				identity.Status = tc.status
+				require.NoError(t, k.SetIdentity(ctx, identity))
+			}
+
+			ctx = ctx.WithBlockHeight(10)
+			_, err = srv.UpdateIdentity(ctx, tc.request)
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				_, found := k.GetIdentity(ctx,
-					tc.request.Address,
+				claims := k.GetIdentitiesByIdHash(ctx,
+					tc.request.Commitment,
 				)
-				require.False(t, found)
+				require.Len(t, claims, 1)
+				rst := claims[0]
+				require.Equal(t, creator, rst.Address)
+				require.Equal(t, int64(10), rst.UpdatedHeight)
+				require.Equal(t, types.IdentityStatus_IDENTITY_STATUS_PENDING, rst.Status)
 			}
 		})
 	}
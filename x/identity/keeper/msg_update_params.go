@@ -19,5 +19,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Authority: req.Authority,
+		Params:    req.Params,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nft"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// InitIdentityNFTs creates the identity NFT class if it does not exist yet
+// and issues an NFT to every active or suspended identity without one.
+func (k Keeper) InitIdentityNFTs(ctx context.Context) error {
+	if !k.nftKeeper.HasClass(ctx, types.NFTClassID) {
+		data, err := codectypes.NewAnyWithValue(&types.IdentityClassData{
+			Soulbound:    true,
+			IssuedHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{
+			Id:          types.NFTClassID,
+			Name:        types.NFTClassName,
+			Symbol:      types.NFTClassSymbol,
+			Description: types.NFTClassDescription,
+			Data:        data,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, status := range []types.IdentityStatus{
+		types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		types.IdentityStatus_IDENTITY_STATUS_SUSPENDED,
+	} {
+		for _, identity := range k.GetIdentitiesByStatus(ctx, status) {
+			if k.nftKeeper.HasNFT(ctx, types.NFTClassID, identity.Address) {
+				continue
+			}
+			if err := k.issueIdentityNFT(ctx, identity); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// BurnPendingIdentityNFTs burns the identity NFTs still held by identities
+// waiting for the attestation of a new commitment.
+func (k Keeper) BurnPendingIdentityNFTs(ctx context.Context) error {
+	for _, identity := range k.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING) {
+		if err := k.burnIdentityNFT(ctx, identity.Address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issueIdentityNFT mints the identity NFT of identity to its holder, or
+// refreshes its data if the holder already has one.
+func (k Keeper) issueIdentityNFT(ctx context.Context, identity types.Identity) error {
+	data, err := codectypes.NewAnyWithValue(&types.IdentityNFTData{
+		Verifier:     identity.Verifier,
+		IssuedHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Level:        identity.Level,
+	})
+	if err != nil {
+		return err
+	}
+
+	token := nft.NFT{
+		ClassId: types.NFTClassID,
+		Id:      identity.Address,
+		Data:    data,
+	}
+	if k.nftKeeper.HasNFT(ctx, types.NFTClassID, identity.Address) {
+		return k.nftKeeper.Update(ctx, token)
+	}
+
+	holder, err := sdk.AccAddressFromBech32(identity.Address)
+	if err != nil {
+		return err
+	}
+	return k.nftKeeper.Mint(ctx, token, holder)
+}
+
+// burnIdentityNFT burns the identity NFT of address, if it has one.
+func (k Keeper) burnIdentityNFT(ctx context.Context, address string) error {
+	if !k.nftKeeper.HasNFT(ctx, types.NFTClassID, address) {
+		return nil
+	}
+	return k.nftKeeper.Burn(ctx, types.NFTClassID, address)
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+// TestIdentityNFTLifecycle follows the identity NFT of a holder from its
+// attestation to its revocation.
+func TestIdentityNFTLifecycle(t *testing.T) {
+	k, nftKeeper, ctx := keepertest.IdentityKeeperWithNFT(t)
+	srv := keeper.NewMsgServerImpl(k)
+	holder := sample.AccAddress()
+	verifier := sample.AccAddress()
+
+	params := k.GetParams(ctx)
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	class, found := nftKeeper.GetClass(ctx, types.NFTClassID)
+	require.True(t, found)
+	var classData types.IdentityClassData
+	require.NoError(t, classData.Unmarshal(class.Data.Value))
+	require.True(t, classData.Soulbound)
+
+	requireNFT := func(height int64, level types.IdentityLevel) {
+		t.Helper()
+		token, found := nftKeeper.GetNFT(ctx, types.NFTClassID, holder)
+		require.True(t, found)
+		require.Equal(t, holder, nftKeeper.GetOwner(ctx, types.NFTClassID, holder).String())
+
+		var data types.IdentityNFTData
+		require.NoError(t, data.Unmarshal(token.Data.Value))
+		require.Equal(t, types.IdentityNFTData{
+			Verifier:     verifier,
+			IssuedHeight: height,
+			Level:        level,
+		}, data)
+	}
+
+	// none while pending
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+	_, err := srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+	require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, holder))
+
+	ctx = ctx.WithBlockHeight(3)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, holder, create.Commitment, "001099000001"))
+	require.NoError(t, err)
+	requireNFT(3, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED)
+
+	// reissued when the level changes
+	ctx = ctx.WithBlockHeight(4)
+	_, err = srv.SetIdentityLevel(ctx, types.NewMsgSetIdentityLevel(verifier, holder, types.IdentityLevel_IDENTITY_LEVEL_ENHANCED))
+	require.NoError(t, err)
+	requireNFT(4, types.IdentityLevel_IDENTITY_LEVEL_ENHANCED)
+
+	// burnt on re-verification and reissued on the new attestation
+	update := newMsgUpdateIdentity(t, holder, "001099000002")
+	_, err = srv.UpdateIdentity(ctx, update)
+	require.NoError(t, err)
+	require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, holder))
+	require.Zero(t, nftKeeper.GetTotalSupply(ctx, types.NFTClassID))
+
+	ctx = ctx.WithBlockHeight(5)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, holder, update.Commitment, "001099000002"))
+	require.NoError(t, err)
+	requireNFT(5, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED)
+	require.Equal(t, uint64(1), nftKeeper.GetTotalSupply(ctx, types.NFTClassID))
+
+	// kept while suspended, burnt on revocation
+	require.NoError(t, suspend(srv, ctx, k.GetAuthority(), holder))
+	requireNFT(5, types.IdentityLevel_IDENTITY_LEVEL_VERIFIED)
+	require.NoError(t, revoke(srv, ctx, k.GetAuthority(), holder))
+	require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, holder))
+	require.Zero(t, nftKeeper.GetTotalSupply(ctx, types.NFTClassID))
+}
+
+func TestBurnPendingIdentityNFTs(t *testing.T) {
+	k, nftKeeper, ctx := keepertest.IdentityKeeperWithNFT(t)
+
+	// NFTs issued before updates burnt them
+	active := types.Identity{Address: sample.AccAddress(), IdHash: "h1", Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}
+	pending := types.Identity{Address: sample.AccAddress(), IdHash: "h2", Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}
+	for _, identity := range []types.Identity{active, pending} {
+		require.NoError(t, k.SetIdentity(ctx, identity))
+	}
+	require.NoError(t, k.InitIdentityNFTs(ctx))
+	pending.Status = types.IdentityStatus_IDENTITY_STATUS_PENDING
+	require.NoError(t, k.SetIdentity(ctx, pending))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate7to8(ctx))
+	require.True(t, nftKeeper.HasNFT(ctx, types.NFTClassID, active.Address))
+	require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, pending.Address))
+}
+
+func TestInitIdentityNFTs(t *testing.T) {
+	k, nftKeeper, ctx := keepertest.IdentityKeeperWithNFT(t)
+
+	statuses := []types.IdentityStatus{
+		types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		types.IdentityStatus_IDENTITY_STATUS_SUSPENDED,
+		types.IdentityStatus_IDENTITY_STATUS_PENDING,
+		types.IdentityStatus_IDENTITY_STATUS_REVOKED,
+	}
+	identities := make([]types.Identity, len(statuses))
+	for i, status := range statuses {
+		identities[i] = types.Identity{Address: sample.AccAddress(), IdHash: sample.AccAddress(), Status: status}
+		require.NoError(t, k.SetIdentity(ctx, identities[i]))
+	}
+
+	// a second run leaves the issued NFTs alone
+	for i := 0; i < 2; i++ {
+		require.NoError(t, k.InitIdentityNFTs(ctx))
+		require.True(t, nftKeeper.HasNFT(ctx, types.NFTClassID, identities[0].Address))
+		require.True(t, nftKeeper.HasNFT(ctx, types.NFTClassID, identities[1].Address))
+		require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, identities[2].Address))
+		require.False(t, nftKeeper.HasNFT(ctx, types.NFTClassID, identities[3].Address))
+	}
+
+	token, _ := nftKeeper.GetNFT(ctx, types.NFTClassID, identities[0].Address)
+	var data types.IdentityNFTData
+	require.NoError(t, data.Unmarshal(token.Data.Value))
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, data.Level)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k Keeper) AttributeCircuit(ctx context.Context, req *types.QueryGetAttributeCircuitRequest) (*types.QueryGetAttributeCircuitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetAttributeCircuit(ctx, req.Attribute)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAttributeCircuitResponse{AttributeCircuit: val}, nil
+}
+
+func (k Keeper) AttributeCircuitAll(ctx context.Context, req *types.QueryAllAttributeCircuitRequest) (*types.QueryAllAttributeCircuitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	circuits, pageRes, err := query.CollectionPaginate(ctx, k.attributeCircuits, req.Pagination,
+		func(_ string, circuit types.AttributeCircuit) (types.AttributeCircuit, error) {
+			return circuit, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttributeCircuitResponse{AttributeCircuit: circuits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AttributeFlag(ctx context.Context, req *types.QueryGetAttributeFlagRequest) (*types.QueryGetAttributeFlagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetAttributeFlag(ctx, req.Address, req.Attribute)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetAttributeFlagResponse{
+		AttributeFlag: val,
+		Active:        k.IsAttributeFlagActive(ctx, val),
+	}, nil
+}
+
+func (k Keeper) AttributeFlags(ctx context.Context, req *types.QueryAttributeFlagsRequest) (*types.QueryAttributeFlagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	flags, pageRes, err := query.CollectionPaginate(ctx, k.attributeFlags, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], flag types.AttributeFlag) (types.AttributeFlag, error) {
+			return flag, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttributeFlagsResponse{AttributeFlag: flags, Pagination: pageRes}, nil
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k Keeper) Issuer(ctx context.Context, req *types.QueryGetIssuerRequest) (*types.QueryGetIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetIssuer(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetIssuerResponse{Issuer: val}, nil
+}
+
+func (k Keeper) IssuerAll(ctx context.Context, req *types.QueryAllIssuerRequest) (*types.QueryAllIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	issuers, pageRes, err := query.CollectionPaginate(ctx, k.issuers, req.Pagination,
+		func(_ sdk.AccAddress, issuer types.Issuer) (types.Issuer, error) {
+			return issuer, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllIssuerResponse{Issuer: issuers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CredentialSchema(ctx context.Context, req *types.QueryGetCredentialSchemaRequest) (*types.QueryGetCredentialSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetCredentialSchema(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetCredentialSchemaResponse{CredentialSchema: val}, nil
+}
+
+func (k Keeper) CredentialSchemaAll(ctx context.Context, req *types.QueryAllCredentialSchemaRequest) (*types.QueryAllCredentialSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schemas, pageRes, err := query.CollectionPaginate(ctx, k.credentialSchemas, req.Pagination,
+		func(_ uint64, schema types.CredentialSchema) (types.CredentialSchema, error) {
+			return schema, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCredentialSchemaResponse{CredentialSchema: schemas, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Credential(ctx context.Context, req *types.QueryGetCredentialRequest) (*types.QueryGetCredentialResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetCredential(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetCredentialResponse{
+		Credential: val,
+		Status:     val.StatusAt(sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}, nil
+}
+
+func (k Keeper) CredentialsByHolder(ctx context.Context, req *types.QueryCredentialsByHolderRequest) (*types.QueryCredentialsByHolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	holder, err := sdk.AccAddressFromBech32(req.Holder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid holder address")
+	}
+
+	credentials, pageRes, err := query.CollectionPaginate(ctx, k.holderIndex, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Credential, error) {
+			return k.credentials.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](holder),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCredentialsByHolderResponse{Credential: credentials, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CredentialStatusList(ctx context.Context, req *types.QueryCredentialStatusListRequest) (*types.QueryCredentialStatusListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	issuer, found := k.GetIssuer(ctx, req.Issuer)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	encodedList, err := k.GetCredentialStatusList(ctx, sdk.MustAccAddressFromBech32(issuer.Address)).Encode()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCredentialStatusListResponse{
+		StatusPurpose:  types.StatusPurposeRevocation,
+		EncodedList:    encodedList,
+		StatusListSize: issuer.StatusListSize,
+	}, nil
+}
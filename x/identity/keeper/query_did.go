@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Nexelra/x/identity/types"
+)
+
+// ResolveDid resolves a did:nexelra DID. As in W3C DID Resolution, an
+// unknown or malformed DID is a successful query whose resolution metadata
+// holds the error.
+func (k Keeper) ResolveDid(ctx context.Context, req *types.QueryResolveDidRequest) (*types.QueryResolveDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := types.ParseDid(req.Did)
+	if err != nil {
+		return &types.QueryResolveDidResponse{
+			DidResolutionMetadata: types.DidResolutionMetadata{Error: types.DidResolutionErrorInvalidDid},
+		}, nil
+	}
+
+	record, found := k.GetDidRecord(ctx, addr.String())
+	if !found {
+		return &types.QueryResolveDidResponse{
+			DidResolutionMetadata: types.DidResolutionMetadata{Error: types.DidResolutionErrorNotFound},
+		}, nil
+	}
+
+	return &types.QueryResolveDidResponse{
+		DidResolutionMetadata: types.DidResolutionMetadata{ContentType: types.DidContentType},
+		DidDocument:           &record.Document,
+		DidDocumentMetadata:   record.Metadata,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k Keeper) GatingPolicy(goCtx context.Context, req *types.QueryGatingPolicyRequest) (*types.QueryGatingPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryGatingPolicyResponse{GatingPolicy: k.GetParams(ctx).GatingPolicy}, nil
+}
+
+func (k Keeper) MsgRequirements(goCtx context.Context, req *types.QueryMsgRequirementsRequest) (*types.QueryMsgRequirementsResponse, error) {
+	if req == nil || req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	policy := params.GatingPolicy
+	rule := policy.RuleFor(req.MsgTypeUrl)
+	if rule == types.GatingRule_GATING_RULE_EXEMPT {
+		return &types.QueryMsgRequirementsResponse{Rule: rule}, nil
+	}
+
+	return &types.QueryMsgRequirementsResponse{
+		Rule:        rule,
+		MinLevel:    policy.LevelFor(req.MsgTypeUrl),
+		Attributes:  policy.AttributesFor(req.MsgTypeUrl),
+		SpendLimits: params.SpendPolicy.Limits,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"Nexelra/x/identity/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IdentitiesByVerifier(ctx context.Context, req *types.QueryIdentitiesByVerifierRequest) (*types.QueryIdentitiesByVerifierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	verifier, err := sdk.AccAddressFromBech32(req.Verifier)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid verifier address")
+	}
+
+	identitys, pageRes, err := query.CollectionPaginate(ctx, k.verifierIndex, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (types.Identity, error) {
+			return k.identities.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](verifier),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIdentitiesByVerifierResponse{Identity: identitys, Pagination: pageRes}, nil
+}
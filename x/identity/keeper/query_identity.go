@@ -5,8 +5,7 @@ import (
 
 	"Nexelra/x/identity/types"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,21 +16,11 @@ func (k Keeper) IdentityAll(ctx context.Context, req *types.QueryAllIdentityRequ
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var identitys []types.Identity
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	identityStore := prefix.NewStore(store, types.KeyPrefix(types.IdentityKeyPrefix))
-
-	pageRes, err := query.Paginate(identityStore, req.Pagination, func(key []byte, value []byte) error {
-		var identity types.Identity
-		if err := k.cdc.Unmarshal(value, &identity); err != nil {
-			return err
-		}
-
-		identitys = append(identitys, identity)
-		return nil
-	})
-
+	identitys, pageRes, err := query.CollectionPaginate(ctx, k.identities, req.Pagination,
+		func(_ sdk.AccAddress, identity types.Identity) (types.Identity, error) {
+			return identity, nil
+		},
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
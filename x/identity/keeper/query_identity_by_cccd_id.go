@@ -4,10 +4,7 @@ import (
     "context"
 
     "Nexelra/x/identity/types"
-    "cosmossdk.io/store/prefix"
-    "github.com/cosmos/cosmos-sdk/runtime"
     sdk "github.com/cosmos/cosmos-sdk/types"
-    "github.com/cosmos/cosmos-sdk/types/query"
     "google.golang.org/grpc/codes"
     "google.golang.org/grpc/status"
 )
@@ -18,30 +15,14 @@ func (k Keeper) IdentityByCccdId(goCtx context.Context, req *types.QueryIdentity
     }
 
     ctx := sdk.UnwrapSDKContext(goCtx)
-    var identities []types.Identity
+    identities := []types.Identity{}
 
-    store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-    identityStore := prefix.NewStore(store, types.KeyPrefix(types.IdentityKeyPrefix))
-
-    pageRes, err := query.Paginate(identityStore, req.Pagination, func(key []byte, value []byte) error {
-        var identity types.Identity
-        if err := k.cdc.Unmarshal(value, &identity); err != nil {
-            return err
-        }
-
-        if identity.IdHash == req.IdHash {
-            identities = append(identities, identity)
-        }
-
-        return nil
-    })
-
-    if err != nil {
-        return nil, status.Error(codes.Internal, err.Error())
-    }
+    // A CCCD hash is bound to at most one address, besides the pending
+    // registrations claiming it, so the secondary index answers the query
+    // directly instead of scanning every identity.
+    identities = append(identities, k.GetIdentitiesByIdHash(ctx, req.IdHash)...)
 
     return &types.QueryIdentityByCccdIdResponse{
-        Identity:   identities,
-        Pagination: pageRes,
+        Identity: identities,
     }, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	keepertest "Nexelra/testutil/keeper"
 	"Nexelra/testutil/nullify"
+	"Nexelra/testutil/sample"
 	"Nexelra/x/identity/types"
 )
 
@@ -19,7 +20,7 @@ var _ = strconv.IntSize
 
 func TestIdentityQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.IdentityKeeper(t)
-	msgs := createNIdentity(keeper, ctx, 2)
+	msgs := createNIdentity(t, keeper, ctx, 2)
 	tests := []struct {
 		desc     string
 		request  *types.QueryGetIdentityRequest
@@ -43,7 +44,7 @@ func TestIdentityQuerySingle(t *testing.T) {
 		{
 			desc: "KeyNotFound",
 			request: &types.QueryGetIdentityRequest{
-				Address: strconv.Itoa(100000),
+				Address: sample.AccAddress(),
 			},
 			err: status.Error(codes.NotFound, "not found"),
 		},
@@ -70,7 +71,7 @@ func TestIdentityQuerySingle(t *testing.T) {
 
 func TestIdentityQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.IdentityKeeper(t)
-	msgs := createNIdentity(keeper, ctx, 5)
+	msgs := createNIdentity(t, keeper, ctx, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllIdentityRequest {
 		return &types.QueryAllIdentityRequest{
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"Nexelra/x/identity/types"
+)
+
+func (k Keeper) SpendAllowance(ctx context.Context, req *types.QuerySpendAllowanceRequest) (*types.QuerySpendAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	identity, found := k.GetIdentity(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	policy := k.GetParams(ctx).SpendPolicy
+	spend, _ := k.GetIdentitySpend(ctx, identity.CccdKey())
+	spent, txCount := spend.Usage(policy, sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+
+	level := types.GatedLevel(identity, found)
+	res := &types.QuerySpendAllowanceResponse{
+		CccdKey:     identity.CccdKey(),
+		Level:       level,
+		Window:      policy.Window,
+		SpentAmount: spent,
+		TxCount:     txCount,
+	}
+	if limit, ok := policy.LimitFor(level); ok {
+		res.Limited = true
+		res.Limit = limit
+		res.RemainingAmount, res.RemainingTxCount = limit.Remaining(spent, txCount)
+	}
+
+	return res, nil
+}
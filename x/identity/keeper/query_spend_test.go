@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestSpendAllowanceQuery(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	verifier := sample.AccAddress()
+	holder := sample.AccAddress()
+	limit := types.LevelSpendLimit{
+		Level:      types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		MaxAmount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		MaxTxCount: 5,
+	}
+
+	params := k.GetParams(ctx)
+	params.Verifiers = []string{verifier}
+	params.SpendPolicy.Limits = []types.LevelSpendLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	create := newMsgCreateIdentity(t, holder, "001099000001")
+	_, err := srv.CreateIdentity(ctx, create)
+	require.NoError(t, err)
+	_, err = srv.AttestIdentity(ctx, newMsgAttestIdentity(t, verifier, holder, create.Commitment, "001099000001"))
+	require.NoError(t, err)
+
+	identity, _ := k.GetIdentity(ctx, holder)
+	require.NoError(t, k.RecordSpend(ctx, identity, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.NoError(t, k.RecordSpend(ctx, identity, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	res, err := k.SpendAllowance(ctx, &types.QuerySpendAllowanceRequest{Address: holder})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySpendAllowanceResponse{
+		CccdKey:          identity.CccdTag,
+		Level:            types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		Window:           types.DefaultSpendWindow,
+		SpentAmount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+		TxCount:          2,
+		Limited:          true,
+		Limit:            limit,
+		RemainingAmount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+		RemainingTxCount: 3,
+	}, res)
+
+	// a new commitment keeps the spend, the pending identity is not limited
+	update := newMsgUpdateIdentity(t, holder, "001099000002")
+	_, err = srv.UpdateIdentity(ctx, update)
+	require.NoError(t, err)
+
+	res, err = k.SpendAllowance(ctx, &types.QuerySpendAllowanceRequest{Address: holder})
+	require.NoError(t, err)
+	require.Equal(t, identity.CccdTag, res.CccdKey)
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, res.Level)
+	require.Equal(t, uint64(2), res.TxCount)
+	require.False(t, res.Limited)
+
+	_, err = k.SpendAllowance(ctx, &types.QuerySpendAllowanceRequest{Address: sample.AccAddress()})
+	require.Error(t, err)
+	_, err = k.SpendAllowance(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestMoveIdentitySpend(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	verifier := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.Verifiers = []string{verifier}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// an identity attested before tags is keyed by its commitment
+	cccdId := "001099000001"
+	legacy := types.Identity{
+		Address:    sample.AccAddress(),
+		IdHash:     types.LegacyCommitments(params.Pepper, cccdId)[1],
+		HashScheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256,
+		Status:     types.IdentityStatus_IDENTITY_STATUS_ACTIVE,
+		Verifier:   verifier,
+	}
+	require.NoError(t, k.SetIdentity(ctx, legacy))
+	require.NoError(t, k.RecordSpend(ctx, legacy, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+
+	update := newMsgUpdateIdentity(t, legacy.Address, cccdId)
+	_, err := srv.UpdateIdentity(ctx, update)
+	require.NoError(t, err)
+	_, found := k.GetIdentitySpend(ctx, legacy.IdHash)
+	require.False(t, found)
+	_, found = k.GetIdentitySpend(ctx, update.Commitment)
+	require.True(t, found)
+
+	// and by its tag once a verifier attests it with one
+	attest := newMsgAttestIdentity(t, verifier, legacy.Address, update.Commitment, cccdId)
+	_, err = srv.AttestIdentity(ctx, attest)
+	require.NoError(t, err)
+	_, found = k.GetIdentitySpend(ctx, update.Commitment)
+	require.False(t, found)
+
+	identity, _ := k.GetIdentity(ctx, legacy.Address)
+	spent, txCount := k.SpendUsage(ctx, identity)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), spent)
+	require.Equal(t, uint64(1), txCount)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// SetIdentitySpend stores the spend of a CCCD.
+func (k Keeper) SetIdentitySpend(ctx context.Context, spend types.IdentitySpend) error {
+	return k.identitySpends.Set(ctx, spend.CccdKey, spend)
+}
+
+// GetIdentitySpend returns the spend of the identities with CCCD key cccdKey.
+func (k Keeper) GetIdentitySpend(ctx context.Context, cccdKey string) (val types.IdentitySpend, found bool) {
+	val, err := k.identitySpends.Get(ctx, cccdKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return val, true
+}
+
+// GetAllIdentitySpend returns the spends of all CCCDs.
+func (k Keeper) GetAllIdentitySpend(ctx context.Context) (list []types.IdentitySpend) {
+	err := k.identitySpends.Walk(ctx, nil, func(_ string, spend types.IdentitySpend) (bool, error) {
+		list = append(list, spend)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// MoveIdentitySpend rekeys the spend of an identity whose CCCD key changes
+// from cccdKey to newCccdKey, such as an identity without a tag replacing its
+// commitment or being tagged, so that it keeps its usage of the window.
+func (k Keeper) MoveIdentitySpend(ctx context.Context, cccdKey, newCccdKey string) error {
+	if cccdKey == newCccdKey {
+		return nil
+	}
+
+	spend, found := k.GetIdentitySpend(ctx, cccdKey)
+	if !found {
+		return nil
+	}
+	if err := k.identitySpends.Remove(ctx, cccdKey); err != nil {
+		return err
+	}
+
+	spend.CccdKey = newCccdKey
+	return k.SetIdentitySpend(ctx, spend)
+}
+
+// SpendUsage returns what the addresses of the CCCD of identity sent, and in
+// how many transactions, within the spend window ending at the current block.
+func (k Keeper) SpendUsage(ctx context.Context, identity types.Identity) (sdk.Coins, uint64) {
+	policy := k.GetParams(ctx).SpendPolicy
+	spend, _ := k.GetIdentitySpend(ctx, identity.CccdKey())
+
+	return spend.Usage(policy, sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+}
+
+// RecordSpend adds a transaction of identity sending amount to the spend of
+// its CCCD.
+func (k Keeper) RecordSpend(ctx context.Context, identity types.Identity, amount sdk.Coins) error {
+	policy := k.GetParams(ctx).SpendPolicy
+	spend, found := k.GetIdentitySpend(ctx, identity.CccdKey())
+	if !found {
+		spend = types.IdentitySpend{CccdKey: identity.CccdKey()}
+	}
+
+	spend.Record(policy, sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), amount)
+	return k.SetIdentitySpend(ctx, spend)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// ValidateOperator returns an error unless the account operator holds an
+// active identity of the verification level the params require of
+// validator operators.
+func (k Keeper) ValidateOperator(ctx context.Context, operator sdk.AccAddress) error {
+	identity, found := k.GetIdentity(ctx, operator.String())
+	return types.ValidateOperatorIdentity(operator.String(), identity, found, k.GetParams(ctx).ValidatorLevel)
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks refuses validators whose operator does not qualify under
+// ValidateOperator. Only the creation is checked here: x/staking also calls
+// BeforeValidatorModified when slashing, which must never fail, so edits are
+// checked by the ante handler instead.
+type StakingHooks struct {
+	k Keeper
+}
+
+// StakingHooks returns the staking hooks of the identity module.
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// AfterValidatorCreated checks the identity of the new validator's operator.
+// Validators created at genesis are checked against the identity genesis by
+// ValidateGenTxIdentities instead, as the identities are not imported yet.
+func (h StakingHooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error {
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return nil
+	}
+	return h.k.ValidateOperator(ctx, sdk.AccAddress(valAddr))
+}
+
+func (StakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+
+func (StakingHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterDelegationModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+
+func (StakingHooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"Nexelra/x/identity/types"
+)
+
+// x/gov of SDK v0.50 cannot be given another tally function: its EndBlocker
+// always calls Keeper.Tally, which weighs votes by stake. Proposals selecting
+// the one-person-one-vote tally are therefore finished by this module's
+// EndBlocker, which runs before the one of x/gov and takes them out of the
+// active proposal queue, so that x/gov never sees them.
+
+// Tally counts the votes of a one-person-one-vote proposal. Each voter with
+// an active identity has one vote, split over its weighted options; voters
+// without one are ignored, and identities sharing a CCCD key count once.
+// Quorum is measured against the number of CCCDs with an active identity.
+// The thresholds are the gov params. Like x/gov's tally, the votes are
+// deleted.
+func (k Keeper) Tally(ctx context.Context, gk *govkeeper.Keeper, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	results := map[v1.VoteOption]math.LegacyDec{
+		v1.OptionYes:        math.LegacyZeroDec(),
+		v1.OptionAbstain:    math.LegacyZeroDec(),
+		v1.OptionNo:         math.LegacyZeroDec(),
+		v1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+	totalVotingPower := math.LegacyZeroDec()
+
+	counted := make(map[string]bool)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	err = gk.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		identity, found := k.GetIdentity(ctx, key.K2().String())
+		if found && identity.Status == types.IdentityStatus_IDENTITY_STATUS_ACTIVE && !counted[identity.CccdKey()] {
+			counted[identity.CccdKey()] = true
+
+			for _, option := range vote.Options {
+				weight, err := math.LegacyNewDecFromStr(option.Weight)
+				if err != nil {
+					return true, err
+				}
+				results[option.Option] = results[option.Option].Add(weight)
+			}
+			totalVotingPower = totalVotingPower.Add(math.LegacyOneDec())
+		}
+
+		return false, gk.Votes.Remove(ctx, key)
+	})
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	params, err := gk.Params.Get(ctx)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	// If nobody holds an identity, the proposal fails
+	electorate := k.ActiveCccdCount(ctx)
+	if electorate == 0 {
+		return false, false, tallyResults, nil
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(electorate)))
+	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
+	if percentVoting.LT(quorum) {
+		return false, params.BurnVoteQuorum, tallyResults, nil
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[v1.OptionAbstain]).IsZero() {
+		return false, false, tallyResults, nil
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
+	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
+		return false, params.BurnVoteVeto, tallyResults, nil
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	// For expedited 2/3
+	thresholdStr := params.GetThreshold()
+	if proposal.Expedited {
+		thresholdStr = params.GetExpeditedThreshold()
+	}
+	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
+		return true, false, tallyResults, nil
+	}
+
+	return false, false, tallyResults, nil
+}
+
+// ActiveCccdCount returns the number of distinct CCCD keys among the active
+// identities, the electorate of one-person-one-vote proposals. It is kept up
+// to date by SetIdentity and RemoveIdentity rather than counted.
+func (k Keeper) ActiveCccdCount(ctx context.Context) uint64 {
+	count, err := k.activeCccdCount.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// InitActiveCccdCount counts the active identities of each CCCD over the
+// whole identity store, for identities written without SetIdentity.
+func (k Keeper) InitActiveCccdCount(ctx context.Context) error {
+	if err := k.activeCccds.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.activeCccdCount.Remove(ctx); err != nil {
+		return err
+	}
+
+	return k.identities.Walk(ctx, nil, func(_ sdk.AccAddress, identity types.Identity) (bool, error) {
+		return false, k.countActiveCccd(ctx, identity, true)
+	})
+}
+
+// uncountActiveCccd takes the identity stored at addr, if any, out of the
+// active CCCD count before it is replaced or removed.
+func (k Keeper) uncountActiveCccd(ctx context.Context, addr sdk.AccAddress) error {
+	identity, err := k.identities.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return k.countActiveCccd(ctx, identity, false)
+}
+
+// countActiveCccd adds identity to, or takes it out of, the active
+// identities of its CCCD. A CCCD counts towards ActiveCccdCount while it has
+// at least one.
+func (k Keeper) countActiveCccd(ctx context.Context, identity types.Identity, add bool) error {
+	if identity.Status != types.IdentityStatus_IDENTITY_STATUS_ACTIVE {
+		return nil
+	}
+
+	key := identity.CccdKey()
+	holders, err := k.activeCccds.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	count := k.ActiveCccdCount(ctx)
+
+	switch {
+	case add:
+		if holders == 0 {
+			count++
+		}
+		holders++
+	case holders > 0:
+		holders--
+		if holders == 0 {
+			count--
+		}
+	}
+
+	if holders == 0 {
+		err = k.activeCccds.Remove(ctx, key)
+	} else {
+		err = k.activeCccds.Set(ctx, key, holders)
+	}
+	if err != nil {
+		return err
+	}
+	return k.activeCccdCount.Set(ctx, count)
+}
+
+// EndOnePersonOneVoteProposals finishes the one-person-one-vote proposals
+// whose voting period ended, the way x/gov's EndBlocker finishes the others:
+// deposits are refunded or burnt, the messages of a passed proposal are
+// executed, and a failed expedited proposal goes back to a regular voting
+// period.
+func (k Keeper) EndOnePersonOneVoteProposals(ctx context.Context, gk *govkeeper.Keeper) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var ended []v1.Proposal
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](sdkCtx.BlockTime())
+	err := gk.ActiveProposalsQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+		proposal, err := gk.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			// x/gov fails proposals it cannot decode itself
+			return false, nil
+		}
+		if types.IsOnePersonOneVote(proposal.Metadata) {
+			ended = append(ended, proposal)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range ended {
+		if err := k.endProposal(sdkCtx, gk, proposal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) endProposal(ctx sdk.Context, gk *govkeeper.Keeper, proposal v1.Proposal) error {
+	passes, burnDeposits, tallyResults, err := k.Tally(ctx, gk, proposal)
+	if err != nil {
+		return err
+	}
+
+	// a failed expedited proposal keeps its deposits for the regular tally
+	if !(proposal.Expedited && !passes) {
+		if burnDeposits {
+			err = gk.DeleteAndBurnDeposits(ctx, proposal.Id)
+		} else {
+			err = gk.RefundAndDeleteDeposits(ctx, proposal.Id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = gk.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
+		return err
+	}
+
+	var tagValue, logMsg string
+	switch {
+	case passes:
+		tagValue, logMsg = executeProposal(ctx, gk, &proposal)
+	case proposal.Expedited:
+		params, err := gk.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		proposal.Expedited = false
+		endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+		proposal.VotingEndTime = &endTime
+
+		if err = gk.ActiveProposalsQueue.Set(ctx, collections.Join(endTime, proposal.Id), proposal.Id); err != nil {
+			return err
+		}
+
+		tagValue = govtypes.AttributeValueExpeditedProposalRejected
+		logMsg = "expedited proposal converted to regular"
+	default:
+		proposal.Status = v1.StatusRejected
+		proposal.FailedReason = "proposal did not get enough votes to pass"
+		tagValue = govtypes.AttributeValueProposalRejected
+		logMsg = "rejected"
+	}
+
+	proposal.FinalTallyResult = &tallyResults
+	if err = gk.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	// the hook must not halt the chain, its error is only logged
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := gk.Hooks().AfterProposalVotingPeriodEnded(cacheCtx, proposal.Id); err == nil {
+		writeCache()
+	} else {
+		k.Logger().Error("failed to execute AfterProposalVotingPeriodEnded hook", "error", err)
+	}
+
+	k.Logger().Info(
+		"one-person-one-vote proposal tallied",
+		"proposal", proposal.Id,
+		"status", proposal.Status.String(),
+		"expedited", proposal.Expedited,
+		"results", logMsg,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeActiveProposal,
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalResult, tagValue),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalLog, logMsg),
+			sdk.NewAttribute(types.MetadataKeyTallyMode, types.TallyModeOnePersonOneVote),
+		),
+	)
+
+	return nil
+}
+
+// executeProposal runs the messages of a passed proposal in a cached context
+// and sets its final status. State is only written if every message succeeds.
+func executeProposal(ctx sdk.Context, gk *govkeeper.Keeper, proposal *v1.Proposal) (tagValue, logMsg string) {
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return govtypes.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	var events sdk.Events
+	for idx, msg := range messages {
+		res, err := safeExecuteHandler(cacheCtx, msg, gk.Router().Handler(msg))
+		if err != nil {
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = err.Error()
+			return govtypes.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+		}
+		events = append(events, res.GetEvents()...)
+	}
+
+	proposal.Status = v1.StatusPassed
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	return govtypes.AttributeValueProposalPassed, "passed"
+}
+
+// safeExecuteHandler executes handler(msg) and recovers from a panic.
+func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", msg, r)
+		}
+	}()
+	res, err = handler(ctx, msg)
+	return
+}
@@ -0,0 +1,76 @@
+package v2
+
+// The raw key layout used by x/identity up to consensus version 4. From
+// version 5 the module stores its state in collections, and these keys are
+// only read by the migrations.
+
+var ParamsKey = []byte("p_identity")
+
+const (
+	// IdentityKeyPrefix is the prefix to retrieve all Identity
+	IdentityKeyPrefix = "Identity/value/"
+
+	// IdentityHashKeyPrefix is the prefix of the idHash -> address secondary index
+	IdentityHashKeyPrefix = "Identity/hash/"
+
+	// IdentityVerifierKeyPrefix is the prefix of the verifier -> attested
+	// addresses secondary index
+	IdentityVerifierKeyPrefix = "Identity/verifier/"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// IdentityKey returns the store key to retrieve a Identity from the index fields
+func IdentityKey(
+	address string,
+) []byte {
+	var key []byte
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// IdentityHashKey returns the store key to retrieve the address bound to a CCCD hash
+func IdentityHashKey(
+	idHash string,
+) []byte {
+	var key []byte
+
+	idHashBytes := []byte(idHash)
+	key = append(key, idHashBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// IdentityVerifierPrefix returns the store prefix of all identities attested by a verifier
+func IdentityVerifierPrefix(
+	verifier string,
+) []byte {
+	var key []byte
+
+	verifierBytes := []byte(verifier)
+	key = append(key, verifierBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// IdentityVerifierKey returns the store key marking address as attested by verifier
+func IdentityVerifierKey(
+	verifier string,
+	address string,
+) []byte {
+	key := IdentityVerifierPrefix(verifier)
+
+	addressBytes := []byte(address)
+	key = append(key, addressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration builds the idHash -> address secondary index for every stored
+// identity.
+//
+// v1 never enforced CCCD uniqueness, so several addresses may already share a
+// hash. The index keeps the earliest registration (lowest createdAt, then
+// lowest address) and the other holders are logged for manual review.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	identityStore := prefix.NewStore(storeAdapter, KeyPrefix(IdentityKeyPrefix))
+	hashStore := prefix.NewStore(storeAdapter, KeyPrefix(IdentityHashKeyPrefix))
+
+	owners := make(map[string]types.Identity)
+	var order []string
+
+	iterator := storetypes.KVStorePrefixIterator(identityStore, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var identity types.Identity
+		if err := cdc.Unmarshal(iterator.Value(), &identity); err != nil {
+			return err
+		}
+		if identity.IdHash == "" {
+			continue
+		}
+
+		owner, ok := owners[identity.IdHash]
+		if !ok {
+			owners[identity.IdHash] = identity
+			order = append(order, identity.IdHash)
+			continue
+		}
+
+		kept, dropped := owner, identity
+		if identity.CreatedAt < owner.CreatedAt {
+			kept, dropped = identity, owner
+			owners[identity.IdHash] = identity
+		}
+		sdk.UnwrapSDKContext(ctx).Logger().Error(
+			"duplicate CCCD hash found while building identity index",
+			"module", types.ModuleName,
+			"id_hash", identity.IdHash,
+			"kept", kept.Address,
+			"dropped", dropped.Address,
+		)
+	}
+
+	for _, idHash := range order {
+		hashStore.Set(IdentityHashKey(idHash), []byte(owners[idHash].Address))
+	}
+
+	return nil
+}
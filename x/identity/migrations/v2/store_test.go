@@ -0,0 +1,41 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v1 layout: identities only, duplicates allowed
+	identityStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityKeyPrefix))
+	for _, identity := range []types.Identity{
+		{Address: "a", IdHash: "h1", CreatedAt: 20},
+		{Address: "b", IdHash: "h1", CreatedAt: 10},
+		{Address: "c", IdHash: "h2", CreatedAt: 30},
+		{Address: "d"},
+	} {
+		identityStore.Set(v2.IdentityKey(identity.Address), cdc.MustMarshal(&identity))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	hashStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityHashKeyPrefix))
+	require.Equal(t, "b", string(hashStore.Get(v2.IdentityHashKey("h1"))))
+	require.Equal(t, "c", string(hashStore.Get(v2.IdentityHashKey("h2"))))
+	require.Nil(t, hashStore.Get(v2.IdentityHashKey("")))
+}
@@ -0,0 +1,60 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	"Nexelra/x/identity/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration backfills Identity.CreatedHeight for records written before the
+// field existed.
+//
+// The real registration height of those records is not recoverable from
+// state, so they are stamped with the upgrade height, which is the earliest
+// height at which the chain can vouch for them.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	identityStore := prefix.NewStore(storeAdapter, v2.KeyPrefix(v2.IdentityKeyPrefix))
+
+	iterator := storetypes.KVStorePrefixIterator(identityStore, []byte{})
+	defer iterator.Close()
+
+	updated := make(map[string]types.Identity)
+	var keys []string
+	for ; iterator.Valid(); iterator.Next() {
+		var identity types.Identity
+		if err := cdc.Unmarshal(iterator.Value(), &identity); err != nil {
+			return err
+		}
+		if identity.CreatedHeight != 0 {
+			continue
+		}
+
+		identity.CreatedHeight = height
+		updated[string(iterator.Key())] = identity
+		keys = append(keys, string(iterator.Key()))
+	}
+
+	// Writes are deferred until the iterator is done with the store
+	for _, key := range keys {
+		identity := updated[key]
+		bz, err := cdc.Marshal(&identity)
+		if err != nil {
+			return err
+		}
+		identityStore.Set([]byte(key), bz)
+	}
+
+	return nil
+}
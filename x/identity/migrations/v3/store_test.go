@@ -0,0 +1,40 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	v3 "Nexelra/x/identity/migrations/v3"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockHeight(500)
+	store := ctx.KVStore(storeKey)
+
+	identityStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityKeyPrefix))
+	for _, identity := range []types.Identity{
+		{Address: "a", IdHash: "h1", CreatedAt: 20},
+		{Address: "b", IdHash: "h2", CreatedAt: 30, CreatedHeight: 7},
+	} {
+		identityStore.Set(v2.IdentityKey(identity.Address), cdc.MustMarshal(&identity))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	for address, height := range map[string]int64{"a": 500, "b": 7} {
+		var identity types.Identity
+		cdc.MustUnmarshal(identityStore.Get(v2.IdentityKey(address)), &identity)
+		require.Equal(t, height, identity.CreatedHeight)
+	}
+}
@@ -0,0 +1,43 @@
+package v4
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	"Nexelra/x/identity/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. The
+// migration installs the default identity gating policy.
+//
+// Params written before the policy existed decode to an empty policy, which
+// would gate every message including MsgCreateIdentity and lock new accounts
+// out of the chain. The default policy reproduces the exemptions that were
+// previously hard-coded in the ante handler.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	var params types.Params
+	if bz := store.Get(v2.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.GatingPolicy = types.DefaultGatingPolicy()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(v2.ParamsKey, bz)
+
+	return nil
+}
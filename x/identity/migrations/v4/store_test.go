@@ -0,0 +1,42 @@
+package v4_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	v2 "Nexelra/x/identity/migrations/v2"
+	v4 "Nexelra/x/identity/migrations/v4"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params as written by v3, without a gating policy
+	verifier := sample.AccAddress()
+	old := types.Params{
+		Pepper:     "pepper",
+		HashScheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256,
+		Verifiers:  []string{verifier},
+	}
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&old))
+
+	require.NoError(t, v4.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(v2.ParamsKey), &params)
+	require.Equal(t, "pepper", params.Pepper)
+	require.Equal(t, []string{verifier}, params.Verifiers)
+	require.Equal(t, types.DefaultGatingPolicy(), params.GatingPolicy)
+	require.Equal(t, types.GatingRule_GATING_RULE_EXEMPT, params.GatingPolicy.RuleFor("/nexelra.identity.MsgCreateIdentity"))
+}
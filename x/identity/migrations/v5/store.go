@@ -0,0 +1,95 @@
+package v5
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "Nexelra/x/identity/migrations/v2"
+	"Nexelra/x/identity/types"
+)
+
+// IdentityStore is the identity collection the migration writes into.
+type IdentityStore interface {
+	Set(ctx context.Context, addr sdk.AccAddress, identity types.Identity) error
+}
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration moves the module state from the raw key layout onto
+// collections: the params into params, and every identity into identities,
+// keyed by its address bytes instead of the bech32 string.
+//
+// The collection maintains the idHash, status and verifier indexes itself,
+// so the hand-written idHash and verifier indexes are dropped. An identity
+// whose address does not decode fails the migration, since it could not be
+// looked up or gated afterwards.
+func MigrateStore(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	cdc codec.BinaryCodec,
+	params collections.Item[types.Params],
+	identities IdentityStore,
+) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if bz := storeAdapter.Get(v2.ParamsKey); bz != nil {
+		var p types.Params
+		if err := cdc.Unmarshal(bz, &p); err != nil {
+			return err
+		}
+		if err := params.Set(ctx, p); err != nil {
+			return err
+		}
+		storeAdapter.Delete(v2.ParamsKey)
+	}
+
+	identityStore := prefix.NewStore(storeAdapter, v2.KeyPrefix(v2.IdentityKeyPrefix))
+	var legacy []types.Identity
+	keys := prefixKeys(identityStore)
+	for _, key := range keys {
+		var identity types.Identity
+		if err := cdc.Unmarshal(identityStore.Get(key), &identity); err != nil {
+			return err
+		}
+		legacy = append(legacy, identity)
+	}
+
+	for _, identity := range legacy {
+		addr, err := sdk.AccAddressFromBech32(identity.Address)
+		if err != nil {
+			return fmt.Errorf("identity %q: %w", identity.Address, err)
+		}
+		if err := identities.Set(ctx, addr, identity); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range []string{v2.IdentityKeyPrefix, v2.IdentityHashKeyPrefix, v2.IdentityVerifierKeyPrefix} {
+		s := prefix.NewStore(storeAdapter, v2.KeyPrefix(p))
+		for _, key := range prefixKeys(s) {
+			s.Delete(key)
+		}
+	}
+
+	return nil
+}
+
+// prefixKeys returns every key of s. Callers modify s only once the iterator
+// is closed.
+func prefixKeys(s storetypes.KVStore) [][]byte {
+	iterator := storetypes.KVStorePrefixIterator(s, []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
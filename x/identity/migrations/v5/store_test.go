@@ -0,0 +1,88 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	v2 "Nexelra/x/identity/migrations/v2"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil)
+
+	// state as written by v4
+	verifier := sample.AccAddress()
+	params := types.DefaultParams()
+	params.Verifiers = []string{verifier}
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&params))
+
+	identities := []types.Identity{
+		{Address: sample.AccAddress(), IdHash: "h1", Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE, Verifier: verifier},
+		{Address: sample.AccAddress(), IdHash: "h2", Status: types.IdentityStatus_IDENTITY_STATUS_PENDING},
+	}
+	identityStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityKeyPrefix))
+	hashStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityHashKeyPrefix))
+	verifierStore := prefix.NewStore(store, v2.KeyPrefix(v2.IdentityVerifierKeyPrefix))
+	for _, identity := range identities {
+		identityStore.Set(v2.IdentityKey(identity.Address), cdc.MustMarshal(&identity))
+		hashStore.Set(v2.IdentityHashKey(identity.IdHash), []byte(identity.Address))
+		if identity.Verifier != "" {
+			verifierStore.Set(v2.IdentityVerifierKey(identity.Verifier, identity.Address), []byte(identity.Address))
+		}
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	require.Equal(t, params, k.GetParams(ctx))
+	for _, identity := range identities {
+		got, found := k.GetIdentity(ctx, identity.Address)
+		require.True(t, found)
+		require.Equal(t, identity, got)
+
+		require.Equal(t, []types.Identity{identity}, k.GetIdentitiesByIdHash(ctx, identity.IdHash))
+	}
+	require.Equal(t, identities[1:], k.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING))
+
+	res, err := k.IdentitiesByVerifier(ctx, &types.QueryIdentitiesByVerifierRequest{Verifier: verifier})
+	require.NoError(t, err)
+	require.Equal(t, identities[:1], res.Identity)
+
+	// nothing is left in the raw layout
+	require.Nil(t, store.Get(v2.ParamsKey))
+	for _, p := range []string{v2.IdentityKeyPrefix, v2.IdentityHashKeyPrefix, v2.IdentityVerifierKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(store, v2.KeyPrefix(p))
+		require.False(t, iterator.Valid(), p)
+		require.NoError(t, iterator.Close())
+	}
+}
+
+func TestMigrateStoreInvalidAddress(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil)
+
+	identity := types.Identity{Address: "not-an-address", IdHash: "h1"}
+	identityStore := prefix.NewStore(ctx.KVStore(storeKey), v2.KeyPrefix(v2.IdentityKeyPrefix))
+	identityStore.Set(v2.IdentityKey(identity.Address), cdc.MustMarshal(&identity))
+
+	require.ErrorContains(t, keeper.NewMigrator(k).Migrate4to5(ctx), "not-an-address")
+}
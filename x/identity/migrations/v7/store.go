@@ -0,0 +1,41 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// IdentityStore is the identity collection the migration rewrites.
+type IdentityStore interface {
+	Walk(ctx context.Context, ranger collections.Ranger[sdk.AccAddress], walkFunc func(sdk.AccAddress, types.Identity) (bool, error)) error
+	Set(ctx context.Context, addr sdk.AccAddress, identity types.Identity) error
+}
+
+// MigrateStore performs in-place store migrations from v6 to v7, which adds
+// identity levels. Identities a verifier attested become VERIFIED, the others
+// keep the zero value, SELF_DECLARED.
+func MigrateStore(ctx context.Context, identities IdentityStore) error {
+	var attested []types.Identity
+	err := identities.Walk(ctx, nil, func(_ sdk.AccAddress, identity types.Identity) (bool, error) {
+		if identity.Verifier != "" {
+			attested = append(attested, identity)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range attested {
+		identity.Level = types.IdentityLevel_IDENTITY_LEVEL_VERIFIED
+		if err := identities.Set(ctx, sdk.MustAccAddressFromBech32(identity.Address), identity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.IdentityKeeper(t)
+
+	// identities as written by v6, without a level
+	verifier := sample.AccAddress()
+	attested := types.Identity{Address: sample.AccAddress(), IdHash: "h1", Verifier: verifier}
+	pending := types.Identity{Address: sample.AccAddress(), IdHash: "h2", Status: types.IdentityStatus_IDENTITY_STATUS_PENDING}
+	legacy := types.Identity{Address: sample.AccAddress(), IdHash: "h3"}
+	for _, identity := range []types.Identity{attested, pending, legacy} {
+		require.NoError(t, k.SetIdentity(ctx, identity))
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate6to7(ctx))
+
+	got, found := k.GetIdentity(ctx, attested.Address)
+	require.True(t, found)
+	attested.Level = types.IdentityLevel_IDENTITY_LEVEL_VERIFIED
+	require.Equal(t, attested, got)
+
+	for _, identity := range []types.Identity{pending, legacy} {
+		got, found := k.GetIdentity(ctx, identity.Address)
+		require.True(t, found)
+		require.Equal(t, identity, got)
+	}
+
+	// the verifier index still serves the migrated identity
+	res, err := k.IdentitiesByVerifier(ctx, &types.QueryIdentitiesByVerifierRequest{Verifier: verifier})
+	require.NoError(t, err)
+	require.Equal(t, []types.Identity{attested}, res.Identity)
+}
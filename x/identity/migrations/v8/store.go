@@ -0,0 +1,38 @@
+package v8
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"Nexelra/x/identity/types"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8, which adds
+// salted commitments and CCCD tags.
+//
+// New registrations default to HASH_SCHEME_SALTED_HMAC_SHA256, and a chain
+// that ran without a pepper gets DefaultPepper so the keyed schemes validate.
+// Existing commitments keep the scheme they were made with and have no tag
+// until a verifier attests them again. The tag index only serves non-empty
+// tags, so the identities need no rewrite.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	p.HashScheme = types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256
+	if p.Pepper == "" {
+		p.Pepper = types.DefaultPepper
+	}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,46 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "Nexelra/testutil/keeper"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tests := []struct {
+		desc   string
+		scheme types.HashScheme
+		pepper string
+		want   string
+	}{
+		{desc: "plain, no pepper", scheme: types.HashScheme_HASH_SCHEME_SHA256, want: types.DefaultPepper},
+		{desc: "keyed", scheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256, pepper: "network-pepper", want: "network-pepper"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := keepertest.IdentityKeeper(t)
+
+			// params and identities as written by v7, without salts
+			params := types.DefaultParams()
+			params.HashScheme, params.Pepper = tc.scheme, tc.pepper
+			require.NoError(t, k.SetParams(ctx, params))
+			identity := types.Identity{Address: sample.AccAddress(), IdHash: "h1", HashScheme: tc.scheme}
+			require.NoError(t, k.SetIdentity(ctx, identity))
+
+			require.NoError(t, keeper.NewMigrator(k).Migrate7to8(ctx))
+
+			params.HashScheme, params.Pepper = types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256, tc.want
+			require.Equal(t, params, k.GetParams(ctx))
+
+			// the commitment keeps its scheme
+			got, found := k.GetIdentity(ctx, identity.Address)
+			require.True(t, found)
+			require.Equal(t, identity, got)
+		})
+	}
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
     return &autocliv1.ModuleOptions{
         Query: &autocliv1.ServiceCommandDescriptor{
-            Service: modulev1.Query_ServiceDesc.ServiceName,
+            Service:              modulev1.Query_ServiceDesc.ServiceName,
+            EnhanceCustomCommand: true,
             RpcCommandOptions: []*autocliv1.RpcCommandOptions{
                 {
                     RpcMethod: "Params",
@@ -34,6 +35,99 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
                     Short:          "Query identity by CCCD ID hash",
                     PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "idHash"}},
                 },
+                {
+                    RpcMethod:      "IdentitiesByVerifier",
+                    Use:            "identities-by-verifier [verifier]",
+                    Short:          "List the identities attested by a verifier",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "verifier"}},
+                },
+                {
+                    RpcMethod: "GatingPolicy",
+                    Use:       "gating-policy",
+                    Short:     "Shows the identity gating policy enforced on transactions",
+                },
+                {
+                    RpcMethod:      "MsgRequirements",
+                    Use:            "msg-requirements [msg-type-url]",
+                    Short:          "Explain the identity level, attributes and spend limits a message type requires",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msgTypeUrl"}},
+                },
+                {
+                    RpcMethod:      "ResolveDid",
+                    Use:            "resolve-did [did]",
+                    Short:          "Resolve a did:nexelra DID to its DID document",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+                },
+                {
+                    RpcMethod:      "Issuer",
+                    Use:            "show-issuer [address]",
+                    Short:          "Shows a credential issuer by address",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+                },
+                {
+                    RpcMethod: "IssuerAll",
+                    Use:       "list-issuer",
+                    Short:     "List all credential issuers",
+                },
+                {
+                    RpcMethod:      "CredentialSchema",
+                    Use:            "show-credential-schema [id]",
+                    Short:          "Shows a credential schema by id",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+                },
+                {
+                    RpcMethod: "CredentialSchemaAll",
+                    Use:       "list-credential-schema",
+                    Short:     "List all credential schemas",
+                },
+                {
+                    RpcMethod:      "Credential",
+                    Use:            "show-credential [id]",
+                    Short:          "Shows a credential and its current status by id",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+                },
+                {
+                    RpcMethod:      "CredentialsByHolder",
+                    Use:            "credentials-by-holder [holder]",
+                    Short:          "List the credentials issued to a holder",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "holder"}},
+                },
+                {
+                    RpcMethod:      "CredentialStatusList",
+                    Use:            "credential-status-list [issuer]",
+                    Short:          "Shows the revocation status list of an issuer",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "issuer"}},
+                },
+                {
+                    RpcMethod:      "AttributeCircuit",
+                    Use:            "show-attribute-circuit [attribute]",
+                    Short:          "Shows the circuit proving an attribute",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attribute"}},
+                },
+                {
+                    RpcMethod: "AttributeCircuitAll",
+                    Use:       "list-attribute-circuit",
+                    Short:     "List all attribute circuits",
+                },
+                {
+                    RpcMethod:      "AttributeFlag",
+                    Use:            "show-attribute-flag [address] [attribute]",
+                    Short:          "Shows an attribute flag of an identity and whether it is active",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "attribute"}},
+                },
+                {
+                    RpcMethod:      "AttributeFlags",
+                    Use:            "attribute-flags [address]",
+                    Short:          "List the attribute flags of an identity",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+                },
+                {
+                    RpcMethod:      "SpendAllowance",
+                    Use:            "spend-allowance [address]",
+                    Short:          "Show what the identity of an address may still send within the spend window",
+                    Long:           "Show what the identity of an address sent within the rolling window of the spend policy and what is left of the limit of its level. The spend is shared by all the addresses of the CCCD of the identity.",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+                },
             },
         },
         Tx: &autocliv1.ServiceCommandDescriptor{
@@ -45,10 +139,106 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
                     Skip:      true, // skipped because authority gated
                 },
                 {
-                    RpcMethod:      "CreateIdentity",
-                    Use:            "create-identity [cccd-id]",
-                    Short:          "Create identity with CCCD ID",
-                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "cccdId"}},
+                    RpcMethod: "CreateIdentity",
+                    Skip:      true, // custom command, computes the CCCD commitment locally
+                },
+                {
+                    RpcMethod: "UpdateIdentity",
+                    Skip:      true, // custom command, computes the CCCD commitment locally
+                },
+                {
+                    RpcMethod: "RevokeIdentity",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "SuspendIdentity",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "ReinstateIdentity",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod:      "AttestIdentity",
+                    Use:            "attest-identity [address] [id-hash]",
+                    Short:          "Attest a pending identity as a registered verifier",
+                    Long:           "Attest a pending identity as a registered verifier. The identity becomes verified, or enhanced with --level enhanced. Salted commitments also take the CCCD tag, --cccd-tag, and the unsalted commitments of the CCCD ID, --legacy-id-hashes, which must not belong to another address.",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "idHash"}},
+                },
+                {
+                    RpcMethod:      "SetIdentityLevel",
+                    Use:            "set-identity-level [address] [verified|enhanced]",
+                    Short:          "Change the level of an attested identity as a registered verifier",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "level"}},
+                },
+                {
+                    RpcMethod: "CreateDid",
+                    Use:       "create-did",
+                    Short:     "Create the DID document of your identity",
+                    Long: `Create the did:nexelra DID document of the identity of the signer. Verification
+methods and services are JSON objects whose ids may be bare fragments, e.g.
+--verification-method '{"id":"#key-1","type":"Multikey","publicKeyMultibase":"z6Mk..."}'
+--authentication '#key-1'
+--service '{"id":"#kyc","type":"LinkedDomains","serviceEndpoint":"https://example.com"}'`,
+                },
+                {
+                    RpcMethod: "UpdateDid",
+                    Use:       "update-did",
+                    Short:     "Replace the content of the DID document of your identity",
+                    Long:      "Replace the verification methods, relationships and services of the DID document of the signer. Flags are those of create-did.",
+                },
+                {
+                    RpcMethod:      "RotateDidKey",
+                    Use:            "rotate-did-key [method-id] [public-key-multibase]",
+                    Short:          "Replace the public key of a verification method of your DID document",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "methodId"}, {ProtoField: "publicKeyMultibase"}},
+                },
+                {
+                    RpcMethod: "DeactivateDid",
+                    Use:       "deactivate-did",
+                    Short:     "Permanently deactivate the DID document of your identity",
+                },
+                {
+                    RpcMethod: "RegisterIssuer",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "RemoveIssuer",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod:      "RegisterCredentialSchema",
+                    Use:            "register-credential-schema [name] [uri] [hash]",
+                    Short:          "Register a credential schema as an issuer",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "uri"}, {ProtoField: "hash"}},
+                },
+                {
+                    RpcMethod:      "IssueCredential",
+                    Use:            "issue-credential [holder] [schema-id] [hash]",
+                    Short:          "Anchor a credential issued to the holder of an identity",
+                    Long:           "Anchor the SHA-256 hash of a credential issued to the holder of an active identity. Set --expires-at to the unix time the credential expires at.",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "holder"}, {ProtoField: "schemaId"}, {ProtoField: "hash"}},
+                },
+                {
+                    RpcMethod:      "RevokeCredential",
+                    Use:            "revoke-credential [id]",
+                    Short:          "Revoke a credential you issued",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+                },
+                {
+                    RpcMethod: "RegisterAttributeCircuit",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod: "RemoveAttributeCircuit",
+                    Skip:      true, // skipped because authority gated
+                },
+                {
+                    RpcMethod:      "ProveAttribute",
+                    Use:            "prove-attribute [attribute] [proof]",
+                    Short:          "Prove an attribute of your identity with a Groth16 proof",
+                    Long:           "Prove an attribute of the identity of the signer with a Groth16 proof of the attribute's circuit, given as a file, hex or base64. The proof is the 256 bytes of A, B and C in the encoding of gnark's MarshalSolidity.",
+                    PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attribute"}, {ProtoField: "proof"}},
                 },
             },
         },
@@ -11,12 +11,58 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// Set all the identity
 	for _, elem := range genState.IdentityList {
-		k.SetIdentity(ctx, elem)
+		if err := k.SetIdentity(ctx, elem); err != nil {
+			panic(err)
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventIdentityCreated(elem)); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.DidList {
+		if err := k.SetDidRecord(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.IssuerList {
+		if err := k.SetIssuer(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.CredentialSchemaList {
+		if err := k.SetCredentialSchema(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	// the status lists are rebuilt from the revoked credentials
+	for _, elem := range genState.CredentialList {
+		if err := k.SetCredential(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.AttributeCircuitList {
+		if err := k.SetAttributeCircuit(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.AttributeFlagList {
+		if err := k.SetAttributeFlag(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.IdentitySpendList {
+		if err := k.SetIdentitySpend(ctx, elem); err != nil {
+			panic(err)
+		}
 	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	// NFTs exported with x/nft's genesis are kept as they are
+	if err := k.InitIdentityNFTs(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -25,6 +71,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.IdentityList = k.GetAllIdentity(ctx)
+	genesis.DidList = k.GetAllDidRecord(ctx)
+	genesis.IssuerList = k.GetAllIssuer(ctx)
+	genesis.CredentialSchemaList = k.GetAllCredentialSchema(ctx)
+	genesis.CredentialList = k.GetAllCredential(ctx)
+	genesis.AttributeCircuitList = k.GetAllAttributeCircuit(ctx)
+	genesis.AttributeFlagList = k.GetAllAttributeFlag(ctx)
+	genesis.IdentitySpendList = k.GetAllIdentitySpend(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -1,26 +1,41 @@
 package identity_test
 
 import (
+	"crypto/rand"
+	"strings"
 	"testing"
 
 	keepertest "Nexelra/testutil/keeper"
 	"Nexelra/testutil/nullify"
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/groth16"
+	"Nexelra/x/identity/keeper"
 	identity "Nexelra/x/identity/module"
 	"Nexelra/x/identity/types"
 
+	"cosmossdk.io/x/nft"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	holder := sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
 		IdentityList: []types.Identity{
 			{
-				Address: "0",
+				Address: holder,
 			},
 			{
-				Address: "1",
+				Address: sample.AccAddress(),
+			},
+		},
+		DidList: []types.DidRecord{
+			{
+				Document: types.DidDocument{Id: types.DidFromAddress(holder), Controller: []string{types.DidFromAddress(holder)}},
+				Metadata: types.DidDocumentMetadata{Created: "2024-01-02T03:04:05Z", VersionId: "1"},
 			},
 		},
 		// this line is used by starport scaffolding # genesis/test/state
@@ -35,5 +50,96 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.IdentityList, got.IdentityList)
+	require.ElementsMatch(t, genesisState.DidList, got.DidList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisCredentials(t *testing.T) {
+	issuer, holder := sample.AccAddress(), sample.AccAddress()
+	hash := strings.Repeat("ab", 32)
+	genesisState := types.GenesisState{
+		Params:               types.DefaultParams(),
+		IdentityList:         []types.Identity{{Address: holder}},
+		IssuerList:           []types.Issuer{{Address: issuer, Name: "issuer", Active: true, StatusListSize: 12}},
+		CredentialSchemaList: []types.CredentialSchema{{Id: 3, Issuer: issuer, Name: "over-18", Uri: "https://example.com/s.json", Hash: hash}},
+		CredentialList: []types.Credential{
+			{Id: 4, Issuer: issuer, Holder: holder, SchemaId: 3, Hash: hash, StatusListIndex: 10},
+			{Id: 7, Issuer: issuer, Holder: holder, SchemaId: 3, Hash: hash, StatusListIndex: 11, Revoked: true},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.IdentityKeeper(t)
+	identity.InitGenesis(ctx, k, genesisState)
+	got := identity.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState.IssuerList, got.IssuerList)
+	require.Equal(t, genesisState.CredentialSchemaList, got.CredentialSchemaList)
+	require.Equal(t, genesisState.CredentialList, got.CredentialList)
+
+	// the status list is rebuilt from the revoked credentials
+	statusList := k.GetCredentialStatusList(ctx, sdk.MustAccAddressFromBech32(issuer))
+	require.False(t, statusList.IsSet(10))
+	require.True(t, statusList.IsSet(11))
+
+	// new entries are numbered after the imported ones
+	srv := keeper.NewMsgServerImpl(k)
+	schema, err := srv.RegisterCredentialSchema(ctx, types.NewMsgRegisterCredentialSchema(issuer, "licensed driver", "https://example.com/d.json", hash))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), schema.Id)
+	credential, err := srv.IssueCredential(ctx, types.NewMsgIssueCredential(issuer, holder, schema.Id, hash, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), credential.Id)
+	require.Equal(t, uint64(12), credential.StatusListIndex)
+}
+
+func TestGenesisAttributes(t *testing.T) {
+	holder := sample.AccAddress()
+	hash := strings.Repeat("ab", 32)
+	simulator, err := groth16.NewSimulator(rand.Reader, 3)
+	require.NoError(t, err)
+	genesisState := types.GenesisState{
+		Params:       types.DefaultParams(),
+		IdentityList: []types.Identity{{Address: holder, IdHash: hash, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}},
+		AttributeCircuitList: []types.AttributeCircuit{{
+			Attribute:        "hanoi-resident",
+			Description:      "province code 01",
+			VerifyingKey:     types.Groth16VerifyingKey{Alpha: simulator.Alpha, Beta: simulator.Beta, Gamma: simulator.Gamma, Delta: simulator.Delta, Ic: simulator.IC},
+			FlagDuration:     3600,
+			RegisteredHeight: 2,
+		}},
+		AttributeFlagList: []types.AttributeFlag{{Address: holder, Attribute: "hanoi-resident", IdHash: hash, ProvenAt: 100, ProvenHeight: 3, ExpiresAt: 3700}},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.IdentityKeeper(t)
+	identity.InitGenesis(ctx, k, genesisState)
+	got := identity.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState.AttributeCircuitList, got.AttributeCircuitList)
+	require.Equal(t, genesisState.AttributeFlagList, got.AttributeFlagList)
+}
+
+func TestGenesisEvents(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		IdentityList: []types.Identity{
+			{Address: sample.AccAddress(), IdHash: "h0"},
+			{Address: sample.AccAddress(), IdHash: "h1", Status: types.IdentityStatus_IDENTITY_STATUS_PENDING},
+		},
+	}
+
+	k, ctx := keepertest.IdentityKeeper(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	identity.InitGenesis(ctx, k, genesisState)
+
+	// the created events, then the mint of the NFT of the active identity
+	events := ctx.EventManager().Events()
+	require.Len(t, events, len(genesisState.IdentityList)+1)
+	for i, elem := range genesisState.IdentityList {
+		got, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		require.NoError(t, err)
+		require.Equal(t, types.NewEventIdentityCreated(elem), got)
+	}
+	got, err := sdk.ParseTypedEvent(abci.Event(events[len(genesisState.IdentityList)]))
+	require.NoError(t, err)
+	require.Equal(t, &nft.EventMint{ClassId: types.NFTClassID, Id: genesisState.IdentityList[0].Address, Owner: genesisState.IdentityList[0].Address}, got)
+}
@@ -0,0 +1,60 @@
+package identity
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"Nexelra/x/identity/types"
+)
+
+// ValidateGenTxIdentities checks that the operator of every validator created
+// by a gentx of appState appears in the identity genesis, active and verified
+// to the level the genesis params require of validator operators.
+//
+// Gentxs are delivered before the identity genesis is imported, so the
+// staking hooks cannot check them on InitChain.
+func ValidateGenTxIdentities(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, appState map[string]json.RawMessage) error {
+	genState := types.DefaultGenesis()
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+		}
+	}
+
+	identities := make(map[string]types.Identity, len(genState.IdentityList))
+	for _, identity := range genState.IdentityList {
+		identities[identity.Address] = identity
+	}
+
+	genutilState := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	for i, genTx := range genutilState.GenTxs {
+		tx, err := txJSONDecoder(genTx)
+		if err != nil {
+			return fmt.Errorf("failed to decode gentx %d: %w", i, err)
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			createValidator, ok := msg.(*stakingtypes.MsgCreateValidator)
+			if !ok {
+				continue
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(createValidator.ValidatorAddress)
+			if err != nil {
+				return fmt.Errorf("gentx %d: invalid validator address: %w", i, err)
+			}
+			operator := sdk.AccAddress(valAddr).String()
+			identity, found := identities[operator]
+			if err := types.ValidateOperatorIdentity(operator, identity, found, genState.Params.ValidatorLevel); err != nil {
+				return fmt.Errorf("gentx %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package identity_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	identity "Nexelra/x/identity/module"
+	"Nexelra/x/identity/types"
+)
+
+func TestValidateGenTxIdentities(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}, identity.AppModuleBasic{})
+	operator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	genTx, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		identities []types.Identity
+		level      types.IdentityLevel
+		err        error
+	}{
+		{
+			desc:       "operator with identity",
+			identities: []types.Identity{{Address: operator.String(), IdHash: "h0"}},
+		},
+		{
+			desc:       "operator without identity",
+			identities: []types.Identity{{Address: sample.AccAddress(), IdHash: "h0"}},
+			err:        types.ErrOperatorNoIdentity,
+		},
+		{
+			desc:       "operator identity pending",
+			identities: []types.Identity{{Address: operator.String(), IdHash: "h0", Status: types.IdentityStatus_IDENTITY_STATUS_PENDING}},
+			err:        types.ErrOperatorNoIdentity,
+		},
+		{
+			desc:       "operator identity below level",
+			identities: []types.Identity{{Address: operator.String(), IdHash: "h0"}},
+			level:      types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+			err:        types.ErrOperatorIdentityLevel,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := types.DefaultGenesis()
+			genState.IdentityList = tc.identities
+			genState.Params.ValidatorLevel = tc.level
+
+			appState := map[string]json.RawMessage{
+				types.ModuleName:        encCfg.Codec.MustMarshalJSON(genState),
+				genutiltypes.ModuleName: encCfg.Codec.MustMarshalJSON(genutiltypes.NewGenesisState([]json.RawMessage{genTx})),
+			}
+
+			err := identity.ValidateGenTxIdentities(encCfg.Codec, encCfg.TxConfig.TxJSONDecoder(), appState)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
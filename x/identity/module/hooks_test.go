@@ -0,0 +1,65 @@
+package identity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	modulev1 "Nexelra/api/nexelra/identity/module"
+	keepertest "Nexelra/testutil/keeper"
+	identity "Nexelra/x/identity/module"
+	"Nexelra/x/identity/types"
+)
+
+func TestInvokeSetIdentityHooks(t *testing.T) {
+	wrappers := map[string]types.IdentityHooksWrapper{
+		"rewards": {IdentityHooks: types.MultiIdentityHooks{}},
+		"lending": {IdentityHooks: types.MultiIdentityHooks{}},
+	}
+
+	tests := []struct {
+		desc  string
+		order []string
+		hooks map[string]types.IdentityHooksWrapper
+		err   bool
+	}{
+		{
+			desc: "no hooks",
+		},
+		{
+			desc:  "alphabetical order",
+			hooks: wrappers,
+		},
+		{
+			desc:  "configured order",
+			order: []string{"rewards", "lending"},
+			hooks: wrappers,
+		},
+		{
+			desc:  "order misses a module",
+			order: []string{"rewards"},
+			hooks: wrappers,
+			err:   true,
+		},
+		{
+			desc:  "order names an unknown module",
+			order: []string{"rewards", "voting"},
+			hooks: wrappers,
+			err:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, _ := keepertest.IdentityKeeper(t)
+			err := identity.InvokeSetIdentityHooks(&modulev1.Module{HooksOrder: tc.order}, k, tc.hooks)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if len(tc.hooks) > 0 {
+				require.Len(t, k.Hooks(), len(tc.hooks))
+			}
+		})
+	}
+}
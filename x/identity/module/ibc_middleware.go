@@ -0,0 +1,120 @@
+package identity
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"Nexelra/x/identity/keeper"
+	"Nexelra/x/identity/types"
+)
+
+var (
+	_ porttypes.IBCModule             = IBCMiddleware{}
+	_ porttypes.UpgradableModule      = IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware wraps the ICS-20 transfer application and refuses incoming
+// transfers whose receiver has no active identity, as configured by the
+// IbcReceivePolicy param. Receivers the gating policy exempts, including the
+// app's module accounts, are let through as the ante handler lets them.
+// Refused packets are acknowledged with an error, so the sending chain
+// refunds the sender and no funds ever reach the receiver.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper         keeper.Keeper
+	moduleAccounts map[string]bool
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware around the transfer application app.
+// moduleAccounts are the bech32 addresses of the app's module accounts.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper, moduleAccounts map[string]bool) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule:      app,
+		keeper:         k,
+		moduleAccounts: moduleAccounts,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a transfer packet, the transfer application rejects it itself
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	params := im.keeper.GetParams(ctx)
+	if params.IbcReceivePolicy.RuleFor(packet.GetDestChannel()) == types.IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if !params.GatingPolicy.IsExempt(data.Receiver, im.moduleAccounts) && !im.keeper.HasActiveIdentity(ctx, data.Receiver) {
+		im.keeper.Logger().Info("refusing IBC transfer to receiver without identity",
+			"receiver", data.Receiver,
+			"channel", packet.GetDestChannel(),
+			"sequence", packet.GetSequence(),
+		)
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrReceiverNoIdentity, data.Receiver))
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := im.IBCModule.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "underlying app does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(bz)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -15,12 +16,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	// this line is used by starport scaffolding # 1
 
 	modulev1 "Nexelra/api/nexelra/identity/module"
+	"Nexelra/x/identity/client/cli"
 	"Nexelra/x/identity/keeper"
 	"Nexelra/x/identity/types"
 )
@@ -87,6 +92,16 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	}
 }
 
+// GetQueryCmd returns the module's custom query commands; autocli adds the rest.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// GetTxCmd returns the module's custom transaction commands; autocli adds the rest.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
 // ----------------------------------------------------------------------------
 // AppModule
 // ----------------------------------------------------------------------------
@@ -98,6 +113,9 @@ type AppModule struct {
 	keeper        keeper.Keeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	// govKeeper is nil in apps without x/gov, which then have no
+	// one-person-one-vote proposals
+	govKeeper *govkeeper.Keeper
 }
 
 func NewAppModule(
@@ -105,12 +123,14 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	govKeeper *govkeeper.Keeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
+		govKeeper:      govKeeper,
 	}
 }
 
@@ -118,6 +138,29 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 7 to 8: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -141,7 +184,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -149,10 +192,13 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 	return nil
 }
 
-// EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+// EndBlock finishes the one-person-one-vote proposals whose voting period
+// ended. It must run before the EndBlocker of x/gov.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if am.govKeeper == nil {
+		return nil
+	}
+	return am.keeper.EndOnePersonOneVoteProposals(ctx, am.govKeeper)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -169,6 +215,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetIdentityHooks),
 	)
 }
 
@@ -182,6 +229,8 @@ type ModuleInputs struct {
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	NFTKeeper     types.NFTKeeper
+	GovKeeper     *govkeeper.Keeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -189,6 +238,7 @@ type ModuleOutputs struct {
 
 	IdentityKeeper keeper.Keeper
 	Module         appmodule.AppModule
+	StakingHooks   stakingtypes.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -202,13 +252,57 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StoreService,
 		in.Logger,
 		authority.String(),
+		in.NFTKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
 		k,
 		in.AccountKeeper,
 		in.BankKeeper,
+		in.GovKeeper,
 	)
 
-	return ModuleOutputs{IdentityKeeper: k, Module: m}
+	return ModuleOutputs{
+		IdentityKeeper: k,
+		Module:         m,
+		StakingHooks:   stakingtypes.StakingHooksWrapper{StakingHooks: k.StakingHooks()},
+	}
+}
+
+// InvokeSetIdentityHooks collects the IdentityHooksWrapper provided by other
+// modules and installs them on the keeper, in config.HooksOrder or else in
+// alphabetical order of module names.
+func InvokeSetIdentityHooks(
+	config *modulev1.Module,
+	k keeper.Keeper,
+	identityHooks map[string]types.IdentityHooksWrapper,
+) error {
+	if len(identityHooks) == 0 {
+		return nil
+	}
+
+	order := config.HooksOrder
+	if len(order) == 0 {
+		for modName := range identityHooks {
+			order = append(order, modName)
+		}
+		sort.Strings(order)
+	}
+
+	if len(order) != len(identityHooks) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks: %v)", order, identityHooks)
+	}
+
+	var multiHooks types.MultiIdentityHooks
+	for _, modName := range order {
+		hook, ok := identityHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find identity hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	k.SetHooks(multiHooks)
+	return nil
 }
@@ -27,6 +27,10 @@ const (
     // TODO: Determine the simulation weight value
     defaultWeightMsgCreateIdentity int = 100
 
+    opWeightMsgAttestIdentity = "op_weight_msg_attest_identity"
+    // TODO: Determine the simulation weight value
+    defaultWeightMsgAttestIdentity int = 50
+
     // this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -36,8 +40,12 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
     for i, acc := range simState.Accounts {
         accs[i] = acc.Address.String()
     }
+    params := types.DefaultParams()
+    if len(accs) > 0 {
+        params.Verifiers = accs[:1]
+    }
     identityGenesis := types.GenesisState{
-        Params: types.DefaultParams(),
+        Params: params,
         IdentityList: []types.Identity{
             {
                 Address:   sample.AccAddress(),
@@ -78,6 +86,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
         identitysimulation.SimulateMsgCreateIdentity(am.accountKeeper, am.bankKeeper, am.keeper),
     ))
 
+    var weightMsgAttestIdentity int
+    simState.AppParams.GetOrGenerate(opWeightMsgAttestIdentity, &weightMsgAttestIdentity, nil,
+        func(_ *rand.Rand) {
+            weightMsgAttestIdentity = defaultWeightMsgAttestIdentity
+        },
+    )
+    operations = append(operations, simulation.NewWeightedOperation(
+        weightMsgAttestIdentity,
+        identitysimulation.SimulateMsgAttestIdentity(am.accountKeeper, am.bankKeeper, am.keeper),
+    ))
+
     // this line is used by starport scaffolding # simapp/module/operation
 
     return operations
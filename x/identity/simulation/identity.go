@@ -1,6 +1,7 @@
 package simulation
 
 import (
+    "encoding/hex"
     "math/rand"
     "strconv"
 
@@ -26,16 +27,25 @@ func SimulateMsgCreateIdentity(
     ) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
         simAccount, _ := simtypes.RandomAcc(r, accs)
 
-        msg := &types.MsgCreateIdentity{
-            Creator: simAccount.Address.String(),
-            CccdId:  strconv.Itoa(r.Intn(999999999) + 100000000), // Random 9-digit CCCD ID
+        params := k.GetParams(ctx)
+        cccdId := strconv.Itoa(r.Intn(999999999) + 100000000) // Random 9-digit CCCD ID
+        salt := make([]byte, types.SaltLength)
+        r.Read(salt)
+        commitment, err := types.ComputeCommitment(params.HashScheme, params.Pepper, salt, cccdId)
+        if err != nil {
+            return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgCreateIdentity{}), "unable to compute commitment"), nil, err
         }
 
+        msg := types.NewMsgCreateIdentity(simAccount.Address.String(), commitment, params.HashScheme)
+
         // Check if account already has an identity
         _, found := k.GetIdentity(ctx, msg.Creator)
         if found {
             return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "account already has identity"), nil, nil
         }
+        if _, found := k.GetIdentityByIdHash(ctx, msg.Commitment); found {
+            return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "CCCD ID already registered"), nil, nil
+        }
 
         txCtx := simulation.OperationInput{
             R:               r,
@@ -53,3 +63,61 @@ func SimulateMsgCreateIdentity(
         return simulation.GenAndDeliverTxWithRandFees(txCtx)
     }
 }
+
+func SimulateMsgAttestIdentity(
+    ak types.AccountKeeper,
+    bk types.BankKeeper,
+    k keeper.Keeper,
+) simtypes.Operation {
+    return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+    ) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+        msgType := sdk.MsgTypeURL(&types.MsgAttestIdentity{})
+
+        params := k.GetParams(ctx)
+        if len(params.Verifiers) == 0 {
+            return simtypes.NoOpMsg(types.ModuleName, msgType, "no registered verifier"), nil, nil
+        }
+        verifier, found := FindAccount(accs, params.Verifiers[r.Intn(len(params.Verifiers))])
+        if !found {
+            return simtypes.NoOpMsg(types.ModuleName, msgType, "verifier account not found"), nil, nil
+        }
+
+        var pending []types.Identity
+        for _, identity := range k.GetIdentitiesByStatus(ctx, types.IdentityStatus_IDENTITY_STATUS_PENDING) {
+            if identity.Address != verifier.Address.String() {
+                pending = append(pending, identity)
+            }
+        }
+        if len(pending) == 0 {
+            return simtypes.NoOpMsg(types.ModuleName, msgType, "no pending identity"), nil, nil
+        }
+        identity := pending[r.Intn(len(pending))]
+
+        msg := types.NewMsgAttestIdentity(verifier.Address.String(), identity.Address, identity.IdHash)
+        // The simulation keeps no CCCD IDs, a random tag stands in for the one
+        // the verifier would derive
+        if identity.HashScheme == types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 {
+            msg.CccdTag = identity.CccdTag
+            if msg.CccdTag == "" {
+                tag := make([]byte, types.CommitmentLength/2)
+                r.Read(tag)
+                msg.CccdTag = hex.EncodeToString(tag)
+            }
+        }
+
+        txCtx := simulation.OperationInput{
+            R:               r,
+            App:             app,
+            TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+            Cdc:             nil,
+            Msg:             msg,
+            Context:         ctx,
+            SimAccount:      verifier,
+            AccountKeeper:   ak,
+            Bankkeeper:      bk,
+            ModuleName:      types.ModuleName,
+            CoinsSpentInMsg: sdk.NewCoins(),
+        }
+        return simulation.GenAndDeliverTxWithRandFees(txCtx)
+    }
+}
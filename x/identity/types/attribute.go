@@ -0,0 +1,181 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"Nexelra/x/identity/groth16"
+)
+
+const (
+	// MaxAttributeName bounds the name of an attribute, MaxAttributeDescription
+	// the description of its circuit and MaxAttributeParameters the number of
+	// parameters of the circuit.
+	MaxAttributeName        = 64
+	MaxAttributeDescription = 256
+	MaxAttributeParameters  = 16
+
+	// AttributeProofGas is the gas consumed by verifying an attribute proof,
+	// which takes four pairings.
+	AttributeProofGas = 150000
+
+	// attributeIdentityInputs is the number of public inputs the chain derives
+	// from the identity, ahead of the circuit parameters.
+	attributeIdentityInputs = 3
+)
+
+// ValidateAttributeName checks that name is 1 to MaxAttributeName lowercase
+// letters, digits and hyphens.
+func ValidateAttributeName(name string) error {
+	if name == "" || len(name) > MaxAttributeName {
+		return fmt.Errorf("attribute name must have 1 to %d characters", MaxAttributeName)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			return fmt.Errorf("invalid character %q in attribute name %q", c, name)
+		}
+	}
+	return nil
+}
+
+// Parse decodes k, checking that its points are on the curve and in their
+// groups.
+func (k Groth16VerifyingKey) Parse() (*groth16.VerifyingKey, error) {
+	return groth16.NewVerifyingKey(k.Alpha, k.Beta, k.Gamma, k.Delta, k.Ic)
+}
+
+// ValidateAttributeCircuitParameters checks that parameters are decimal
+// elements of the BN254 scalar field.
+func ValidateAttributeCircuitParameters(parameters []string) error {
+	if len(parameters) > MaxAttributeParameters {
+		return fmt.Errorf("too many parameters: %d > %d", len(parameters), MaxAttributeParameters)
+	}
+	for i, parameter := range parameters {
+		if _, err := parseFieldElement(parameter); err != nil {
+			return fmt.Errorf("parameter %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateAttributeCircuit checks the fields of a circuit as registered by
+// governance: its verifying key must take the identity inputs and the
+// parameters as public inputs.
+func ValidateAttributeCircuit(attribute, description string, verifyingKey Groth16VerifyingKey, parameters []string, flagDuration int64) error {
+	if err := ValidateAttributeName(attribute); err != nil {
+		return err
+	}
+	if strings.TrimSpace(description) == "" || len(description) > MaxAttributeDescription {
+		return fmt.Errorf("circuit description must have 1 to %d characters", MaxAttributeDescription)
+	}
+	if err := ValidateAttributeCircuitParameters(parameters); err != nil {
+		return err
+	}
+	if flagDuration <= 0 {
+		return fmt.Errorf("flag duration must be positive, got %d", flagDuration)
+	}
+
+	vk, err := verifyingKey.Parse()
+	if err != nil {
+		return fmt.Errorf("invalid verifying key: %w", err)
+	}
+	if want := attributeIdentityInputs + len(parameters); vk.NumPublicInputs() != want {
+		return fmt.Errorf("verifying key has %d public inputs, the identity and parameters make %d", vk.NumPublicInputs(), want)
+	}
+
+	return nil
+}
+
+// Validate checks the fields of c.
+func (c AttributeCircuit) Validate() error {
+	if err := ValidateAttributeCircuit(c.Attribute, c.Description, c.VerifyingKey, c.Parameters, c.FlagDuration); err != nil {
+		return fmt.Errorf("circuit %s: %w", c.Attribute, err)
+	}
+	return nil
+}
+
+// PublicInputs returns the public inputs of a proof of c by the holder of
+// identity: the two 128-bit halves of its idHash, its address, then the
+// parameters of c.
+func (c AttributeCircuit) PublicInputs(identity Identity) ([]*big.Int, error) {
+	if err := ValidateCommitment(identity.IdHash); err != nil {
+		return nil, err
+	}
+	commitment, _ := hex.DecodeString(identity.IdHash)
+	holder, err := sdk.AccAddressFromBech32(identity.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := []*big.Int{
+		new(big.Int).SetBytes(commitment[:len(commitment)/2]),
+		new(big.Int).SetBytes(commitment[len(commitment)/2:]),
+		new(big.Int).SetBytes(holder),
+	}
+	for i, parameter := range c.Parameters {
+		input, err := parseFieldElement(parameter)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i, err)
+		}
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+// Verify checks that proof proves c for the holder of identity.
+func (c AttributeCircuit) Verify(identity Identity, proof []byte) error {
+	vk, err := c.VerifyingKey.Parse()
+	if err != nil {
+		return err
+	}
+	inputs, err := c.PublicInputs(identity)
+	if err != nil {
+		return err
+	}
+	parsed, err := groth16.ParseProof(proof)
+	if err != nil {
+		return err
+	}
+
+	return vk.Verify(parsed, inputs)
+}
+
+// parseFieldElement parses a decimal element of the BN254 scalar field.
+func parseFieldElement(s string) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok || x.Sign() < 0 || x.Cmp(groth16.Order) >= 0 || x.String() != s {
+		return nil, fmt.Errorf("%q is not a decimal element of the scalar field", s)
+	}
+	return x, nil
+}
+
+// Validate checks the fields of f.
+func (f AttributeFlag) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Address); err != nil {
+		return fmt.Errorf("attribute flag: invalid address %q: %w", f.Address, err)
+	}
+	if err := ValidateAttributeName(f.Attribute); err != nil {
+		return fmt.Errorf("attribute flag of %s: %w", f.Address, err)
+	}
+	if err := ValidateCommitment(f.IdHash); err != nil {
+		return fmt.Errorf("attribute flag %s of %s: %w", f.Attribute, f.Address, err)
+	}
+	if f.ExpiresAt <= f.ProvenAt {
+		return fmt.Errorf("attribute flag %s of %s: expires before it was proven", f.Attribute, f.Address)
+	}
+	return nil
+}
+
+// ActiveFor reports whether f holds for identity at time t: it has not
+// expired, and identity is active with the commitment f was proven against.
+func (f AttributeFlag) ActiveFor(identity Identity, t time.Time) bool {
+	return identity.Status == IdentityStatus_IDENTITY_STATUS_ACTIVE &&
+		identity.IdHash == f.IdHash &&
+		t.Unix() < f.ExpiresAt
+}
@@ -0,0 +1,1350 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nexelra/identity/attribute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Groth16VerifyingKey is the verifying key of a Groth16 circuit over BN254, as
+// set up with gnark. Points use the uncompressed EIP-197 encoding gnark's
+// MarshalSolidity writes: 64 bytes per G1 point and 128 bytes per G2 point.
+type Groth16VerifyingKey struct {
+	// alpha is a G1 point.
+	Alpha []byte `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// beta, gamma and delta are G2 points.
+	Beta  []byte `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	Gamma []byte `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	Delta []byte `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// ic are the G1 commitments to the public inputs, preceded by the constant
+	// term.
+	Ic [][]byte `protobuf:"bytes,5,rep,name=ic,proto3" json:"ic,omitempty"`
+}
+
+func (m *Groth16VerifyingKey) Reset()         { *m = Groth16VerifyingKey{} }
+func (m *Groth16VerifyingKey) String() string { return proto.CompactTextString(m) }
+func (*Groth16VerifyingKey) ProtoMessage()    {}
+func (*Groth16VerifyingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573a837c37e873e, []int{0}
+}
+func (m *Groth16VerifyingKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Groth16VerifyingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Groth16VerifyingKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Groth16VerifyingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Groth16VerifyingKey.Merge(m, src)
+}
+func (m *Groth16VerifyingKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *Groth16VerifyingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_Groth16VerifyingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Groth16VerifyingKey proto.InternalMessageInfo
+
+func (m *Groth16VerifyingKey) GetAlpha() []byte {
+	if m != nil {
+		return m.Alpha
+	}
+	return nil
+}
+
+func (m *Groth16VerifyingKey) GetBeta() []byte {
+	if m != nil {
+		return m.Beta
+	}
+	return nil
+}
+
+func (m *Groth16VerifyingKey) GetGamma() []byte {
+	if m != nil {
+		return m.Gamma
+	}
+	return nil
+}
+
+func (m *Groth16VerifyingKey) GetDelta() []byte {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+func (m *Groth16VerifyingKey) GetIc() [][]byte {
+	if m != nil {
+		return m.Ic
+	}
+	return nil
+}
+
+// AttributeCircuit is a zero-knowledge circuit registered by governance to
+// prove a predicate on the CCCD data an identity committed to, such as "born
+// in or before N" or "province code in a set", without revealing the data.
+//
+// The public inputs of the circuit are, in order: the two 128-bit halves of
+// the identity's idHash, the holder's account address as a big-endian
+// integer, then the parameters.
+type AttributeCircuit struct {
+	// attribute is the name of the flag a valid proof grants, e.g. age-over-18.
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// description states the predicate the circuit proves.
+	Description  string              `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	VerifyingKey Groth16VerifyingKey `protobuf:"bytes,3,opt,name=verifyingKey,proto3" json:"verifyingKey"`
+	// parameters are the public inputs fixed by governance, such as the year N
+	// or the Merkle root of a set of province codes, as decimal elements of the
+	// BN254 scalar field.
+	Parameters []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// flagDuration is how long (seconds) the flags granted by proofs last.
+	FlagDuration     int64 `protobuf:"varint,5,opt,name=flagDuration,proto3" json:"flagDuration,omitempty"`
+	RegisteredHeight int64 `protobuf:"varint,6,opt,name=registeredHeight,proto3" json:"registeredHeight,omitempty"`
+}
+
+func (m *AttributeCircuit) Reset()         { *m = AttributeCircuit{} }
+func (m *AttributeCircuit) String() string { return proto.CompactTextString(m) }
+func (*AttributeCircuit) ProtoMessage()    {}
+func (*AttributeCircuit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573a837c37e873e, []int{1}
+}
+func (m *AttributeCircuit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeCircuit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeCircuit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeCircuit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeCircuit.Merge(m, src)
+}
+func (m *AttributeCircuit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeCircuit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeCircuit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeCircuit proto.InternalMessageInfo
+
+func (m *AttributeCircuit) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *AttributeCircuit) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AttributeCircuit) GetVerifyingKey() Groth16VerifyingKey {
+	if m != nil {
+		return m.VerifyingKey
+	}
+	return Groth16VerifyingKey{}
+}
+
+func (m *AttributeCircuit) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *AttributeCircuit) GetFlagDuration() int64 {
+	if m != nil {
+		return m.FlagDuration
+	}
+	return 0
+}
+
+func (m *AttributeCircuit) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+// AttributeFlag records that the holder of an identity proved an attribute.
+// The flag holds until it expires, the identity's commitment changes or the
+// attribute's circuit is removed.
+type AttributeFlag struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Attribute string `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// idHash is the commitment the proof was made against.
+	IdHash string `protobuf:"bytes,3,opt,name=idHash,proto3" json:"idHash,omitempty"`
+	// provenAt is the block time (unix seconds) of the proof.
+	ProvenAt     int64 `protobuf:"varint,4,opt,name=provenAt,proto3" json:"provenAt,omitempty"`
+	ProvenHeight int64 `protobuf:"varint,5,opt,name=provenHeight,proto3" json:"provenHeight,omitempty"`
+	// expiresAt is the time (unix seconds) the flag expires at.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *AttributeFlag) Reset()         { *m = AttributeFlag{} }
+func (m *AttributeFlag) String() string { return proto.CompactTextString(m) }
+func (*AttributeFlag) ProtoMessage()    {}
+func (*AttributeFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4573a837c37e873e, []int{2}
+}
+func (m *AttributeFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeFlag.Merge(m, src)
+}
+func (m *AttributeFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeFlag proto.InternalMessageInfo
+
+func (m *AttributeFlag) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AttributeFlag) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *AttributeFlag) GetIdHash() string {
+	if m != nil {
+		return m.IdHash
+	}
+	return ""
+}
+
+func (m *AttributeFlag) GetProvenAt() int64 {
+	if m != nil {
+		return m.ProvenAt
+	}
+	return 0
+}
+
+func (m *AttributeFlag) GetProvenHeight() int64 {
+	if m != nil {
+		return m.ProvenHeight
+	}
+	return 0
+}
+
+func (m *AttributeFlag) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Groth16VerifyingKey)(nil), "nexelra.identity.Groth16VerifyingKey")
+	proto.RegisterType((*AttributeCircuit)(nil), "nexelra.identity.AttributeCircuit")
+	proto.RegisterType((*AttributeFlag)(nil), "nexelra.identity.AttributeFlag")
+}
+
+func init() { proto.RegisterFile("nexelra/identity/attribute.proto", fileDescriptor_4573a837c37e873e) }
+
+var fileDescriptor_4573a837c37e873e = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
+	0x14, 0xc6, 0x9b, 0xa4, 0xad, 0xe6, 0x6d, 0x95, 0x32, 0x2e, 0x32, 0x2c, 0x12, 0x43, 0x41, 0x28,
+	0x1e, 0x5a, 0x5c, 0xc1, 0x7b, 0x57, 0xd1, 0x05, 0x41, 0x61, 0x0e, 0x1e, 0xbc, 0x4d, 0x9b, 0xb7,
+	0xd3, 0x81, 0x34, 0x09, 0x33, 0xaf, 0x4b, 0x8b, 0xff, 0x84, 0xe0, 0xdf, 0xe3, 0x7d, 0x8f, 0x7b,
+	0xf4, 0x24, 0xd2, 0xfe, 0x23, 0x92, 0x49, 0xda, 0xa6, 0xd5, 0xdb, 0xfb, 0x7e, 0xfd, 0xe8, 0xbc,
+	0xef, 0xcb, 0x83, 0x38, 0xc3, 0x15, 0xa6, 0x46, 0x8e, 0x75, 0x82, 0x19, 0x69, 0x5a, 0x8f, 0x25,
+	0x91, 0xd1, 0xd3, 0x25, 0xe1, 0xa8, 0x30, 0x39, 0xe5, 0xac, 0x5f, 0x3b, 0x46, 0x3b, 0xc7, 0xc5,
+	0xb9, 0xca, 0x55, 0xee, 0x7e, 0x1c, 0x97, 0x53, 0xe5, 0x1b, 0x7c, 0x83, 0x27, 0x1f, 0x4c, 0x4e,
+	0xf3, 0x57, 0x6f, 0xbe, 0xa0, 0xd1, 0x37, 0x6b, 0x9d, 0xa9, 0x8f, 0xb8, 0x66, 0xe7, 0xd0, 0x91,
+	0x69, 0x31, 0x97, 0xdc, 0x8b, 0xbd, 0x61, 0x4f, 0x54, 0x82, 0x31, 0x68, 0x4f, 0x91, 0x24, 0xf7,
+	0x1d, 0x74, 0x73, 0xe9, 0x54, 0x72, 0xb1, 0x90, 0x3c, 0xa8, 0x9c, 0x4e, 0x94, 0x34, 0xc1, 0x94,
+	0x24, 0x6f, 0x57, 0xd4, 0x09, 0xf6, 0x18, 0x7c, 0x3d, 0xe3, 0x9d, 0x38, 0x18, 0xf6, 0x84, 0xaf,
+	0x67, 0x83, 0x1f, 0x3e, 0xf4, 0x27, 0xbb, 0xc5, 0xdf, 0x6a, 0x33, 0x5b, 0x6a, 0x62, 0xcf, 0x20,
+	0xdc, 0x87, 0x71, 0xcf, 0x87, 0xe2, 0x00, 0x58, 0x0c, 0x67, 0x09, 0xda, 0x99, 0xd1, 0x05, 0xe9,
+	0x3c, 0x73, 0x9b, 0x84, 0xa2, 0x89, 0xd8, 0x67, 0xe8, 0xdd, 0x36, 0xa2, 0xb8, 0xbd, 0xce, 0x2e,
+	0x5f, 0x8c, 0x4e, 0x0b, 0x19, 0xfd, 0x27, 0xf7, 0x55, 0xfb, 0xee, 0xf7, 0xf3, 0x96, 0x38, 0xfa,
+	0x03, 0x16, 0x01, 0x14, 0xd2, 0xc8, 0x05, 0x12, 0x1a, 0xcb, 0xdb, 0x71, 0x30, 0x0c, 0x45, 0x83,
+	0xb0, 0x01, 0xf4, 0x6e, 0x52, 0xa9, 0xde, 0x2d, 0x8d, 0x74, 0x3b, 0x75, 0x62, 0x6f, 0x18, 0x88,
+	0x23, 0xc6, 0x5e, 0x42, 0xdf, 0xa0, 0xd2, 0x96, 0xd0, 0x60, 0x72, 0x8d, 0x5a, 0xcd, 0x89, 0x77,
+	0x9d, 0xef, 0x1f, 0x3e, 0xf8, 0xe9, 0xc1, 0xa3, 0x7d, 0x2b, 0xef, 0x53, 0xa9, 0x18, 0x87, 0x07,
+	0x32, 0x49, 0x0c, 0x5a, 0x5b, 0x17, 0xb2, 0x93, 0xc7, 0x65, 0xf9, 0xa7, 0x65, 0x3d, 0x85, 0xae,
+	0x4e, 0xae, 0xa5, 0x9d, 0xbb, 0x12, 0x42, 0x51, 0x2b, 0x76, 0x01, 0x0f, 0x0b, 0x93, 0xdf, 0x62,
+	0x36, 0x21, 0xf7, 0x81, 0x02, 0xb1, 0xd7, 0x65, 0x9a, 0x6a, 0xae, 0xb7, 0xac, 0xd3, 0x34, 0x59,
+	0xf9, 0x2a, 0xae, 0x0a, 0x6d, 0xd0, 0x4e, 0x76, 0x31, 0x0e, 0xe0, 0xea, 0xf2, 0x6e, 0x13, 0x79,
+	0xf7, 0x9b, 0xc8, 0xfb, 0xb3, 0x89, 0xbc, 0xef, 0xdb, 0xa8, 0x75, 0xbf, 0x8d, 0x5a, 0xbf, 0xb6,
+	0x51, 0xeb, 0x2b, 0xff, 0x54, 0x9f, 0xed, 0xea, 0x70, 0xb8, 0xb4, 0x2e, 0xd0, 0x4e, 0xbb, 0xee,
+	0x1a, 0x5f, 0xff, 0x1d, 0x00, 0x11, 0x04, 0x63, 0x55, 0xd9, 0x02, 0x00, 0x00,
+}
+
+func (m *Groth16VerifyingKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Groth16VerifyingKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Groth16VerifyingKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ic) > 0 {
+		for iNdEx := len(m.Ic) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ic[iNdEx])
+			copy(dAtA[i:], m.Ic[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.Ic[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delta) > 0 {
+		i -= len(m.Delta)
+		copy(dAtA[i:], m.Delta)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Delta)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Gamma) > 0 {
+		i -= len(m.Gamma)
+		copy(dAtA[i:], m.Gamma)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Gamma)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Beta) > 0 {
+		i -= len(m.Beta)
+		copy(dAtA[i:], m.Beta)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Beta)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alpha) > 0 {
+		i -= len(m.Alpha)
+		copy(dAtA[i:], m.Alpha)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Alpha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeCircuit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeCircuit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeCircuit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FlagDuration != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.FlagDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.VerifyingKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttribute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProvenHeight != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ProvenHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProvenAt != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.ProvenAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IdHash) > 0 {
+		i -= len(m.IdHash)
+		copy(dAtA[i:], m.IdHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.IdHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Groth16VerifyingKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alpha)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Beta)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Gamma)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Delta)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Ic) > 0 {
+		for _, b := range m.Ic {
+			l = len(b)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AttributeCircuit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = m.VerifyingKey.Size()
+	n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	if m.FlagDuration != 0 {
+		n += 1 + sovAttribute(uint64(m.FlagDuration))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovAttribute(uint64(m.RegisteredHeight))
+	}
+	return n
+}
+
+func (m *AttributeFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.IdHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.ProvenAt != 0 {
+		n += 1 + sovAttribute(uint64(m.ProvenAt))
+	}
+	if m.ProvenHeight != 0 {
+		n += 1 + sovAttribute(uint64(m.ProvenHeight))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAttribute(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Groth16VerifyingKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Groth16VerifyingKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Groth16VerifyingKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alpha = append(m.Alpha[:0], dAtA[iNdEx:postIndex]...)
+			if m.Alpha == nil {
+				m.Alpha = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beta = append(m.Beta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Beta == nil {
+				m.Beta = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gamma = append(m.Gamma[:0], dAtA[iNdEx:postIndex]...)
+			if m.Gamma == nil {
+				m.Gamma = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delta = append(m.Delta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delta == nil {
+				m.Delta = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ic", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ic = append(m.Ic, make([]byte, postIndex-iNdEx))
+			copy(m.Ic[len(m.Ic)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeCircuit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeCircuit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeCircuit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VerifyingKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlagDuration", wireType)
+			}
+			m.FlagDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlagDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenAt", wireType)
+			}
+			m.ProvenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenHeight", wireType)
+			}
+			m.ProvenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttribute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttribute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttribute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttribute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttribute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttribute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttribute = fmt.Errorf("proto: unexpected end of group")
+)
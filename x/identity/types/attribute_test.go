@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/groth16"
+	"Nexelra/x/identity/types"
+)
+
+func TestValidateAttributeName(t *testing.T) {
+	require.NoError(t, types.ValidateAttributeName("age-over-18"))
+	require.NoError(t, types.ValidateAttributeName(strings.Repeat("a", types.MaxAttributeName)))
+	require.Error(t, types.ValidateAttributeName(""))
+	require.Error(t, types.ValidateAttributeName(strings.Repeat("a", types.MaxAttributeName+1)))
+	require.Error(t, types.ValidateAttributeName("Age-Over-18"))
+	require.Error(t, types.ValidateAttributeName("age over 18"))
+}
+
+func TestValidateAttributeCircuitParameters(t *testing.T) {
+	require.NoError(t, types.ValidateAttributeCircuitParameters([]string{"0", "2007", new(big.Int).Sub(groth16.Order, big.NewInt(1)).String()}))
+	require.Error(t, types.ValidateAttributeCircuitParameters([]string{groth16.Order.String()}))
+	require.Error(t, types.ValidateAttributeCircuitParameters([]string{"-1"}))
+	require.Error(t, types.ValidateAttributeCircuitParameters([]string{"02007"}))
+	require.Error(t, types.ValidateAttributeCircuitParameters([]string{"0x7d7"}))
+	require.Error(t, types.ValidateAttributeCircuitParameters(make([]string, types.MaxAttributeParameters+1)))
+}
+
+func TestAttributeCircuitPublicInputs(t *testing.T) {
+	holder := sample.AccAddress()
+	identity := types.Identity{Address: holder, IdHash: strings.Repeat("00", 15) + "01" + strings.Repeat("00", 15) + "02"}
+	circuit := types.AttributeCircuit{Parameters: []string{"2007", "79"}}
+
+	inputs, err := circuit.PublicInputs(identity)
+	require.NoError(t, err)
+	require.Equal(t, []*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).SetBytes(sdk.MustAccAddressFromBech32(holder)),
+		big.NewInt(2007),
+		big.NewInt(79),
+	}, inputs)
+
+	identity.IdHash = "hash"
+	_, err = circuit.PublicInputs(identity)
+	require.Error(t, err)
+}
+
+func TestAttributeFlagActiveFor(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	identity := types.Identity{Address: sample.AccAddress(), IdHash: hash, Status: types.IdentityStatus_IDENTITY_STATUS_ACTIVE}
+	flag := types.AttributeFlag{Address: identity.Address, Attribute: "age-over-18", IdHash: hash, ProvenAt: 100, ExpiresAt: 200}
+	require.NoError(t, flag.Validate())
+
+	require.True(t, flag.ActiveFor(identity, time.Unix(199, 0)))
+	require.False(t, flag.ActiveFor(identity, time.Unix(200, 0)))
+
+	changed := identity
+	changed.IdHash = strings.Repeat("cd", 32)
+	require.False(t, flag.ActiveFor(changed, time.Unix(150, 0)))
+
+	suspended := identity
+	suspended.Status = types.IdentityStatus_IDENTITY_STATUS_SUSPENDED
+	require.False(t, flag.ActiveFor(suspended, time.Unix(150, 0)))
+}
@@ -5,11 +5,30 @@ import (
     cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
     sdk "github.com/cosmos/cosmos-sdk/types"
     "github.com/cosmos/cosmos-sdk/types/msgservice"
+    "github.com/cosmos/gogoproto/proto"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
     cdc.RegisterConcrete(&MsgUpdateParams{}, "identity/UpdateParams", nil)
     cdc.RegisterConcrete(&MsgCreateIdentity{}, "identity/CreateIdentity", nil)
+    cdc.RegisterConcrete(&MsgUpdateIdentity{}, "identity/UpdateIdentity", nil)
+    cdc.RegisterConcrete(&MsgRevokeIdentity{}, "identity/RevokeIdentity", nil)
+    cdc.RegisterConcrete(&MsgSuspendIdentity{}, "identity/SuspendIdentity", nil)
+    cdc.RegisterConcrete(&MsgReinstateIdentity{}, "identity/ReinstateIdentity", nil)
+    cdc.RegisterConcrete(&MsgAttestIdentity{}, "identity/AttestIdentity", nil)
+    cdc.RegisterConcrete(&MsgSetIdentityLevel{}, "identity/SetIdentityLevel", nil)
+    cdc.RegisterConcrete(&MsgCreateDid{}, "identity/CreateDid", nil)
+    cdc.RegisterConcrete(&MsgUpdateDid{}, "identity/UpdateDid", nil)
+    cdc.RegisterConcrete(&MsgRotateDidKey{}, "identity/RotateDidKey", nil)
+    cdc.RegisterConcrete(&MsgDeactivateDid{}, "identity/DeactivateDid", nil)
+    cdc.RegisterConcrete(&MsgRegisterIssuer{}, "identity/RegisterIssuer", nil)
+    cdc.RegisterConcrete(&MsgRemoveIssuer{}, "identity/RemoveIssuer", nil)
+    cdc.RegisterConcrete(&MsgRegisterCredentialSchema{}, "identity/RegisterCredentialSchema", nil)
+    cdc.RegisterConcrete(&MsgIssueCredential{}, "identity/IssueCredential", nil)
+    cdc.RegisterConcrete(&MsgRevokeCredential{}, "identity/RevokeCredential", nil)
+    cdc.RegisterConcrete(&MsgRegisterAttributeCircuit{}, "identity/RegisterAttributeCircuit", nil)
+    cdc.RegisterConcrete(&MsgRemoveAttributeCircuit{}, "identity/RemoveAttributeCircuit", nil)
+    cdc.RegisterConcrete(&MsgProveAttribute{}, "identity/ProveAttribute", nil)
     // this line is used by starport scaffolding # 2
 }
 
@@ -17,8 +36,32 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
     registry.RegisterImplementations((*sdk.Msg)(nil),
         &MsgUpdateParams{},
         &MsgCreateIdentity{},
+        &MsgUpdateIdentity{},
+        &MsgRevokeIdentity{},
+        &MsgSuspendIdentity{},
+        &MsgReinstateIdentity{},
+        &MsgAttestIdentity{},
+        &MsgSetIdentityLevel{},
+        &MsgCreateDid{},
+        &MsgUpdateDid{},
+        &MsgRotateDidKey{},
+        &MsgDeactivateDid{},
+        &MsgRegisterIssuer{},
+        &MsgRemoveIssuer{},
+        &MsgRegisterCredentialSchema{},
+        &MsgIssueCredential{},
+        &MsgRevokeCredential{},
+        &MsgRegisterAttributeCircuit{},
+        &MsgRemoveAttributeCircuit{},
+        &MsgProveAttribute{},
     )
     // this line is used by starport scaffolding # 3
 
+    // data of the identity NFT class and tokens, packed in x/nft Anys
+    registry.RegisterImplementations((*proto.Message)(nil),
+        &IdentityClassData{},
+        &IdentityNFTData{},
+    )
+
     msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const (
+	// CommitmentLength is the length of a hex encoded CCCD commitment, and of
+	// a hex encoded CCCD tag.
+	CommitmentLength = sha256.Size * 2
+
+	// SaltLength is the length of the salt of a salted commitment.
+	SaltLength = 32
+)
+
+// ComputeCommitment derives the commitment of a CCCD ID under the given hash
+// scheme. It is meant to run client-side so the raw CCCD ID never reaches the
+// chain.
+//
+// Only the salted scheme takes a salt, which must be SaltLength random bytes
+// the holder keeps: the commitments of the other schemes are the same for
+// every holder of a CCCD ID, which lets anyone who knows the pepper test
+// guessed CCCD IDs against them.
+func ComputeCommitment(scheme HashScheme, pepper string, salt []byte, cccdId string) (string, error) {
+	switch scheme {
+	case HashScheme_HASH_SCHEME_SHA256:
+		hash := sha256.Sum256([]byte(cccdId))
+		return hex.EncodeToString(hash[:]), nil
+	case HashScheme_HASH_SCHEME_HMAC_SHA256:
+		if pepper == "" {
+			return "", fmt.Errorf("hash scheme %s requires a pepper", scheme)
+		}
+		mac := hmac.New(sha256.New, []byte(pepper))
+		mac.Write([]byte(cccdId))
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	case HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256:
+		if pepper == "" {
+			return "", fmt.Errorf("hash scheme %s requires a pepper", scheme)
+		}
+		if len(salt) != SaltLength {
+			return "", fmt.Errorf("hash scheme %s requires a %d-byte salt, got %d bytes", scheme, SaltLength, len(salt))
+		}
+		mac := hmac.New(sha256.New, []byte(pepper))
+		mac.Write(salt)
+		mac.Write([]byte(cccdId))
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	default:
+		return "", fmt.Errorf("unsupported hash scheme %s", scheme)
+	}
+}
+
+// LegacyCommitments derives the commitments of a CCCD ID under the unsalted
+// schemes, SHA256 and HMAC_SHA256 with pepper. Identities registered with
+// them before CCCD tags existed have no tag, so verifiers list them when
+// attesting a salted commitment to keep the CCCD on one address.
+func LegacyCommitments(pepper, cccdId string) []string {
+	schemes := []HashScheme{HashScheme_HASH_SCHEME_SHA256}
+	if pepper != "" {
+		schemes = append(schemes, HashScheme_HASH_SCHEME_HMAC_SHA256)
+	}
+
+	commitments := make([]string, 0, len(schemes))
+	for _, scheme := range schemes {
+		commitment, err := ComputeCommitment(scheme, pepper, nil, cccdId)
+		if err != nil {
+			panic(err)
+		}
+		commitments = append(commitments, commitment)
+	}
+	return commitments
+}
+
+// ComputeCccdTag derives the tag verifiers attest salted commitments with:
+// HMAC-SHA256 of the CCCD ID keyed by the secret they share. The key never
+// goes on chain, so unlike a commitment the tag cannot be tested against
+// guessed CCCD IDs by anyone else.
+func ComputeCccdTag(key, cccdId string) (string, error) {
+	if key == "" {
+		return "", errors.New("CCCD tag requires a key")
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(cccdId))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// ValidateCommitment checks that commitment is a well-formed hex encoded
+// SHA-256 sized digest.
+func ValidateCommitment(commitment string) error {
+	if len(commitment) != CommitmentLength {
+		return fmt.Errorf("commitment must be %d hex characters, got %d", CommitmentLength, len(commitment))
+	}
+	if _, err := hex.DecodeString(commitment); err != nil {
+		return fmt.Errorf("commitment must be hex encoded: %w", err)
+	}
+	return nil
+}
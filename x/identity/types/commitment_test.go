@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/types"
+)
+
+func TestComputeCommitment(t *testing.T) {
+	tests := []struct {
+		desc     string
+		scheme   types.HashScheme
+		pepper   string
+		expected string
+		valid    bool
+	}{
+		{
+			desc:     "legacy sha256 matches the former on-chain hash",
+			scheme:   types.HashScheme_HASH_SCHEME_SHA256,
+			expected: "2cfda9a81d79b0ec16a50198b31f11de9f242e747599d28fc078cb74fe5e1903",
+			valid:    true,
+		},
+		{
+			desc:   "hmac without pepper",
+			scheme: types.HashScheme_HASH_SCHEME_HMAC_SHA256,
+		},
+		{
+			desc:   "salted without salt",
+			scheme: types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256,
+			pepper: "pepper",
+		},
+		{
+			desc:   "unknown scheme",
+			scheme: types.HashScheme(99),
+			pepper: "pepper",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			commitment, err := types.ComputeCommitment(tc.scheme, tc.pepper, nil, "001099000001")
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, commitment)
+		})
+	}
+}
+
+func TestComputeCommitmentHmacPepper(t *testing.T) {
+	scheme := types.HashScheme_HASH_SCHEME_HMAC_SHA256
+
+	a, err := types.ComputeCommitment(scheme, "pepper-a", nil, "001099000001")
+	require.NoError(t, err)
+	again, err := types.ComputeCommitment(scheme, "pepper-a", nil, "001099000001")
+	require.NoError(t, err)
+	b, err := types.ComputeCommitment(scheme, "pepper-b", nil, "001099000001")
+	require.NoError(t, err)
+
+	// deterministic for the uniqueness index, but bound to the pepper
+	require.Equal(t, a, again)
+	require.NotEqual(t, a, b)
+	require.NoError(t, types.ValidateCommitment(a))
+}
+
+func TestComputeCommitmentSalted(t *testing.T) {
+	scheme := types.HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256
+	salt := bytes.Repeat([]byte{1}, types.SaltLength)
+
+	a, err := types.ComputeCommitment(scheme, "pepper", salt, "001099000001")
+	require.NoError(t, err)
+	again, err := types.ComputeCommitment(scheme, "pepper", salt, "001099000001")
+	require.NoError(t, err)
+	other, err := types.ComputeCommitment(scheme, "pepper", bytes.Repeat([]byte{2}, types.SaltLength), "001099000001")
+	require.NoError(t, err)
+	unsalted, err := types.ComputeCommitment(types.HashScheme_HASH_SCHEME_HMAC_SHA256, "pepper", nil, "001099000001")
+	require.NoError(t, err)
+
+	// the pepper and CCCD ID alone do not give the commitment away
+	require.Equal(t, a, again)
+	require.NotEqual(t, a, other)
+	require.NotEqual(t, a, unsalted)
+	require.NoError(t, types.ValidateCommitment(a))
+
+	_, err = types.ComputeCommitment(scheme, "pepper", salt[1:], "001099000001")
+	require.Error(t, err)
+}
+
+func TestComputeCccdTag(t *testing.T) {
+	a, err := types.ComputeCccdTag("key-a", "001099000001")
+	require.NoError(t, err)
+	again, err := types.ComputeCccdTag("key-a", "001099000001")
+	require.NoError(t, err)
+	b, err := types.ComputeCccdTag("key-b", "001099000001")
+	require.NoError(t, err)
+
+	// one tag per CCCD ID, bound to the verifiers' key
+	require.Equal(t, a, again)
+	require.NotEqual(t, a, b)
+	require.NoError(t, types.ValidateCommitment(a))
+
+	_, err = types.ComputeCccdTag("", "001099000001")
+	require.Error(t, err)
+}
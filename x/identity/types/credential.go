@@ -0,0 +1,154 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StatusPurposeRevocation is the purpose of the issuer status lists: a set
+	// bit revokes a credential for good.
+	StatusPurposeRevocation = "revocation"
+
+	// StatusListMinEntries is the minimum length of an encoded status list,
+	// 16KB, so the index of a credential does not single out its holder.
+	StatusListMinEntries = 131072
+
+	// MaxIssuerName and MaxCredentialSchemaName bound the names of issuers and
+	// schemas, and MaxRevocationReason the reason of a revocation.
+	MaxIssuerName           = 128
+	MaxCredentialSchemaName = 128
+	MaxRevocationReason     = 256
+)
+
+// ValidateDocumentHash checks that hash is the hex encoded SHA-256 of an
+// off-chain document, such as a credential or a credential schema.
+func ValidateDocumentHash(hash string) error {
+	if err := ValidateCommitment(hash); err != nil {
+		return fmt.Errorf("invalid document hash: %w", err)
+	}
+	return nil
+}
+
+// ValidateSchemaURI checks that uri is an absolute URI.
+func ValidateSchemaURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" || u.Opaque == "" && u.Host == "" {
+		return fmt.Errorf("schema uri %q is not an absolute URI", uri)
+	}
+	return nil
+}
+
+// ValidateIssuerName checks the name of an issuer.
+func ValidateIssuerName(name string) error {
+	if strings.TrimSpace(name) == "" || len(name) > MaxIssuerName {
+		return fmt.Errorf("issuer name must have 1 to %d characters", MaxIssuerName)
+	}
+	return nil
+}
+
+// ValidateCredentialSchemaName checks the name of a credential schema.
+func ValidateCredentialSchemaName(name string) error {
+	if strings.TrimSpace(name) == "" || len(name) > MaxCredentialSchemaName {
+		return fmt.Errorf("schema name must have 1 to %d characters", MaxCredentialSchemaName)
+	}
+	return nil
+}
+
+// Validate checks the address and name of i.
+func (i Issuer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(i.Address); err != nil {
+		return fmt.Errorf("invalid issuer address %q: %w", i.Address, err)
+	}
+	return ValidateIssuerName(i.Name)
+}
+
+// Validate checks the fields of s.
+func (s CredentialSchema) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Issuer); err != nil {
+		return fmt.Errorf("schema %d: invalid issuer address %q: %w", s.Id, s.Issuer, err)
+	}
+	if err := ValidateCredentialSchemaName(s.Name); err != nil {
+		return fmt.Errorf("schema %d: %w", s.Id, err)
+	}
+	if err := ValidateSchemaURI(s.Uri); err != nil {
+		return fmt.Errorf("schema %d: %w", s.Id, err)
+	}
+	if err := ValidateDocumentHash(s.Hash); err != nil {
+		return fmt.Errorf("schema %d: %w", s.Id, err)
+	}
+	return nil
+}
+
+// Validate checks the fields of c.
+func (c Credential) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Issuer); err != nil {
+		return fmt.Errorf("credential %d: invalid issuer address %q: %w", c.Id, c.Issuer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Holder); err != nil {
+		return fmt.Errorf("credential %d: invalid holder address %q: %w", c.Id, c.Holder, err)
+	}
+	if err := ValidateDocumentHash(c.Hash); err != nil {
+		return fmt.Errorf("credential %d: %w", c.Id, err)
+	}
+	if c.ExpiresAt < 0 {
+		return fmt.Errorf("credential %d: negative expiry", c.Id)
+	}
+	return nil
+}
+
+// StatusAt returns the status of c at time t.
+func (c Credential) StatusAt(t time.Time) CredentialStatus {
+	switch {
+	case c.Revoked:
+		return CredentialStatus_CREDENTIAL_STATUS_REVOKED
+	case c.ExpiresAt != 0 && t.Unix() >= c.ExpiresAt:
+		return CredentialStatus_CREDENTIAL_STATUS_EXPIRED
+	default:
+		return CredentialStatus_CREDENTIAL_STATUS_VALID
+	}
+}
+
+// Set sets the bit at index, growing the list as needed.
+func (l *CredentialStatusList) Set(index uint64) {
+	if n := index/8 + 1; uint64(len(l.Bitstring)) < n {
+		l.Bitstring = append(l.Bitstring, make([]byte, n-uint64(len(l.Bitstring)))...)
+	}
+	l.Bitstring[index/8] |= 0x80 >> (index % 8)
+}
+
+// IsSet reports whether the bit at index is set.
+func (l CredentialStatusList) IsSet(index uint64) bool {
+	if index/8 >= uint64(len(l.Bitstring)) {
+		return false
+	}
+	return l.Bitstring[index/8]&(0x80>>(index%8)) != 0
+}
+
+// Encode returns the encodedList of l as in a W3C Bitstring Status List
+// credential: the bitstring padded to StatusListMinEntries, GZIP compressed
+// and base64url multibase encoded.
+func (l CredentialStatusList) Encode() (string, error) {
+	bitstring := l.Bitstring
+	if len(bitstring) < StatusListMinEntries/8 {
+		bitstring = append(bytes.Clone(bitstring), make([]byte, StatusListMinEntries/8-len(bitstring))...)
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(bitstring); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return "u" + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
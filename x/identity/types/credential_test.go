@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/types"
+)
+
+func TestCredentialStatusList(t *testing.T) {
+	var list types.CredentialStatusList
+	require.False(t, list.IsSet(0))
+
+	list.Set(0)
+	list.Set(9)
+	require.Equal(t, []byte{0x80, 0x40}, list.Bitstring)
+	require.True(t, list.IsSet(0))
+	require.False(t, list.IsSet(1))
+	require.True(t, list.IsSet(9))
+	require.False(t, list.IsSet(1000))
+
+	encoded, err := list.Encode()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, "u"))
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded[1:])
+	require.NoError(t, err)
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	bitstring, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Len(t, bitstring, types.StatusListMinEntries/8)
+	require.Equal(t, list.Bitstring, bitstring[:2])
+	require.Equal(t, make([]byte, len(bitstring)-2), bitstring[2:])
+}
+
+func TestCredentialStatusAt(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_VALID, types.Credential{}.StatusAt(now))
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_VALID, types.Credential{ExpiresAt: now.Unix() + 1}.StatusAt(now))
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_EXPIRED, types.Credential{ExpiresAt: now.Unix()}.StatusAt(now))
+	require.Equal(t, types.CredentialStatus_CREDENTIAL_STATUS_REVOKED, types.Credential{ExpiresAt: now.Unix(), Revoked: true}.StatusAt(now))
+}
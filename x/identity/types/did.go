@@ -0,0 +1,322 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DidPrefix starts every DID of the did:nexelra method, which is followed
+	// by the bech32 account address of the identity holder.
+	DidPrefix = "did:nexelra:"
+
+	// DidContentType is the media type of resolved DID documents: the plain
+	// JSON representation of DID Core, without JSON-LD context.
+	DidContentType = "application/did+json"
+
+	// DID resolution errors, as registered in the DID Specification Registries.
+	DidResolutionErrorInvalidDid = "invalidDid"
+	DidResolutionErrorNotFound   = "notFound"
+
+	// MaxDidVerificationMethods and MaxDidServices bound the size of a DID
+	// document.
+	MaxDidVerificationMethods = 16
+	MaxDidServices            = 16
+
+	// maxDidFragment and maxDidPublicKey bound the length of the fragments of
+	// DID URLs and of the public keys of verification methods.
+	maxDidFragment  = 64
+	maxDidPublicKey = 256
+)
+
+// SupportedVerificationMethodTypes are the verification method types DID
+// documents may use. All of them carry the key in publicKeyMultibase.
+var SupportedVerificationMethodTypes = []string{
+	"Multikey",
+	"Ed25519VerificationKey2020",
+	"EcdsaSecp256k1VerificationKey2019",
+}
+
+// base58Alphabet is the alphabet of base58btc, the 'z' multibase encoding.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DidFromAddress returns the DID of the identity held by address.
+func DidFromAddress(address string) string {
+	return DidPrefix + address
+}
+
+// ParseDid returns the address of the identity a did:nexelra DID refers to.
+func ParseDid(did string) (sdk.AccAddress, error) {
+	address, ok := strings.CutPrefix(did, DidPrefix)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a %s DID", did, strings.TrimSuffix(DidPrefix, ":"))
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DID %q: %w", did, err)
+	}
+	// the address part must be canonical, so a DID has a single spelling
+	if addr.String() != address {
+		return nil, fmt.Errorf("invalid DID %q: address is not canonical", did)
+	}
+
+	return addr, nil
+}
+
+// NewDidDocument returns the DID document of did with the given verification
+// methods, relationships and services. Their ids may be given as bare
+// fragments, such as #key-1, and are expanded to DID URLs of did. Methods
+// without a controller are controlled by did.
+func NewDidDocument(
+	did string,
+	methods []VerificationMethod,
+	authentication []string,
+	assertionMethod []string,
+	services []Service,
+) (DidDocument, error) {
+	doc := DidDocument{
+		Id:         did,
+		Controller: []string{did},
+	}
+
+	var err error
+	for _, method := range methods {
+		if method.Id, err = DidURL(did, method.Id); err != nil {
+			return DidDocument{}, err
+		}
+		if method.Controller == "" {
+			method.Controller = did
+		}
+		doc.VerificationMethod = append(doc.VerificationMethod, method)
+	}
+	for _, id := range authentication {
+		if id, err = DidURL(did, id); err != nil {
+			return DidDocument{}, err
+		}
+		doc.Authentication = append(doc.Authentication, id)
+	}
+	for _, id := range assertionMethod {
+		if id, err = DidURL(did, id); err != nil {
+			return DidDocument{}, err
+		}
+		doc.AssertionMethod = append(doc.AssertionMethod, id)
+	}
+	for _, service := range services {
+		if service.Id, err = DidURL(did, service.Id); err != nil {
+			return DidDocument{}, err
+		}
+		doc.Service = append(doc.Service, service)
+	}
+
+	return doc, doc.Validate()
+}
+
+// DidURL returns the DID URL of the fragment id in did. id is either a bare
+// fragment, such as #key-1, or already a DID URL of did.
+func DidURL(did string, id string) (string, error) {
+	fragment, ok := strings.CutPrefix(id, did+"#")
+	if !ok {
+		if fragment, ok = strings.CutPrefix(id, "#"); !ok {
+			return "", fmt.Errorf("%q is neither a fragment nor a DID URL of %s", id, did)
+		}
+	}
+
+	if fragment == "" || len(fragment) > maxDidFragment {
+		return "", fmt.Errorf("invalid fragment in %q: must have 1 to %d characters", id, maxDidFragment)
+	}
+	for _, c := range fragment {
+		if !isFragmentChar(c) {
+			return "", fmt.Errorf("invalid character %q in fragment of %q", c, id)
+		}
+	}
+
+	return did + "#" + fragment, nil
+}
+
+func isFragmentChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '-' || c == '_' || c == '.'
+}
+
+// Validate checks that d is a well-formed did:nexelra document whose ids are
+// DID URLs of its DID, and whose relationships reference its methods.
+func (d DidDocument) Validate() error {
+	if _, err := ParseDid(d.Id); err != nil {
+		return err
+	}
+	if len(d.Controller) != 1 || d.Controller[0] != d.Id {
+		return fmt.Errorf("%s must be its own and only controller", d.Id)
+	}
+
+	if len(d.VerificationMethod) > MaxDidVerificationMethods {
+		return fmt.Errorf("too many verification methods: %d > %d", len(d.VerificationMethod), MaxDidVerificationMethods)
+	}
+	methods := make(map[string]bool, len(d.VerificationMethod))
+	for _, method := range d.VerificationMethod {
+		if err := d.validateId(method.Id, methods); err != nil {
+			return err
+		}
+		if err := method.Validate(); err != nil {
+			return err
+		}
+		if method.Controller != d.Id {
+			return fmt.Errorf("verification method %s must be controlled by %s", method.Id, d.Id)
+		}
+	}
+
+	if err := validateRelationship("authentication", d.Authentication, methods); err != nil {
+		return err
+	}
+	if err := validateRelationship("assertionMethod", d.AssertionMethod, methods); err != nil {
+		return err
+	}
+
+	if len(d.Service) > MaxDidServices {
+		return fmt.Errorf("too many services: %d > %d", len(d.Service), MaxDidServices)
+	}
+	for _, service := range d.Service {
+		// methods and services share the fragment namespace of the DID
+		if err := d.validateId(service.Id, methods); err != nil {
+			return err
+		}
+		if err := service.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateId checks that id is a DID URL of d not in seen, and adds it.
+func (d DidDocument) validateId(id string, seen map[string]bool) error {
+	expanded, err := DidURL(d.Id, id)
+	if err != nil {
+		return err
+	}
+	if expanded != id {
+		return fmt.Errorf("%q must be a DID URL of %s", id, d.Id)
+	}
+	if seen[id] {
+		return fmt.Errorf("duplicate id %s", id)
+	}
+	seen[id] = true
+	return nil
+}
+
+// validateRelationship checks that the verification relationship name lists
+// each of its methods once, and only methods of the document.
+func validateRelationship(name string, ids []string, methods map[string]bool) error {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !methods[id] {
+			return fmt.Errorf("%s references unknown verification method %s", name, id)
+		}
+		if seen[id] {
+			return fmt.Errorf("%s references %s twice", name, id)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// Validate checks the type and public key of m.
+func (m VerificationMethod) Validate() error {
+	supported := false
+	for _, t := range SupportedVerificationMethodTypes {
+		supported = supported || m.Type == t
+	}
+	if !supported {
+		return fmt.Errorf("verification method %s: unsupported type %q", m.Id, m.Type)
+	}
+
+	if err := ValidatePublicKeyMultibase(m.PublicKeyMultibase); err != nil {
+		return fmt.Errorf("verification method %s: %w", m.Id, err)
+	}
+
+	return nil
+}
+
+// ValidatePublicKeyMultibase checks that key is a base58btc multibase string.
+func ValidatePublicKeyMultibase(key string) error {
+	encoded, ok := strings.CutPrefix(key, "z")
+	if !ok || encoded == "" {
+		return fmt.Errorf("public key %q must be base58btc multibase, starting with z", key)
+	}
+	if len(key) > maxDidPublicKey {
+		return fmt.Errorf("public key longer than %d characters", maxDidPublicKey)
+	}
+	for _, c := range encoded {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return fmt.Errorf("invalid base58 character %q in public key", c)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the type and endpoint of s.
+func (s Service) Validate() error {
+	if strings.TrimSpace(s.Type) == "" {
+		return fmt.Errorf("service %s: empty type", s.Id)
+	}
+
+	endpoint, err := url.Parse(s.ServiceEndpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Opaque == "" && endpoint.Host == "" {
+		return fmt.Errorf("service %s: endpoint %q is not an absolute URI", s.Id, s.ServiceEndpoint)
+	}
+
+	return nil
+}
+
+// VerificationMethodIndex returns the index of the verification method with
+// the given id in d, or -1 if there is none.
+func (d DidDocument) VerificationMethodIndex(id string) int {
+	for i, method := range d.VerificationMethod {
+		if method.Id == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Version returns the version of the document the metadata describes.
+func (m DidDocumentMetadata) Version() uint64 {
+	version, _ := strconv.ParseUint(m.VersionId, 10, 64)
+	return version
+}
+
+// Validate checks the record's document and metadata.
+func (r DidRecord) Validate() error {
+	if err := r.Document.Validate(); err != nil {
+		return err
+	}
+	if r.Metadata.Version() == 0 {
+		return fmt.Errorf("%s: invalid versionId %q", r.Document.Id, r.Metadata.VersionId)
+	}
+	if _, err := time.Parse(time.RFC3339, r.Metadata.Created); err != nil {
+		return fmt.Errorf("%s: invalid created time: %w", r.Document.Id, err)
+	}
+	if r.Metadata.Updated != "" {
+		if _, err := time.Parse(time.RFC3339, r.Metadata.Updated); err != nil {
+			return fmt.Errorf("%s: invalid updated time: %w", r.Document.Id, err)
+		}
+	}
+	if r.Metadata.Deactivated && len(r.Document.VerificationMethod) > 0 {
+		return fmt.Errorf("%s: deactivated document has verification methods", r.Document.Id)
+	}
+
+	return nil
+}
+
+// FormatDidTime formats a block time for DID document metadata.
+func FormatDidTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
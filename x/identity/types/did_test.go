@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/types"
+)
+
+const testPublicKey = "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"
+
+func TestParseDid(t *testing.T) {
+	address := sample.AccAddress()
+
+	addr, err := types.ParseDid(types.DidFromAddress(address))
+	require.NoError(t, err)
+	require.Equal(t, address, addr.String())
+
+	for _, did := range []string{
+		"did:example:" + address,
+		"did:nexelra:",
+		"did:nexelra:" + strings.ToUpper(address),
+		"did:nexelra:" + address + "#key-1",
+	} {
+		_, err := types.ParseDid(did)
+		require.Error(t, err, did)
+	}
+}
+
+func TestNewDidDocument(t *testing.T) {
+	did := types.DidFromAddress(sample.AccAddress())
+	key := types.VerificationMethod{Id: "#key-1", Type: "Multikey", PublicKeyMultibase: testPublicKey}
+	service := types.Service{Id: "#kyc", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"}
+
+	doc, err := types.NewDidDocument(did, []types.VerificationMethod{key}, []string{"#key-1"}, []string{did + "#key-1"}, []types.Service{service})
+	require.NoError(t, err)
+	require.Equal(t, []string{did}, doc.Controller)
+	require.Equal(t, did+"#key-1", doc.VerificationMethod[0].Id)
+	require.Equal(t, did, doc.VerificationMethod[0].Controller)
+	require.Equal(t, []string{did + "#key-1"}, doc.Authentication)
+	require.Equal(t, []string{did + "#key-1"}, doc.AssertionMethod)
+	require.Equal(t, did+"#kyc", doc.Service[0].Id)
+
+	with := func(f func(*types.VerificationMethod, *types.Service)) ([]types.VerificationMethod, []types.Service) {
+		k, s := key, service
+		f(&k, &s)
+		return []types.VerificationMethod{k}, []types.Service{s}
+	}
+	for desc, f := range map[string]func(*types.VerificationMethod, *types.Service){
+		"unsupported type":      func(k *types.VerificationMethod, _ *types.Service) { k.Type = "RsaVerificationKey2018" },
+		"key not multibase":     func(k *types.VerificationMethod, _ *types.Service) { k.PublicKeyMultibase = "6MkhaXgBZ" },
+		"key not base58":        func(k *types.VerificationMethod, _ *types.Service) { k.PublicKeyMultibase = "z0OIl" },
+		"other controller":      func(k *types.VerificationMethod, _ *types.Service) { k.Controller = "did:nexelra:other" },
+		"id of another DID":     func(k *types.VerificationMethod, _ *types.Service) { k.Id = "did:nexelra:other#key-1" },
+		"invalid fragment":      func(k *types.VerificationMethod, _ *types.Service) { k.Id = "#key 1" },
+		"duplicate id":          func(_ *types.VerificationMethod, s *types.Service) { s.Id = "#key-1" },
+		"relative endpoint":     func(_ *types.VerificationMethod, s *types.Service) { s.ServiceEndpoint = "/kyc" },
+		"service without type":  func(_ *types.VerificationMethod, s *types.Service) { s.Type = " " },
+		"unknown authenticator": func(k *types.VerificationMethod, _ *types.Service) { k.Id = "#key-2" },
+	} {
+		methods, services := with(f)
+		_, err := types.NewDidDocument(did, methods, []string{"#key-1"}, nil, services)
+		require.Error(t, err, desc)
+	}
+
+	_, err = types.NewDidDocument(did, []types.VerificationMethod{key}, []string{"#key-1", "#key-1"}, nil, nil)
+	require.Error(t, err)
+}
@@ -8,6 +8,43 @@ import (
 
 // x/identity module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner         = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample                = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrCccdAlreadyRegistered = sdkerrors.Register(ModuleName, 1102, "CCCD ID is already registered to another address")
+	ErrUnsupportedHashScheme = sdkerrors.Register(ModuleName, 1103, "unsupported CCCD hash scheme")
+	ErrInvalidStatus         = sdkerrors.Register(ModuleName, 1104, "invalid identity status transition")
+	ErrUnknownVerifier       = sdkerrors.Register(ModuleName, 1105, "signer is not a registered verifier")
+	ErrReceiverNoIdentity    = sdkerrors.Register(ModuleName, 1106, "receiver has no active identity")
+
+	// Rejections of the identity gate in the ante handler. Clients map the
+	// codes to localized messages, so they must never be renumbered.
+	ErrSignerNotRegistered    = sdkerrors.Register(ModuleName, 1107, "signer has no registered identity")
+	ErrIdentityPending        = sdkerrors.Register(ModuleName, 1108, "signer identity is not attested yet")
+	ErrIdentitySuspended      = sdkerrors.Register(ModuleName, 1109, "signer identity is suspended")
+	ErrIdentityRevoked        = sdkerrors.Register(ModuleName, 1110, "signer identity is revoked")
+	ErrRecipientNotRegistered = sdkerrors.Register(ModuleName, 1111, "recipient has no registered identity")
+	ErrRecipientNotActive     = sdkerrors.Register(ModuleName, 1112, "recipient identity is not active")
+	ErrRecipientUnresolvable  = sdkerrors.Register(ModuleName, 1113, "cannot determine message recipients")
+
+	ErrSoulboundNFT = sdkerrors.Register(ModuleName, 1114, "identity NFTs cannot be transferred")
+
+	ErrOperatorNoIdentity    = sdkerrors.Register(ModuleName, 1115, "validator operator has no active identity")
+	ErrOperatorIdentityLevel = sdkerrors.Register(ModuleName, 1116, "validator operator identity is below the required identity level")
+
+	ErrInvalidDidDocument = sdkerrors.Register(ModuleName, 1117, "invalid DID document")
+	ErrDidExists          = sdkerrors.Register(ModuleName, 1118, "DID document already exists")
+	ErrDidNotFound        = sdkerrors.Register(ModuleName, 1119, "DID document not found")
+	ErrDidDeactivated     = sdkerrors.Register(ModuleName, 1120, "DID document is deactivated")
+
+	ErrUnknownIssuer     = sdkerrors.Register(ModuleName, 1121, "signer is not an active credential issuer")
+	ErrCredentialRevoked = sdkerrors.Register(ModuleName, 1122, "credential is revoked")
+
+	ErrMissingAttribute      = sdkerrors.Register(ModuleName, 1123, "signer has not proven a required attribute")
+	ErrInvalidAttributeProof = sdkerrors.Register(ModuleName, 1124, "invalid attribute proof")
+
+	ErrIdentityLevelTooLow   = sdkerrors.Register(ModuleName, 1125, "signer identity is below the level required by the message")
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 1126, "transfer exceeds the per-transaction limit of the sender identity level")
+
+	ErrSpendLimitExceeded   = sdkerrors.Register(ModuleName, 1127, "transfer exceeds what the sender identity may send within the spend window")
+	ErrTxCountLimitExceeded = sdkerrors.Register(ModuleName, 1128, "sender identity reached its transaction count for the spend window")
 )
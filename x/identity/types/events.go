@@ -0,0 +1,50 @@
+package types
+
+// Identity gate events, emitted by the ante handler for every signer and
+// recipient whose identity was checked.
+const (
+	EventTypeIdentityCheck = "identity_check"
+
+	AttributeKeyAddress  = "address"
+	AttributeKeyMsgIndex = "msg_index"
+	AttributeKeyMsgType  = "msg_type"
+	AttributeKeyRole     = "role"
+
+	// AttributeKeyAttribute names the missing identity attribute in
+	// rejections by an attribute rule.
+	AttributeKeyAttribute = "attribute"
+
+	// AttributeKeyLevel and AttributeKeyRequiredLevel give the level of the
+	// identity and the level a level rule requires in its rejections.
+	AttributeKeyLevel         = "level"
+	AttributeKeyRequiredLevel = "required_level"
+
+	// AttributeKeyAmount and AttributeKeyLimit give the amount sent and the
+	// limit it exceeds in rejections by a spend limit.
+	AttributeKeyAmount = "amount"
+	AttributeKeyLimit  = "limit"
+
+	// AttributeKeyTxCount gives the transactions sent within the spend window,
+	// counting the rejected one, and AttributeKeyWindow the length (seconds)
+	// of that window in rejections by a spend limit.
+	AttributeKeyTxCount = "tx_count"
+	AttributeKeyWindow  = "window"
+
+	AttributeValueRoleSigner    = "signer"
+	AttributeValueRoleRecipient = "recipient"
+)
+
+// RejectionFormat formats the details wrapped into a gating error. The
+// fields are key=value pairs so clients can parse them back out of the log
+// and render the rejection in their own language.
+const RejectionFormat = AttributeKeyAddress + "=%s " + AttributeKeyMsgIndex + "=%d " + AttributeKeyMsgType + "=%s"
+
+// NewEventIdentityCreated returns the event announcing identity.
+func NewEventIdentityCreated(identity Identity) *EventIdentityCreated {
+	return &EventIdentityCreated{
+		Address:    identity.Address,
+		IdHash:     identity.IdHash,
+		HashScheme: identity.HashScheme,
+		Status:     identity.Status,
+	}
+}
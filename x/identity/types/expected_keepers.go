@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +19,16 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// NFTKeeper defines the expected interface for the NFT module.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Update(ctx context.Context, token nft.NFT) error
+	HasNFT(ctx context.Context, classID, id string) bool
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
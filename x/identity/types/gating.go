@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGatingPolicy returns the policy of a freshly initialized chain: every
+// signer and recipient needs an active identity, except for the messages that
+// let an account obtain one and the governance-only parameter update.
+func DefaultGatingPolicy() GatingPolicy {
+	return GatingPolicy{
+		DefaultRule: GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT,
+		MsgRules: []MsgGatingRule{
+			{MsgTypeUrl: sdk.MsgTypeURL(&MsgCreateIdentity{}), Rule: GatingRule_GATING_RULE_EXEMPT},
+			{MsgTypeUrl: sdk.MsgTypeURL(&MsgUpdateIdentity{}), Rule: GatingRule_GATING_RULE_EXEMPT},
+			{MsgTypeUrl: sdk.MsgTypeURL(&MsgUpdateParams{}), Rule: GatingRule_GATING_RULE_EXEMPT},
+			// verifiers are authorized by the keeper against Params.Verifiers
+			{MsgTypeUrl: sdk.MsgTypeURL(&MsgAttestIdentity{}), Rule: GatingRule_GATING_RULE_EXEMPT},
+			{MsgTypeUrl: sdk.MsgTypeURL(&MsgSetIdentityLevel{}), Rule: GatingRule_GATING_RULE_EXEMPT},
+		},
+		ExemptModuleAccounts: true,
+	}
+}
+
+// RuleFor returns the rule that applies to messages of type msgTypeUrl
+func (p GatingPolicy) RuleFor(msgTypeUrl string) GatingRule {
+	for _, r := range p.MsgRules {
+		if r.MsgTypeUrl == msgTypeUrl {
+			return r.Rule
+		}
+	}
+
+	return p.DefaultRule
+}
+
+// AttributesFor returns the attributes the signers of messages of type
+// msgTypeUrl must have proven
+func (p GatingPolicy) AttributesFor(msgTypeUrl string) []string {
+	for _, r := range p.AttributeRules {
+		if r.MsgTypeUrl == msgTypeUrl {
+			return r.Attributes
+		}
+	}
+
+	return nil
+}
+
+// LevelFor returns the lowest identity level the signers of messages of type
+// msgTypeUrl may have
+func (p GatingPolicy) LevelFor(msgTypeUrl string) IdentityLevel {
+	for _, r := range p.LevelRules {
+		if r.MsgTypeUrl == msgTypeUrl {
+			return r.MinLevel
+		}
+	}
+
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+// IsExempt reports whether address never needs an identity: it is listed in
+// ExemptAddresses, or it is one of moduleAccounts, the bech32 addresses of
+// the app's module accounts, and ExemptModuleAccounts is set. The ante
+// handler and the IBC middleware both decide exemptions with it.
+func (p GatingPolicy) IsExempt(address string, moduleAccounts map[string]bool) bool {
+	if p.ExemptModuleAccounts && moduleAccounts[address] {
+		return true
+	}
+
+	for _, exempt := range p.ExemptAddresses {
+		if exempt == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate validates the gating policy
+func (p GatingPolicy) Validate() error {
+	if err := validateGatingRule(p.DefaultRule); err != nil {
+		return err
+	}
+
+	seenMsgs := make(map[string]bool, len(p.MsgRules))
+	for _, r := range p.MsgRules {
+		if err := validateMsgTypeUrl(r.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seenMsgs[r.MsgTypeUrl] {
+			return fmt.Errorf("duplicate gating rule for %s", r.MsgTypeUrl)
+		}
+		seenMsgs[r.MsgTypeUrl] = true
+
+		if err := validateGatingRule(r.Rule); err != nil {
+			return err
+		}
+	}
+
+	seenAttributeMsgs := make(map[string]bool, len(p.AttributeRules))
+	for _, r := range p.AttributeRules {
+		if err := validateMsgTypeUrl(r.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seenAttributeMsgs[r.MsgTypeUrl] {
+			return fmt.Errorf("duplicate attribute rule for %s", r.MsgTypeUrl)
+		}
+		seenAttributeMsgs[r.MsgTypeUrl] = true
+
+		if len(r.Attributes) == 0 {
+			return fmt.Errorf("attribute rule for %s lists no attributes", r.MsgTypeUrl)
+		}
+		seenAttributes := make(map[string]bool, len(r.Attributes))
+		for _, attribute := range r.Attributes {
+			if err := ValidateAttributeName(attribute); err != nil {
+				return fmt.Errorf("attribute rule for %s: %w", r.MsgTypeUrl, err)
+			}
+			if seenAttributes[attribute] {
+				return fmt.Errorf("attribute rule for %s lists %s twice", r.MsgTypeUrl, attribute)
+			}
+			seenAttributes[attribute] = true
+		}
+	}
+
+	seenLevelMsgs := make(map[string]bool, len(p.LevelRules))
+	for _, r := range p.LevelRules {
+		if err := validateMsgTypeUrl(r.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seenLevelMsgs[r.MsgTypeUrl] {
+			return fmt.Errorf("duplicate level rule for %s", r.MsgTypeUrl)
+		}
+		seenLevelMsgs[r.MsgTypeUrl] = true
+
+		if err := validateIdentityLevel(r.MinLevel); err != nil {
+			return fmt.Errorf("level rule for %s: %w", r.MsgTypeUrl, err)
+		}
+	}
+
+	seenAddrs := make(map[string]bool, len(p.ExemptAddresses))
+	for _, address := range p.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid exempt address %q: %w", address, err)
+		}
+		if seenAddrs[address] {
+			return fmt.Errorf("duplicate exempt address: %s", address)
+		}
+		seenAddrs[address] = true
+	}
+
+	return nil
+}
+
+func validateMsgTypeUrl(msgTypeUrl string) error {
+	if !strings.HasPrefix(msgTypeUrl, "/") || len(msgTypeUrl) == 1 {
+		return fmt.Errorf("invalid message type URL: %q", msgTypeUrl)
+	}
+
+	return nil
+}
+
+func validateGatingRule(rule GatingRule) error {
+	if _, ok := GatingRule_name[int32(rule)]; !ok {
+		return fmt.Errorf("unknown gating rule: %d", rule)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/types"
+)
+
+func TestGatingPolicyRuleFor(t *testing.T) {
+	policy := types.DefaultGatingPolicy()
+	require.Equal(t, types.GatingRule_GATING_RULE_EXEMPT, policy.RuleFor(sdk.MsgTypeURL(&types.MsgCreateIdentity{})))
+	require.Equal(t, types.GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT, policy.RuleFor(sdk.MsgTypeURL(&banktypes.MsgSend{})))
+
+	policy.MsgRules = append(policy.MsgRules, types.MsgGatingRule{
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		Rule:       types.GatingRule_GATING_RULE_SIGNER_ONLY,
+	})
+	require.Equal(t, types.GatingRule_GATING_RULE_SIGNER_ONLY, policy.RuleFor(sdk.MsgTypeURL(&banktypes.MsgSend{})))
+}
+
+func TestGatingPolicyLevels(t *testing.T) {
+	policy := types.DefaultGatingPolicy()
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED, policy.LevelFor(sendType))
+
+	policy.LevelRules = []types.MsgLevelRule{{MsgTypeUrl: sendType, MinLevel: types.IdentityLevel_IDENTITY_LEVEL_ENHANCED}}
+	require.Equal(t, types.IdentityLevel_IDENTITY_LEVEL_ENHANCED, policy.LevelFor(sendType))
+}
+
+func TestGatingPolicyIsExempt(t *testing.T) {
+	policy := types.DefaultGatingPolicy()
+	moduleAccount, listed := sample.AccAddress(), sample.AccAddress()
+	moduleAccounts := map[string]bool{moduleAccount: true}
+	policy.ExemptAddresses = []string{listed}
+
+	require.True(t, policy.IsExempt(moduleAccount, moduleAccounts))
+	require.True(t, policy.IsExempt(listed, moduleAccounts))
+	require.False(t, policy.IsExempt(sample.AccAddress(), moduleAccounts))
+
+	policy.ExemptModuleAccounts = false
+	require.False(t, policy.IsExempt(moduleAccount, moduleAccounts))
+	require.True(t, policy.IsExempt(listed, moduleAccounts))
+}
+
+func TestGatingPolicyValidate(t *testing.T) {
+	exempt := sample.AccAddress()
+
+	tests := []struct {
+		desc   string
+		mutate func(*types.GatingPolicy)
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			mutate: func(*types.GatingPolicy) {},
+			valid:  true,
+		},
+		{
+			desc: "exempt address",
+			mutate: func(p *types.GatingPolicy) {
+				p.ExemptAddresses = []string{exempt}
+			},
+			valid: true,
+		},
+		{
+			desc: "unknown default rule",
+			mutate: func(p *types.GatingPolicy) {
+				p.DefaultRule = types.GatingRule(99)
+			},
+		},
+		{
+			desc: "malformed type URL",
+			mutate: func(p *types.GatingPolicy) {
+				p.MsgRules = append(p.MsgRules, types.MsgGatingRule{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend"})
+			},
+		},
+		{
+			desc: "duplicate type URL",
+			mutate: func(p *types.GatingPolicy) {
+				p.MsgRules = append(p.MsgRules, p.MsgRules[0])
+			},
+		},
+		{
+			desc: "attribute rule",
+			mutate: func(p *types.GatingPolicy) {
+				p.AttributeRules = []types.MsgAttributeRule{
+					{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Attributes: []string{"age-over-18", "resident"}},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "attribute rule without attributes",
+			mutate: func(p *types.GatingPolicy) {
+				p.AttributeRules = []types.MsgAttributeRule{{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}}
+			},
+		},
+		{
+			desc: "attribute rule with invalid attribute",
+			mutate: func(p *types.GatingPolicy) {
+				p.AttributeRules = []types.MsgAttributeRule{
+					{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Attributes: []string{"Age Over 18"}},
+				}
+			},
+		},
+		{
+			desc: "attribute rule listing an attribute twice",
+			mutate: func(p *types.GatingPolicy) {
+				p.AttributeRules = []types.MsgAttributeRule{
+					{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Attributes: []string{"resident", "resident"}},
+				}
+			},
+		},
+		{
+			desc: "duplicate attribute rule",
+			mutate: func(p *types.GatingPolicy) {
+				rule := types.MsgAttributeRule{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Attributes: []string{"resident"}}
+				p.AttributeRules = []types.MsgAttributeRule{rule, rule}
+			},
+		},
+		{
+			desc: "attribute rule with malformed type URL",
+			mutate: func(p *types.GatingPolicy) {
+				p.AttributeRules = []types.MsgAttributeRule{{MsgTypeUrl: "MsgSend", Attributes: []string{"resident"}}}
+			},
+		},
+		{
+			desc: "level rules",
+			mutate: func(p *types.GatingPolicy) {
+				p.LevelRules = []types.MsgLevelRule{
+					{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MinLevel: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate level rule",
+			mutate: func(p *types.GatingPolicy) {
+				rule := types.MsgLevelRule{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MinLevel: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED}
+				p.LevelRules = []types.MsgLevelRule{rule, rule}
+			},
+		},
+		{
+			desc: "level rule with unknown level",
+			mutate: func(p *types.GatingPolicy) {
+				p.LevelRules = []types.MsgLevelRule{{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), MinLevel: types.IdentityLevel(99)}}
+			},
+		},
+		{
+			desc: "invalid exempt address",
+			mutate: func(p *types.GatingPolicy) {
+				p.ExemptAddresses = []string{"invalid_address"}
+			},
+		},
+		{
+			desc: "duplicate exempt address",
+			mutate: func(p *types.GatingPolicy) {
+				p.ExemptAddresses = []string{exempt, exempt}
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			policy := types.DefaultGatingPolicy()
+			tc.mutate(&policy)
+			err := policy.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -11,6 +13,16 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		IdentityList: []Identity{},
+		DidList:      []DidRecord{},
+
+		IssuerList:           []Issuer{},
+		CredentialSchemaList: []CredentialSchema{},
+		CredentialList:       []Credential{},
+
+		AttributeCircuitList: []AttributeCircuit{},
+		AttributeFlagList:    []AttributeFlag{},
+
+		IdentitySpendList: []IdentitySpend{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -21,15 +33,181 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// Check for duplicated index in identity
 	identityIndexMap := make(map[string]struct{})
+	// Check for the same CCCD hash or CCCD tag bound to several addresses
+	identityHashMap := make(map[string]string)
+	identityTagMap := make(map[string]string)
 
 	for _, elem := range gs.IdentityList {
-		index := string(IdentityKey(elem.Address))
+		// identities are keyed by the address bytes
+		addr, err := sdk.AccAddressFromBech32(elem.Address)
+		if err != nil {
+			return fmt.Errorf("invalid identity address %q: %w", elem.Address, err)
+		}
+		index := string(addr)
 		if _, ok := identityIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for identity")
 		}
 		identityIndexMap[index] = struct{}{}
+
+		if err := validateIdentityLevel(elem.Level); err != nil {
+			return fmt.Errorf("identity %s: %w", elem.Address, err)
+		}
+		// only verifiers raise identities above self-declared
+		if elem.Level != IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED && elem.Verifier == "" {
+			return fmt.Errorf("identity %s is %s without a verifier", elem.Address, elem.Level)
+		}
+
+		if elem.CccdTag != "" {
+			if owner, ok := identityTagMap[elem.CccdTag]; ok {
+				return fmt.Errorf("duplicated cccdTag for identity: %s and %s", owner, elem.Address)
+			}
+			identityTagMap[elem.CccdTag] = elem.Address
+		}
+
+		// pending identities only claim their commitment
+		if elem.IdHash == "" || elem.Status == IdentityStatus_IDENTITY_STATUS_PENDING {
+			continue
+		}
+		if owner, ok := identityHashMap[elem.IdHash]; ok {
+			return fmt.Errorf("duplicated idHash for identity: %s and %s", owner, elem.Address)
+		}
+		identityHashMap[elem.IdHash] = elem.Address
+	}
+
+	// Check DID documents, one at most per identity
+	didIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DidList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid DID document: %w", err)
+		}
+		addr, _ := ParseDid(elem.Document.Id)
+		index := string(addr)
+		if _, ok := identityIndexMap[index]; !ok {
+			return fmt.Errorf("DID %s has no identity", elem.Document.Id)
+		}
+		if _, ok := didIndexMap[index]; ok {
+			return fmt.Errorf("duplicated DID document %s", elem.Document.Id)
+		}
+		didIndexMap[index] = struct{}{}
+	}
+
+	if err := gs.validateCredentials(); err != nil {
+		return err
+	}
+	if err := gs.validateAttributes(identityIndexMap); err != nil {
+		return err
+	}
+
+	// Check spends, one at most per CCCD
+	spendIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IdentitySpendList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := spendIndexMap[elem.CccdKey]; ok {
+			return fmt.Errorf("duplicated spend for cccdKey %s", elem.CccdKey)
+		}
+		spendIndexMap[elem.CccdKey] = struct{}{}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validateCredentials checks the credential registry: schemas and
+// credentials must reference registered issuers and schemas, and each
+// credential must have its own entry in the status list of its issuer.
+func (gs GenesisState) validateCredentials() error {
+	issuers := make(map[string]Issuer, len(gs.IssuerList))
+	for _, elem := range gs.IssuerList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := issuers[elem.Address]; ok {
+			return fmt.Errorf("duplicated issuer %s", elem.Address)
+		}
+		issuers[elem.Address] = elem
+	}
+
+	schemas := make(map[uint64]struct{}, len(gs.CredentialSchemaList))
+	for _, elem := range gs.CredentialSchemaList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := issuers[elem.Issuer]; !ok {
+			return fmt.Errorf("schema %d: unknown issuer %s", elem.Id, elem.Issuer)
+		}
+		if _, ok := schemas[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for schema: %d", elem.Id)
+		}
+		schemas[elem.Id] = struct{}{}
+	}
+
+	credentials := make(map[uint64]struct{}, len(gs.CredentialList))
+	statusIndexes := make(map[string]map[uint64]bool, len(issuers))
+	for _, elem := range gs.CredentialList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := credentials[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for credential: %d", elem.Id)
+		}
+		credentials[elem.Id] = struct{}{}
+		if _, ok := schemas[elem.SchemaId]; !ok {
+			return fmt.Errorf("credential %d: unknown schema %d", elem.Id, elem.SchemaId)
+		}
+
+		issuer, ok := issuers[elem.Issuer]
+		if !ok {
+			return fmt.Errorf("credential %d: unknown issuer %s", elem.Id, elem.Issuer)
+		}
+		if elem.StatusListIndex >= issuer.StatusListSize {
+			return fmt.Errorf("credential %d: status list index %d beyond the %d entries of %s",
+				elem.Id, elem.StatusListIndex, issuer.StatusListSize, elem.Issuer)
+		}
+		if statusIndexes[elem.Issuer] == nil {
+			statusIndexes[elem.Issuer] = make(map[uint64]bool)
+		}
+		if statusIndexes[elem.Issuer][elem.StatusListIndex] {
+			return fmt.Errorf("credential %d: status list index %d of %s already taken", elem.Id, elem.StatusListIndex, elem.Issuer)
+		}
+		statusIndexes[elem.Issuer][elem.StatusListIndex] = true
+	}
+
+	return nil
+}
+
+// validateAttributes checks the attribute circuits and flags. Flags must
+// belong to an identity, but may outlive the circuit of their attribute: they
+// lapse when it is removed, or when it is registered again, since a flag
+// proven at or before the circuit's RegisteredHeight no longer counts.
+func (gs GenesisState) validateAttributes(identities map[string]struct{}) error {
+	circuits := make(map[string]struct{}, len(gs.AttributeCircuitList))
+	for _, elem := range gs.AttributeCircuitList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := circuits[elem.Attribute]; ok {
+			return fmt.Errorf("duplicated circuit for attribute %s", elem.Attribute)
+		}
+		circuits[elem.Attribute] = struct{}{}
+	}
+
+	flags := make(map[string]struct{}, len(gs.AttributeFlagList))
+	for _, elem := range gs.AttributeFlagList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		addr, _ := sdk.AccAddressFromBech32(elem.Address)
+		if _, ok := identities[string(addr)]; !ok {
+			return fmt.Errorf("attribute flag %s of %s has no identity", elem.Attribute, elem.Address)
+		}
+		index := string(addr) + "/" + elem.Attribute
+		if _, ok := flags[index]; ok {
+			return fmt.Errorf("duplicated attribute flag %s of %s", elem.Attribute, elem.Address)
+		}
+		flags[index] = struct{}{}
+	}
+
+	return nil
+}
@@ -27,8 +27,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the identity module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	IdentityList []Identity `protobuf:"bytes,2,rep,name=identityList,proto3" json:"identityList"`
+	Params       Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	IdentityList []Identity  `protobuf:"bytes,2,rep,name=identityList,proto3" json:"identityList"`
+	DidList      []DidRecord `protobuf:"bytes,3,rep,name=didList,proto3" json:"didList"`
+	// issuerList, credentialSchemaList and credentialList hold the credential
+	// registry. Status lists are rebuilt from the credentials.
+	IssuerList           []Issuer           `protobuf:"bytes,4,rep,name=issuerList,proto3" json:"issuerList"`
+	CredentialSchemaList []CredentialSchema `protobuf:"bytes,5,rep,name=credentialSchemaList,proto3" json:"credentialSchemaList"`
+	CredentialList       []Credential       `protobuf:"bytes,6,rep,name=credentialList,proto3" json:"credentialList"`
+	AttributeCircuitList []AttributeCircuit `protobuf:"bytes,7,rep,name=attributeCircuitList,proto3" json:"attributeCircuitList"`
+	AttributeFlagList    []AttributeFlag    `protobuf:"bytes,8,rep,name=attributeFlagList,proto3" json:"attributeFlagList"`
+	IdentitySpendList    []IdentitySpend    `protobuf:"bytes,9,rep,name=identitySpendList,proto3" json:"identitySpendList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +87,55 @@ func (m *GenesisState) GetIdentityList() []Identity {
 	return nil
 }
 
+func (m *GenesisState) GetDidList() []DidRecord {
+	if m != nil {
+		return m.DidList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIssuerList() []Issuer {
+	if m != nil {
+		return m.IssuerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialSchemaList() []CredentialSchema {
+	if m != nil {
+		return m.CredentialSchemaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialList() []Credential {
+	if m != nil {
+		return m.CredentialList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttributeCircuitList() []AttributeCircuit {
+	if m != nil {
+		return m.AttributeCircuitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttributeFlagList() []AttributeFlag {
+	if m != nil {
+		return m.AttributeFlagList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIdentitySpendList() []IdentitySpend {
+	if m != nil {
+		return m.IdentitySpendList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nexelra.identity.GenesisState")
 }
@@ -85,22 +143,34 @@ func init() {
 func init() { proto.RegisterFile("nexelra/identity/genesis.proto", fileDescriptor_3baa21b0d61606c8) }
 
 var fileDescriptor_3baa21b0d61606c8 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4b, 0xad, 0x48,
-	0xcd, 0x29, 0x4a, 0xd4, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0xc1,
-	0xe4, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0x31, 0x8c, 0x2e, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x9a, 0x2c, 0x25, 0x8f, 0x21, 0x0d, 0x63, 0x40, 0x14, 0x28, 0x4d, 0x64, 0xe4, 0xe2,
-	0x71, 0x87, 0x38, 0x26, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0x0d, 0x62, 0x82, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0xe3, 0xf4, 0x02, 0xc0, 0xf2, 0x4e, 0x9c,
-	0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x45, 0xc8, 0x85, 0x8b,
-	0x07, 0xa6, 0xca, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x0a, 0xd3,
-	0x08, 0x4f, 0x28, 0xc3, 0x89, 0x05, 0x64, 0x48, 0x10, 0x8a, 0x2e, 0x27, 0xa3, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x92, 0xf0, 0x83, 0x7a, 0xa7, 0x02, 0xe1, 0xa1, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x77, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x24, 0x9c, 0x5a, 0x6b, 0x01, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0xaa, 0xda, 0x40,
+	0x14, 0x86, 0x93, 0x7a, 0xaf, 0xb7, 0x77, 0xee, 0xa5, 0xf4, 0x0e, 0x2e, 0x42, 0x6a, 0x63, 0xea,
+	0x4a, 0xba, 0x48, 0xc0, 0x2e, 0x85, 0x42, 0x55, 0x5a, 0x2c, 0xa5, 0x14, 0xb3, 0x2b, 0xdd, 0x8c,
+	0xc9, 0x90, 0x0e, 0x68, 0x12, 0x26, 0x23, 0xe8, 0x5b, 0xf4, 0x31, 0xba, 0xec, 0x23, 0x74, 0xe9,
+	0xd2, 0x65, 0x57, 0xa5, 0xe8, 0xa2, 0xaf, 0x51, 0x72, 0x3c, 0x51, 0x9b, 0x51, 0x37, 0xe1, 0x30,
+	0xff, 0xf7, 0x7f, 0x4c, 0x86, 0x43, 0x9c, 0x84, 0x2f, 0xf8, 0x54, 0x32, 0x5f, 0x44, 0x3c, 0x51,
+	0x42, 0x2d, 0xfd, 0x98, 0x27, 0x3c, 0x17, 0xb9, 0x97, 0xc9, 0x54, 0xa5, 0xf4, 0x29, 0xe6, 0x5e,
+	0x99, 0xdb, 0x0f, 0x6c, 0x26, 0x92, 0xd4, 0x87, 0xef, 0x0e, 0xb2, 0x1b, 0x71, 0x1a, 0xa7, 0x30,
+	0xfa, 0xc5, 0x84, 0xa7, 0xcf, 0x35, 0x75, 0xc6, 0x24, 0x9b, 0xa1, 0xd9, 0x76, 0xb5, 0x98, 0x29,
+	0x25, 0xc5, 0x64, 0xae, 0x38, 0x12, 0x2f, 0x34, 0x22, 0x94, 0x1c, 0x46, 0x36, 0x45, 0xc4, 0xd6,
+	0x90, 0x48, 0x44, 0x98, 0xb5, 0xb4, 0xac, 0x1c, 0x10, 0x68, 0x6a, 0x40, 0x9e, 0xf1, 0x04, 0xeb,
+	0xed, 0x9f, 0xd7, 0xe4, 0xfe, 0xdd, 0xee, 0x2d, 0x02, 0xc5, 0x14, 0xa7, 0x3d, 0x52, 0xdf, 0xfd,
+	0x80, 0x65, 0xba, 0x66, 0xe7, 0xae, 0x6b, 0x79, 0xd5, 0xb7, 0xf1, 0x3e, 0x41, 0xde, 0xbf, 0x5d,
+	0xfd, 0x6e, 0x19, 0xdf, 0xff, 0xfe, 0x78, 0x69, 0x8e, 0xb1, 0x42, 0x87, 0xe4, 0xbe, 0xa4, 0x3e,
+	0x88, 0x5c, 0x59, 0x8f, 0xdc, 0x5a, 0xe7, 0xae, 0x6b, 0xeb, 0x8a, 0x11, 0x0e, 0xfd, 0xab, 0x42,
+	0x32, 0xfe, 0xaf, 0x45, 0x7b, 0xe4, 0x26, 0x12, 0x11, 0x08, 0x6a, 0x20, 0x78, 0xa6, 0x0b, 0x86,
+	0x22, 0x1a, 0xf3, 0x30, 0x95, 0x11, 0x1a, 0xca, 0x06, 0x7d, 0x4d, 0x88, 0xc8, 0xf3, 0x39, 0x97,
+	0xd0, 0xbf, 0x72, 0x6b, 0xa7, 0xff, 0x61, 0x04, 0x0c, 0x96, 0x8f, 0x1a, 0xf4, 0x0b, 0x69, 0x1c,
+	0xde, 0x3f, 0x08, 0xbf, 0xf2, 0x19, 0x03, 0xd3, 0x35, 0x98, 0xda, 0xba, 0x69, 0x50, 0xa1, 0xd1,
+	0x79, 0xd2, 0x42, 0xdf, 0x93, 0x27, 0x87, 0x73, 0xf0, 0xd6, 0xc1, 0xdb, 0xbc, 0xe4, 0x45, 0x63,
+	0xa5, 0x59, 0xdc, 0x74, 0xbf, 0x4b, 0x03, 0x21, 0xc3, 0xb9, 0x50, 0x60, 0xbc, 0x39, 0x77, 0xd3,
+	0x37, 0x15, 0xba, 0xbc, 0xe9, 0x29, 0x0b, 0x0d, 0xc8, 0xc3, 0xfe, 0xfc, 0xed, 0x94, 0xc5, 0xa0,
+	0x7e, 0x0c, 0xea, 0xd6, 0x05, 0x75, 0x81, 0xa2, 0x57, 0xef, 0x17, 0xd2, 0xb2, 0x12, 0x14, 0x4b,
+	0x08, 0xd2, 0xdb, 0x73, 0xd2, 0xd1, 0x31, 0x5a, 0x4a, 0xb5, 0x7e, 0xbf, 0xbb, 0xda, 0x38, 0xe6,
+	0x7a, 0xe3, 0x98, 0x7f, 0x36, 0x8e, 0xf9, 0x6d, 0xeb, 0x18, 0xeb, 0xad, 0x63, 0xfc, 0xda, 0x3a,
+	0xc6, 0x67, 0xeb, 0x23, 0xae, 0xfe, 0xe2, 0xb0, 0xfc, 0x6a, 0x99, 0xf1, 0x7c, 0x52, 0x87, 0xed,
+	0x7f, 0xf5, 0x6f, 0x00, 0xfc, 0x9f, 0x7d, 0x5c, 0x19, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +193,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IdentitySpendList) > 0 {
+		for iNdEx := len(m.IdentitySpendList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdentitySpendList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AttributeFlagList) > 0 {
+		for iNdEx := len(m.AttributeFlagList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeFlagList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AttributeCircuitList) > 0 {
+		for iNdEx := len(m.AttributeCircuitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeCircuitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CredentialList) > 0 {
+		for iNdEx := len(m.CredentialList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CredentialSchemaList) > 0 {
+		for iNdEx := len(m.CredentialSchemaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchemaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IssuerList) > 0 {
+		for iNdEx := len(m.IssuerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DidList) > 0 {
+		for iNdEx := len(m.DidList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.IdentityList) > 0 {
 		for iNdEx := len(m.IdentityList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +343,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DidList) > 0 {
+		for _, e := range m.DidList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IssuerList) > 0 {
+		for _, e := range m.IssuerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialSchemaList) > 0 {
+		for _, e := range m.CredentialSchemaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialList) > 0 {
+		for _, e := range m.CredentialList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttributeCircuitList) > 0 {
+		for _, e := range m.AttributeCircuitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttributeFlagList) > 0 {
+		for _, e := range m.AttributeFlagList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IdentitySpendList) > 0 {
+		for _, e := range m.IdentitySpendList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +490,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidList = append(m.DidList, DidRecord{})
+			if err := m.DidList[len(m.DidList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerList = append(m.IssuerList, Issuer{})
+			if err := m.IssuerList[len(m.IssuerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemaList = append(m.CredentialSchemaList, CredentialSchema{})
+			if err := m.CredentialSchemaList[len(m.CredentialSchemaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialList = append(m.CredentialList, Credential{})
+			if err := m.CredentialList[len(m.CredentialList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeCircuitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeCircuitList = append(m.AttributeCircuitList, AttributeCircuit{})
+			if err := m.AttributeCircuitList[len(m.AttributeCircuitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeFlagList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeFlagList = append(m.AttributeFlagList, AttributeFlag{})
+			if err := m.AttributeFlagList[len(m.AttributeFlagList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentitySpendList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentitySpendList = append(m.IdentitySpendList, IdentitySpend{})
+			if err := m.IdentitySpendList[len(m.IdentitySpendList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,14 +1,57 @@
 package types_test
 
 import (
+	"crypto/rand"
+	"strings"
 	"testing"
 
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/groth16"
 	"Nexelra/x/identity/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	verifier := sample.AccAddress()
+	addr0, addr1 := sample.AccAddress(), sample.AccAddress()
+	hash := strings.Repeat("ab", 32)
+	registry := func(f func(gs *types.GenesisState)) *types.GenesisState {
+		gs := &types.GenesisState{
+			IssuerList:           []types.Issuer{{Address: verifier, Name: "issuer", Active: true, StatusListSize: 2}},
+			CredentialSchemaList: []types.CredentialSchema{{Id: 0, Issuer: verifier, Name: "over-18", Uri: "https://example.com/s.json", Hash: hash}},
+			CredentialList: []types.Credential{
+				{Id: 0, Issuer: verifier, Holder: addr0, Hash: hash, StatusListIndex: 0},
+				{Id: 1, Issuer: verifier, Holder: addr1, Hash: hash, StatusListIndex: 1, Revoked: true},
+			},
+		}
+		f(gs)
+		return gs
+	}
+	simulator, err := groth16.NewSimulator(rand.Reader, 4)
+	require.NoError(t, err)
+	attributes := func(f func(gs *types.GenesisState)) *types.GenesisState {
+		gs := &types.GenesisState{
+			IdentityList: []types.Identity{{Address: addr0, IdHash: hash}},
+			AttributeCircuitList: []types.AttributeCircuit{{
+				Attribute:    "age-over-18",
+				Description:  "birth year at most 2007",
+				VerifyingKey: types.Groth16VerifyingKey{Alpha: simulator.Alpha, Beta: simulator.Beta, Gamma: simulator.Gamma, Delta: simulator.Delta, Ic: simulator.IC},
+				Parameters:   []string{"2007"},
+				FlagDuration: 3600,
+			}},
+			AttributeFlagList: []types.AttributeFlag{{Address: addr0, Attribute: "age-over-18", IdHash: hash, ProvenAt: 1, ExpiresAt: 3601}},
+		}
+		f(gs)
+		return gs
+	}
+	didRecord := func(address string) types.DidRecord {
+		return types.DidRecord{
+			Document: types.DidDocument{Id: types.DidFromAddress(address), Controller: []string{types.DidFromAddress(address)}},
+			Metadata: types.DidDocumentMetadata{Created: "2024-01-02T03:04:05Z", VersionId: "1"},
+		}
+	}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -25,10 +68,10 @@ func TestGenesisState_Validate(t *testing.T) {
 
 				IdentityList: []types.Identity{
 					{
-						Address: "0",
+						Address: addr0,
 					},
 					{
-						Address: "1",
+						Address: addr1,
 					},
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
@@ -40,8 +83,19 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				IdentityList: []types.Identity{
 					{
-						Address: "0",
+						Address: addr0,
+					},
+					{
+						Address: addr0,
 					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid identity address",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{
 					{
 						Address: "0",
 					},
@@ -49,6 +103,195 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated identity idHash",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{
+					{
+						Address: addr0,
+						IdHash:  "hash",
+					},
+					{
+						Address: addr1,
+						IdHash:  "hash",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending identity claiming a bound idHash",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{
+					{
+						Address: addr0,
+						IdHash:  "hash",
+					},
+					{
+						Address: addr1,
+						IdHash:  "hash",
+						Status:  types.IdentityStatus_IDENTITY_STATUS_PENDING,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "valid verifiers",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultPepper, types.DefaultHashScheme, []string{verifier, sample.AccAddress()}, types.DefaultGatingPolicy(), types.DefaultIbcReceivePolicy(), types.DefaultValidatorLevel, types.DefaultSpendPolicy()),
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated verifier",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultPepper, types.DefaultHashScheme, []string{verifier, verifier}, types.DefaultGatingPolicy(), types.DefaultIbcReceivePolicy(), types.DefaultValidatorLevel, types.DefaultSpendPolicy()),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid verifier address",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultPepper, types.DefaultHashScheme, []string{"invalid_address"}, types.DefaultGatingPolicy(), types.DefaultIbcReceivePolicy(), types.DefaultValidatorLevel, types.DefaultSpendPolicy()),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid DID document",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0}},
+				DidList:      []types.DidRecord{didRecord(addr0)},
+			},
+			valid: true,
+		},
+		{
+			desc: "DID document without identity",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0}},
+				DidList:      []types.DidRecord{didRecord(addr1)},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated DID document",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0}},
+				DidList:      []types.DidRecord{didRecord(addr0), didRecord(addr0)},
+			},
+			valid: false,
+		},
+		{
+			desc: "DID document without version",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0}},
+				DidList: []types.DidRecord{{
+					Document: didRecord(addr0).Document,
+					Metadata: types.DidDocumentMetadata{Created: "2024-01-02T03:04:05Z"},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc:     "valid credential registry",
+			genState: registry(func(*types.GenesisState) {}),
+			valid:    true,
+		},
+		{
+			desc:     "duplicated issuer",
+			genState: registry(func(gs *types.GenesisState) { gs.IssuerList = append(gs.IssuerList, gs.IssuerList[0]) }),
+			valid:    false,
+		},
+		{
+			desc:     "schema of unknown issuer",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialSchemaList[0].Issuer = addr0 }),
+			valid:    false,
+		},
+		{
+			desc:     "credential of unknown schema",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialList[0].SchemaId = 1 }),
+			valid:    false,
+		},
+		{
+			desc:     "duplicated credential",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialList[1].Id = 0 }),
+			valid:    false,
+		},
+		{
+			desc:     "shared status list index",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialList[1].StatusListIndex = 0 }),
+			valid:    false,
+		},
+		{
+			desc:     "status list index beyond the issuer list",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialList[1].StatusListIndex = 2 }),
+			valid:    false,
+		},
+		{
+			desc:     "invalid credential hash",
+			genState: registry(func(gs *types.GenesisState) { gs.CredentialList[1].Hash = "hash" }),
+			valid:    false,
+		},
+		{
+			desc:     "valid attributes",
+			genState: attributes(func(*types.GenesisState) {}),
+			valid:    true,
+		},
+		{
+			desc:     "flag outliving its circuit",
+			genState: attributes(func(gs *types.GenesisState) { gs.AttributeCircuitList = nil }),
+			valid:    true,
+		},
+		{
+			desc: "duplicated circuit",
+			genState: attributes(func(gs *types.GenesisState) {
+				gs.AttributeCircuitList = append(gs.AttributeCircuitList, gs.AttributeCircuitList[0])
+			}),
+			valid: false,
+		},
+		{
+			desc:     "circuit missing a public input",
+			genState: attributes(func(gs *types.GenesisState) { gs.AttributeCircuitList[0].Parameters = nil }),
+			valid:    false,
+		},
+		{
+			desc:     "flag without identity",
+			genState: attributes(func(gs *types.GenesisState) { gs.AttributeFlagList[0].Address = addr1 }),
+			valid:    false,
+		},
+		{
+			desc: "duplicated flag",
+			genState: attributes(func(gs *types.GenesisState) {
+				gs.AttributeFlagList = append(gs.AttributeFlagList, gs.AttributeFlagList[0])
+			}),
+			valid: false,
+		},
+		{
+			desc:     "flag expiring before it was proven",
+			genState: attributes(func(gs *types.GenesisState) { gs.AttributeFlagList[0].ExpiresAt = 1 }),
+			valid:    false,
+		},
+		{
+			desc: "verified identity",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0, Verifier: verifier, Level: types.IdentityLevel_IDENTITY_LEVEL_ENHANCED}},
+			},
+			valid: true,
+		},
+		{
+			desc: "verified identity without verifier",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0, Level: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED}},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown identity level",
+			genState: &types.GenesisState{
+				IdentityList: []types.Identity{{Address: addr0, Verifier: verifier, Level: types.IdentityLevel(99)}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IdentityHooks lets other modules react to identity lifecycle changes. An
+// error returned by a hook fails the message that triggered it.
+type IdentityHooks interface {
+	// AfterIdentityCreated is called once a new identity is stored, pending
+	// attestation.
+	AfterIdentityCreated(ctx context.Context, address sdk.AccAddress) error
+	// AfterIdentityRevoked is called once an identity is revoked.
+	AfterIdentityRevoked(ctx context.Context, address sdk.AccAddress) error
+	// BeforeIdentityUpdated is called before an identity's commitment is
+	// replaced, while the stored identity still holds the old one.
+	BeforeIdentityUpdated(ctx context.Context, address sdk.AccAddress) error
+}
+
+// IdentityHooksWrapper is a wrapper for modules to inject IdentityHooks using depinject.
+type IdentityHooksWrapper struct{ IdentityHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (IdentityHooksWrapper) IsOnePerModuleType() {}
+
+var _ IdentityHooks = MultiIdentityHooks{}
+
+// MultiIdentityHooks combines multiple identity hooks, all hook functions are
+// run in array sequence and the first error is returned.
+type MultiIdentityHooks []IdentityHooks
+
+// NewMultiIdentityHooks returns the hooks calling each of hooks in turn.
+func NewMultiIdentityHooks(hooks ...IdentityHooks) MultiIdentityHooks {
+	return hooks
+}
+
+func (h MultiIdentityHooks) AfterIdentityCreated(ctx context.Context, address sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterIdentityCreated(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiIdentityHooks) AfterIdentityRevoked(ctx context.Context, address sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterIdentityRevoked(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiIdentityHooks) BeforeIdentityUpdated(ctx context.Context, address sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeIdentityUpdated(ctx, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultIbcReceivePolicy returns the policy of a freshly initialized chain:
+// every incoming transfer needs a receiver with an active identity.
+func DefaultIbcReceivePolicy() IbcReceivePolicy {
+	return IbcReceivePolicy{
+		DefaultRule: IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY,
+	}
+}
+
+// RuleFor returns the rule that applies to packets arriving on channelId
+func (p IbcReceivePolicy) RuleFor(channelId string) IbcReceiveRule {
+	for _, r := range p.ChannelRules {
+		if r.ChannelId == channelId {
+			return r.Rule
+		}
+	}
+
+	return p.DefaultRule
+}
+
+// Validate validates the IBC receive policy
+func (p IbcReceivePolicy) Validate() error {
+	if err := validateIbcReceiveRule(p.DefaultRule); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(p.ChannelRules))
+	for _, r := range p.ChannelRules {
+		if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel id %q: %w", r.ChannelId, err)
+		}
+		if seen[r.ChannelId] {
+			return fmt.Errorf("duplicate receive rule for channel %s", r.ChannelId)
+		}
+		seen[r.ChannelId] = true
+
+		if err := validateIbcReceiveRule(r.Rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateIbcReceiveRule(rule IbcReceiveRule) error {
+	if _, ok := IbcReceiveRule_name[int32(rule)]; !ok {
+		return fmt.Errorf("unknown IBC receive rule: %d", rule)
+	}
+
+	return nil
+}
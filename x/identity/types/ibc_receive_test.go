@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/types"
+)
+
+func TestIbcReceivePolicy(t *testing.T) {
+	policy := types.DefaultIbcReceivePolicy()
+	require.NoError(t, policy.Validate())
+	require.Equal(t, types.IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY, policy.RuleFor("channel-0"))
+
+	policy.ChannelRules = []types.ChannelReceiveRule{{ChannelId: "channel-0", Rule: types.IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW}}
+	require.NoError(t, policy.Validate())
+	require.Equal(t, types.IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW, policy.RuleFor("channel-0"))
+	require.Equal(t, types.IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY, policy.RuleFor("channel-1"))
+
+	policy.ChannelRules = append(policy.ChannelRules, policy.ChannelRules[0])
+	require.Error(t, policy.Validate(), "duplicate channel")
+
+	policy.ChannelRules = []types.ChannelReceiveRule{{ChannelId: "not a channel"}}
+	require.Error(t, policy.Validate(), "invalid channel id")
+
+	policy.ChannelRules = nil
+	policy.DefaultRule = types.IbcReceiveRule(99)
+	require.Error(t, policy.Validate(), "unknown rule")
+}
@@ -0,0 +1,13 @@
+package types
+
+// CccdKey returns the key the identity is counted under wherever one CCCD
+// must count once, such as spend limits and the one-person-one-vote tally:
+// its CCCD tag, or for identities attested before tags existed, its
+// commitment. The commitment of a salted identity differs for each holder of
+// the CCCD, so only the tag ties the addresses of one CCCD together.
+func (identity Identity) CccdKey() string {
+	if identity.CccdTag != "" {
+		return identity.CccdTag
+	}
+	return identity.IdHash
+}
@@ -22,10 +22,155 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HashScheme identifies how an identity's idHash was derived from the CCCD ID.
+type HashScheme int32
+
+const (
+	// HASH_SCHEME_SHA256 is the legacy scheme: bare SHA-256 of the raw CCCD ID,
+	// computed on-chain from the transaction.
+	HashScheme_HASH_SCHEME_SHA256 HashScheme = 0
+	// HASH_SCHEME_HMAC_SHA256 is HMAC-SHA256 of the CCCD ID keyed by the
+	// chain-wide pepper in Params, computed client-side. The pepper is public
+	// and CCCD IDs are few enough to be enumerated, so the commitment does not
+	// hide the CCCD ID.
+	HashScheme_HASH_SCHEME_HMAC_SHA256 HashScheme = 1
+	// HASH_SCHEME_SALTED_HMAC_SHA256 is HMAC-SHA256 of a random 32-byte salt
+	// followed by the CCCD ID, keyed by the pepper, computed client-side. The
+	// holder keeps the salt, without which guessed CCCD IDs cannot be tested
+	// against the commitment. Commitments of the same CCCD ID differ, so the
+	// cccdTag attested by the verifier is what keeps a CCCD on one address.
+	HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256 HashScheme = 2
+)
+
+var HashScheme_name = map[int32]string{
+	0: "HASH_SCHEME_SHA256",
+	1: "HASH_SCHEME_HMAC_SHA256",
+	2: "HASH_SCHEME_SALTED_HMAC_SHA256",
+}
+
+var HashScheme_value = map[string]int32{
+	"HASH_SCHEME_SHA256":             0,
+	"HASH_SCHEME_HMAC_SHA256":        1,
+	"HASH_SCHEME_SALTED_HMAC_SHA256": 2,
+}
+
+func (x HashScheme) String() string {
+	return proto.EnumName(HashScheme_name, int32(x))
+}
+
+func (HashScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2231339b4da4bb30, []int{0}
+}
+
+// IdentityStatus is the lifecycle state of an identity. Only ACTIVE identities
+// pass the ante handler's identity gate.
+type IdentityStatus int32
+
+const (
+	IdentityStatus_IDENTITY_STATUS_ACTIVE IdentityStatus = 0
+	// IDENTITY_STATUS_SUSPENDED is a temporary hold lifted by MsgReinstateIdentity.
+	IdentityStatus_IDENTITY_STATUS_SUSPENDED IdentityStatus = 1
+	// IDENTITY_STATUS_REVOKED is terminal.
+	IdentityStatus_IDENTITY_STATUS_REVOKED IdentityStatus = 2
+	// IDENTITY_STATUS_PENDING is a submitted commitment awaiting
+	// MsgAttestIdentity from a registered verifier.
+	IdentityStatus_IDENTITY_STATUS_PENDING IdentityStatus = 3
+)
+
+var IdentityStatus_name = map[int32]string{
+	0: "IDENTITY_STATUS_ACTIVE",
+	1: "IDENTITY_STATUS_SUSPENDED",
+	2: "IDENTITY_STATUS_REVOKED",
+	3: "IDENTITY_STATUS_PENDING",
+}
+
+var IdentityStatus_value = map[string]int32{
+	"IDENTITY_STATUS_ACTIVE":    0,
+	"IDENTITY_STATUS_SUSPENDED": 1,
+	"IDENTITY_STATUS_REVOKED":   2,
+	"IDENTITY_STATUS_PENDING":   3,
+}
+
+func (x IdentityStatus) String() string {
+	return proto.EnumName(IdentityStatus_name, int32(x))
+}
+
+func (IdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2231339b4da4bb30, []int{1}
+}
+
+// IdentityLevel is how thoroughly the holder of an identity was checked. The
+// gating policy may require a minimum level per message type and limit the
+// transfers of each level.
+type IdentityLevel int32
+
+const (
+	// IDENTITY_LEVEL_SELF_DECLARED is a commitment no verifier checked, made
+	// by the holder before attestation was required.
+	IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED IdentityLevel = 0
+	// IDENTITY_LEVEL_VERIFIED is an identity a verifier attested against the
+	// holder's CCCD.
+	IdentityLevel_IDENTITY_LEVEL_VERIFIED IdentityLevel = 1
+	// IDENTITY_LEVEL_ENHANCED is a verified identity that also passed the
+	// verifier's enhanced due diligence, such as reading the CCCD chip in
+	// person.
+	IdentityLevel_IDENTITY_LEVEL_ENHANCED IdentityLevel = 2
+)
+
+var IdentityLevel_name = map[int32]string{
+	0: "IDENTITY_LEVEL_SELF_DECLARED",
+	1: "IDENTITY_LEVEL_VERIFIED",
+	2: "IDENTITY_LEVEL_ENHANCED",
+}
+
+var IdentityLevel_value = map[string]int32{
+	"IDENTITY_LEVEL_SELF_DECLARED": 0,
+	"IDENTITY_LEVEL_VERIFIED":      1,
+	"IDENTITY_LEVEL_ENHANCED":      2,
+}
+
+func (x IdentityLevel) String() string {
+	return proto.EnumName(IdentityLevel_name, int32(x))
+}
+
+func (IdentityLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2231339b4da4bb30, []int{2}
+}
+
 type Identity struct {
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	IdHash    string `protobuf:"bytes,2,opt,name=idHash,proto3" json:"idHash,omitempty"`
-	CreatedAt int64  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IdHash  string `protobuf:"bytes,2,opt,name=idHash,proto3" json:"idHash,omitempty"`
+	// createdAt is the block time (unix seconds) of the registration block.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// createdHeight is the height of the registration block.
+	CreatedHeight int64 `protobuf:"varint,4,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	// hashScheme is the scheme idHash was derived with.
+	HashScheme HashScheme     `protobuf:"varint,5,opt,name=hashScheme,proto3,enum=nexelra.identity.HashScheme" json:"hashScheme,omitempty"`
+	Status     IdentityStatus `protobuf:"varint,6,opt,name=status,proto3,enum=nexelra.identity.IdentityStatus" json:"status,omitempty"`
+	// updatedAt is the block time (unix seconds) of the last change to the
+	// identity after its registration, zero if it never changed.
+	UpdatedAt int64 `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// updatedHeight is the height of the last change to the identity.
+	UpdatedHeight int64 `protobuf:"varint,8,opt,name=updatedHeight,proto3" json:"updatedHeight,omitempty"`
+	// statusReason is the reason given for the last suspension or revocation.
+	StatusReason string `protobuf:"bytes,9,opt,name=statusReason,proto3" json:"statusReason,omitempty"`
+	// verifier is the address of the registered verifier that attested the
+	// current commitment, empty while pending and for identities registered
+	// before attestation was required.
+	Verifier string `protobuf:"bytes,10,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// attestedAt is the block time (unix seconds) of the attestation.
+	AttestedAt int64 `protobuf:"varint,11,opt,name=attestedAt,proto3" json:"attestedAt,omitempty"`
+	// attestedHeight is the height of the attestation block.
+	AttestedHeight int64 `protobuf:"varint,12,opt,name=attestedHeight,proto3" json:"attestedHeight,omitempty"`
+	// level is set by verifiers when they attest the identity and may be
+	// changed by them later. It falls back to SELF_DECLARED when the
+	// commitment is replaced.
+	Level IdentityLevel `protobuf:"varint,13,opt,name=level,proto3,enum=nexelra.identity.IdentityLevel" json:"level,omitempty"`
+	// cccdTag is set by the verifier attesting a salted commitment: the
+	// HMAC-SHA256 of the CCCD ID keyed by a secret the verifiers share
+	// off-chain. No two identities have the same tag. It is kept while a
+	// replaced commitment waits for its attestation.
+	CccdTag string `protobuf:"bytes,14,opt,name=cccdTag,proto3" json:"cccdTag,omitempty"`
 }
 
 func (m *Identity) Reset()         { *m = Identity{} }
@@ -82,25 +227,127 @@ func (m *Identity) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *Identity) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Identity) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_SHA256
+}
+
+func (m *Identity) GetStatus() IdentityStatus {
+	if m != nil {
+		return m.Status
+	}
+	return IdentityStatus_IDENTITY_STATUS_ACTIVE
+}
+
+func (m *Identity) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Identity) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *Identity) GetStatusReason() string {
+	if m != nil {
+		return m.StatusReason
+	}
+	return ""
+}
+
+func (m *Identity) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *Identity) GetAttestedAt() int64 {
+	if m != nil {
+		return m.AttestedAt
+	}
+	return 0
+}
+
+func (m *Identity) GetAttestedHeight() int64 {
+	if m != nil {
+		return m.AttestedHeight
+	}
+	return 0
+}
+
+func (m *Identity) GetLevel() IdentityLevel {
+	if m != nil {
+		return m.Level
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (m *Identity) GetCccdTag() string {
+	if m != nil {
+		return m.CccdTag
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("nexelra.identity.HashScheme", HashScheme_name, HashScheme_value)
+	proto.RegisterEnum("nexelra.identity.IdentityStatus", IdentityStatus_name, IdentityStatus_value)
+	proto.RegisterEnum("nexelra.identity.IdentityLevel", IdentityLevel_name, IdentityLevel_value)
 	proto.RegisterType((*Identity)(nil), "nexelra.identity.Identity")
 }
 
 func init() { proto.RegisterFile("nexelra/identity/identity.proto", fileDescriptor_2231339b4da4bb30) }
 
 var fileDescriptor_2231339b4da4bb30 = []byte{
-	// 167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4b, 0xad, 0x48,
-	0xcd, 0x29, 0x4a, 0xd4, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0x84, 0x33, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa0, 0x0a, 0xf4, 0x60, 0xe2, 0x4a, 0x51, 0x5c, 0x1c, 0x9e,
-	0x50, 0xb6, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x99, 0xe2, 0x91, 0x58, 0x9c, 0x21,
-	0xc1, 0x04, 0x96, 0x80, 0xf2, 0x84, 0x64, 0xb8, 0x38, 0x93, 0x8b, 0x52, 0x13, 0x4b, 0x52, 0x53,
-	0x1c, 0x4b, 0x24, 0x98, 0x15, 0x18, 0x35, 0x98, 0x83, 0x10, 0x02, 0x4e, 0x46, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0xe1, 0x07, 0x75, 0x68, 0x05, 0xc2, 0xa9, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x87, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbe,
-	0x17, 0xeb, 0x73, 0xcb, 0x00, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0xa6, 0x4d, 0x93, 0x87, 0x26, 0xb2, 0x6e, 0x08, 0x47, 0x09, 0x6e, 0x14, 0x21,
+	0x14, 0x65, 0x08, 0x52, 0x50, 0x11, 0x03, 0x8b, 0x89, 0xaf, 0xd8, 0xc2, 0x35, 0xc8, 0xe7, 0x46,
+	0x82, 0xc5, 0x32, 0xf1, 0xd1, 0x58, 0x84, 0x24, 0xb2, 0xaf, 0x55, 0xfb, 0x01, 0xd8, 0x59, 0xf8,
+	0x4e, 0x8c, 0x1d, 0x19, 0x51, 0xf2, 0x45, 0x90, 0xcf, 0x97, 0x17, 0xbb, 0xb0, 0xe5, 0xff, 0xf2,
+	0xc4, 0xbf, 0x7b, 0x4e, 0x07, 0x27, 0x33, 0x76, 0xc3, 0xa6, 0x71, 0xf0, 0x3c, 0x0a, 0xd9, 0x8c,
+	0x47, 0xfc, 0x76, 0xf3, 0xa3, 0xbf, 0x88, 0xe7, 0x7c, 0x8e, 0x54, 0x59, 0xe8, 0xaf, 0xfd, 0xce,
+	0xcf, 0x7d, 0xa8, 0x5a, 0x52, 0x20, 0x0c, 0x87, 0x41, 0x18, 0xc6, 0x2c, 0x49, 0xb0, 0xd2, 0x56,
+	0xba, 0x35, 0x77, 0x2d, 0x51, 0x13, 0x2a, 0x51, 0x68, 0x06, 0xc9, 0x04, 0xef, 0x89, 0x40, 0x2a,
+	0xd4, 0x82, 0xda, 0x38, 0x66, 0x01, 0x67, 0xa1, 0xce, 0x71, 0xb9, 0xad, 0x74, 0xcb, 0xee, 0xd6,
+	0x40, 0x4f, 0xa1, 0x2e, 0x85, 0xc9, 0xa2, 0xcb, 0x09, 0xc7, 0xfb, 0xa2, 0x91, 0x37, 0xd1, 0x6b,
+	0x80, 0x49, 0x90, 0x4c, 0xe8, 0x78, 0xc2, 0xbe, 0x31, 0x7c, 0xd0, 0x56, 0xba, 0x8d, 0x41, 0xab,
+	0x5f, 0x24, 0xed, 0x9b, 0x9b, 0x8e, 0xbb, 0xd3, 0x47, 0xaf, 0xa0, 0x92, 0xf0, 0x80, 0x5f, 0x25,
+	0xb8, 0x22, 0x26, 0xdb, 0xf7, 0x27, 0xd7, 0xe7, 0xa3, 0xa2, 0xe7, 0xca, 0x7e, 0xca, 0x7e, 0xb5,
+	0x08, 0x25, 0xfb, 0x61, 0xc6, 0xbe, 0x31, 0x52, 0x76, 0x29, 0x24, 0x7b, 0x35, 0x63, 0xcf, 0x99,
+	0xa8, 0x03, 0x47, 0xd9, 0xbf, 0xb9, 0x2c, 0x48, 0xe6, 0x33, 0x5c, 0x13, 0xdb, 0xc9, 0x79, 0xe8,
+	0x18, 0xaa, 0xd7, 0x2c, 0x8e, 0xbe, 0x44, 0x2c, 0xc6, 0x20, 0xf2, 0x8d, 0x46, 0x1a, 0x40, 0xc0,
+	0x39, 0x4b, 0x32, 0x88, 0x07, 0xe2, 0x13, 0x3b, 0x0e, 0x7a, 0x06, 0x8d, 0xb5, 0x92, 0x18, 0x47,
+	0xa2, 0x53, 0x70, 0xd1, 0x29, 0x1c, 0x4c, 0xd9, 0x35, 0x9b, 0xe2, 0xba, 0x58, 0xc2, 0xc9, 0xff,
+	0x97, 0x60, 0xa7, 0x35, 0x37, 0x6b, 0xa7, 0x17, 0x3e, 0x1e, 0x8f, 0x43, 0x2f, 0xb8, 0xc4, 0x8d,
+	0xec, 0xc2, 0xa5, 0xec, 0x31, 0x80, 0xed, 0xc2, 0x51, 0x13, 0x90, 0xa9, 0x53, 0xd3, 0xa7, 0x43,
+	0x93, 0x9c, 0x13, 0x9f, 0x9a, 0xfa, 0xe0, 0xf4, 0xa5, 0x5a, 0x42, 0x8f, 0xe1, 0xe1, 0xae, 0x6f,
+	0x9e, 0xeb, 0xc3, 0x75, 0xa8, 0xa0, 0x0e, 0x68, 0xb9, 0x21, 0xdd, 0xf6, 0x88, 0x91, 0xeb, 0xec,
+	0xf5, 0xbe, 0x2b, 0xd0, 0xc8, 0x5f, 0x0f, 0x3a, 0x86, 0xa6, 0x65, 0x10, 0xc7, 0xb3, 0xbc, 0x8f,
+	0x3e, 0xf5, 0x74, 0xef, 0x82, 0xfa, 0xfa, 0xd0, 0xb3, 0x46, 0x44, 0x2d, 0xa1, 0x27, 0xf0, 0xa8,
+	0x98, 0xd1, 0x0b, 0xfa, 0x81, 0x38, 0x06, 0x31, 0x54, 0x25, 0xc5, 0x29, 0xc6, 0x2e, 0x19, 0xbd,
+	0x7f, 0x47, 0x0c, 0x75, 0xef, 0x5f, 0x61, 0x3a, 0x68, 0x39, 0x6f, 0xd5, 0x72, 0xef, 0x2b, 0xd4,
+	0x73, 0x0b, 0x42, 0x6d, 0x68, 0x6d, 0xda, 0x36, 0x19, 0x11, 0xdb, 0xa7, 0xc4, 0x3e, 0xf3, 0x0d,
+	0x32, 0xb4, 0x75, 0x97, 0x18, 0xd9, 0xd9, 0x0b, 0x8d, 0x11, 0x71, 0xad, 0x33, 0xeb, 0x1e, 0x49,
+	0x16, 0x12, 0xc7, 0xd4, 0x9d, 0x61, 0x4a, 0xf2, 0x66, 0xf0, 0x6b, 0xa9, 0x29, 0x77, 0x4b, 0x4d,
+	0xf9, 0xb3, 0xd4, 0x94, 0x1f, 0x2b, 0xad, 0x74, 0xb7, 0xd2, 0x4a, 0xbf, 0x57, 0x5a, 0xe9, 0x13,
+	0x76, 0xe4, 0x03, 0xbe, 0xd9, 0x3e, 0x61, 0x7e, 0xbb, 0x60, 0xc9, 0xe7, 0x8a, 0x78, 0xc0, 0x2f,
+	0xfe, 0x0e, 0x00, 0x24, 0x89, 0x40, 0xa3, 0xe3, 0x03, 0x00, 0x00,
 }
 
 func (m *Identity) Marshal() (dAtA []byte, err error) {
@@ -123,6 +370,67 @@ func (m *Identity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CccdTag) > 0 {
+		i -= len(m.CccdTag)
+		copy(dAtA[i:], m.CccdTag)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.CccdTag)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Level != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AttestedHeight != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.AttestedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AttestedAt != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.AttestedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.StatusReason) > 0 {
+		i -= len(m.StatusReason)
+		copy(dAtA[i:], m.StatusReason)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.StatusReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintIdentity(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -173,6 +481,42 @@ func (m *Identity) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovIdentity(uint64(m.CreatedAt))
 	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovIdentity(uint64(m.CreatedHeight))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovIdentity(uint64(m.HashScheme))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIdentity(uint64(m.Status))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovIdentity(uint64(m.UpdatedAt))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovIdentity(uint64(m.UpdatedHeight))
+	}
+	l = len(m.StatusReason)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.AttestedAt != 0 {
+		n += 1 + sovIdentity(uint64(m.AttestedAt))
+	}
+	if m.AttestedHeight != 0 {
+		n += 1 + sovIdentity(uint64(m.AttestedHeight))
+	}
+	if m.Level != 0 {
+		n += 1 + sovIdentity(uint64(m.Level))
+	}
+	l = len(m.CccdTag)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
 	return n
 }
 
@@ -294,6 +638,254 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IdentityStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAt", wireType)
+			}
+			m.AttestedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+			}
+			m.AttestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CccdTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CccdTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "identity"
@@ -11,10 +16,79 @@ const (
 	MemStoreKey = "mem_identity"
 )
 
+// Collection prefixes of the module store. They are single bytes below the
+// ASCII range of the raw keys used up to consensus version 4, so the two
+// layouts never overlap while the v5 migration moves state between them.
 var (
-	ParamsKey = []byte("p_identity")
+	ParamsKey = collections.NewPrefix(0)
+
+	// IdentityKeyPrefix is the prefix of the identities, keyed by account address
+	IdentityKeyPrefix = collections.NewPrefix(1)
+
+	// IdentityIdHashIndexPrefix is the prefix of the idHash index
+	IdentityIdHashIndexPrefix = collections.NewPrefix(2)
+
+	// IdentityStatusIndexPrefix is the prefix of the status index
+	IdentityStatusIndexPrefix = collections.NewPrefix(3)
+
+	// IdentityVerifierIndexPrefix is the prefix of the verifier index
+	IdentityVerifierIndexPrefix = collections.NewPrefix(4)
+
+	// DidKeyPrefix is the prefix of the DID documents, keyed by the account
+	// address of their identity
+	DidKeyPrefix = collections.NewPrefix(5)
+
+	// IssuerKeyPrefix is the prefix of the credential issuers, keyed by
+	// account address
+	IssuerKeyPrefix = collections.NewPrefix(6)
+
+	// CredentialSchemaKeyPrefix is the prefix of the credential schemas, keyed
+	// by id, and CredentialSchemaSeqKey the key of the next schema id
+	CredentialSchemaKeyPrefix = collections.NewPrefix(7)
+	CredentialSchemaSeqKey    = collections.NewPrefix(8)
+
+	// CredentialKeyPrefix is the prefix of the credentials, keyed by id, and
+	// CredentialSeqKey the key of the next credential id
+	CredentialKeyPrefix = collections.NewPrefix(9)
+	CredentialSeqKey    = collections.NewPrefix(10)
+
+	// CredentialHolderIndexPrefix is the prefix of the holder index of the
+	// credentials
+	CredentialHolderIndexPrefix = collections.NewPrefix(11)
+
+	// CredentialStatusListKeyPrefix is the prefix of the revocation status
+	// lists, keyed by issuer address
+	CredentialStatusListKeyPrefix = collections.NewPrefix(12)
+
+	// AttributeCircuitKeyPrefix is the prefix of the attribute circuits, keyed
+	// by attribute
+	AttributeCircuitKeyPrefix = collections.NewPrefix(13)
+
+	// AttributeFlagKeyPrefix is the prefix of the attribute flags, keyed by
+	// account address and attribute
+	AttributeFlagKeyPrefix = collections.NewPrefix(14)
+
+	// IdentitySpendKeyPrefix is the prefix of the spends of the identities,
+	// keyed by CCCD key
+	IdentitySpendKeyPrefix = collections.NewPrefix(15)
+
+	// IdentityCccdTagIndexPrefix is the prefix of the cccdTag index
+	IdentityCccdTagIndexPrefix = collections.NewPrefix(16)
+
+	// ActiveCccdKeyPrefix is the prefix of the number of active identities
+	// of each CCCD, keyed by CCCD key, and ActiveCccdCountKey the key of the
+	// number of CCCDs with one
+	ActiveCccdKeyPrefix = collections.NewPrefix(17)
+	ActiveCccdCountKey  = collections.NewPrefix(18)
 )
 
-func KeyPrefix(p string) []byte {
-	return []byte(p)
+// IdentityStoreKey returns the key of the identity of addr in the module
+// store, the key its membership proofs are for.
+func IdentityStoreKey(addr sdk.AccAddress) []byte {
+	key, err := collections.EncodeKeyWithPrefix(IdentityKeyPrefix, sdk.AccAddressKey, addr)
+	if err != nil {
+		// account addresses always encode
+		panic(err)
+	}
+	return key
 }
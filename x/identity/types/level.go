@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+)
+
+// AttestedLevel returns the level an attestation at level grants: VERIFIED
+// unless the verifier asked for a higher one.
+func AttestedLevel(level IdentityLevel) IdentityLevel {
+	if level == IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED {
+		return IdentityLevel_IDENTITY_LEVEL_VERIFIED
+	}
+	return level
+}
+
+// GatedLevel returns the level the gating policy holds the owner of identity
+// to. Identities that are missing or not active are SELF_DECLARED.
+func GatedLevel(identity Identity, found bool) IdentityLevel {
+	if !found || identity.Status != IdentityStatus_IDENTITY_STATUS_ACTIVE {
+		return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+	}
+	return identity.Level
+}
+
+func validateIdentityLevel(level IdentityLevel) error {
+	if _, ok := IdentityLevel_name[int32(level)]; !ok {
+		return fmt.Errorf("unknown identity level: %d", level)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"Nexelra/x/identity/groth16"
+)
+
+var _ sdk.Msg = &MsgRegisterAttributeCircuit{}
+
+func NewMsgRegisterAttributeCircuit(
+	authority string,
+	attribute string,
+	description string,
+	verifyingKey Groth16VerifyingKey,
+	parameters []string,
+	flagDuration int64,
+) *MsgRegisterAttributeCircuit {
+	return &MsgRegisterAttributeCircuit{
+		Authority:    authority,
+		Attribute:    attribute,
+		Description:  description,
+		VerifyingKey: verifyingKey,
+		Parameters:   parameters,
+		FlagDuration: flagDuration,
+	}
+}
+
+func (msg *MsgRegisterAttributeCircuit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateAttributeCircuit(msg.Attribute, msg.Description, msg.VerifyingKey, msg.Parameters, msg.FlagDuration); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveAttributeCircuit{}
+
+func NewMsgRemoveAttributeCircuit(authority string, attribute string) *MsgRemoveAttributeCircuit {
+	return &MsgRemoveAttributeCircuit{
+		Authority: authority,
+		Attribute: attribute,
+	}
+}
+
+func (msg *MsgRemoveAttributeCircuit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateAttributeName(msg.Attribute); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgProveAttribute{}
+
+func NewMsgProveAttribute(creator string, attribute string, proof []byte) *MsgProveAttribute {
+	return &MsgProveAttribute{
+		Creator:   creator,
+		Attribute: attribute,
+		Proof:     proof,
+	}
+}
+
+func (msg *MsgProveAttribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateAttributeName(msg.Attribute); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.Proof) != groth16.ProofSize {
+		return errorsmod.Wrapf(ErrInvalidAttributeProof, "proof must have %d bytes, got %d", groth16.ProofSize, len(msg.Proof))
+	}
+
+	return nil
+}
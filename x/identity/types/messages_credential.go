@@ -0,0 +1,139 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterIssuer{}
+
+func NewMsgRegisterIssuer(authority string, address string, name string) *MsgRegisterIssuer {
+	return &MsgRegisterIssuer{
+		Authority: authority,
+		Address:   address,
+		Name:      name,
+	}
+}
+
+func (msg *MsgRegisterIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := ValidateIssuerName(msg.Name); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveIssuer{}
+
+func NewMsgRemoveIssuer(authority string, address string) *MsgRemoveIssuer {
+	return &MsgRemoveIssuer{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgRemoveIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRegisterCredentialSchema{}
+
+func NewMsgRegisterCredentialSchema(issuer string, name string, uri string, hash string) *MsgRegisterCredentialSchema {
+	return &MsgRegisterCredentialSchema{
+		Issuer: issuer,
+		Name:   name,
+		Uri:    uri,
+		Hash:   hash,
+	}
+}
+
+func (msg *MsgRegisterCredentialSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if err := ValidateCredentialSchemaName(msg.Name); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateSchemaURI(msg.Uri); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateDocumentHash(msg.Hash); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgIssueCredential{}
+
+func NewMsgIssueCredential(issuer string, holder string, schemaId uint64, hash string, expiresAt int64) *MsgIssueCredential {
+	return &MsgIssueCredential{
+		Issuer:    issuer,
+		Holder:    holder,
+		SchemaId:  schemaId,
+		Hash:      hash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (msg *MsgIssueCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", err)
+	}
+
+	if err := ValidateDocumentHash(msg.Hash); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.ExpiresAt < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "negative expiry")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeCredential{}
+
+func NewMsgRevokeCredential(issuer string, id uint64, reason string) *MsgRevokeCredential {
+	return &MsgRevokeCredential{
+		Issuer: issuer,
+		Id:     id,
+		Reason: reason,
+	}
+}
+
+func (msg *MsgRevokeCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
+	}
+
+	if len(msg.Reason) > MaxRevocationReason {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason longer than %d characters", MaxRevocationReason)
+	}
+
+	return nil
+}
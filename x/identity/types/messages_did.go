@@ -0,0 +1,108 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateDid{}
+
+func NewMsgCreateDid(creator string, methods []VerificationMethod, authentication []string, assertionMethod []string, services []Service) *MsgCreateDid {
+	return &MsgCreateDid{
+		Creator:            creator,
+		VerificationMethod: methods,
+		Authentication:     authentication,
+		AssertionMethod:    assertionMethod,
+		Service:            services,
+	}
+}
+
+func (msg *MsgCreateDid) ValidateBasic() error {
+	_, err := msg.Document()
+	return err
+}
+
+// Document returns the DID document msg creates.
+func (msg *MsgCreateDid) Document() (DidDocument, error) {
+	return newMsgDidDocument(msg.Creator, msg.VerificationMethod, msg.Authentication, msg.AssertionMethod, msg.Service)
+}
+
+var _ sdk.Msg = &MsgUpdateDid{}
+
+func NewMsgUpdateDid(creator string, methods []VerificationMethod, authentication []string, assertionMethod []string, services []Service) *MsgUpdateDid {
+	return &MsgUpdateDid{
+		Creator:            creator,
+		VerificationMethod: methods,
+		Authentication:     authentication,
+		AssertionMethod:    assertionMethod,
+		Service:            services,
+	}
+}
+
+func (msg *MsgUpdateDid) ValidateBasic() error {
+	_, err := msg.Document()
+	return err
+}
+
+// Document returns the DID document msg replaces the creator's one with.
+func (msg *MsgUpdateDid) Document() (DidDocument, error) {
+	return newMsgDidDocument(msg.Creator, msg.VerificationMethod, msg.Authentication, msg.AssertionMethod, msg.Service)
+}
+
+var _ sdk.Msg = &MsgRotateDidKey{}
+
+func NewMsgRotateDidKey(creator string, methodId string, publicKeyMultibase string) *MsgRotateDidKey {
+	return &MsgRotateDidKey{
+		Creator:            creator,
+		MethodId:           methodId,
+		PublicKeyMultibase: publicKeyMultibase,
+	}
+}
+
+func (msg *MsgRotateDidKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := DidURL(DidFromAddress(msg.Creator), msg.MethodId); err != nil {
+		return errorsmod.Wrap(ErrInvalidDidDocument, err.Error())
+	}
+
+	if err := ValidatePublicKeyMultibase(msg.PublicKeyMultibase); err != nil {
+		return errorsmod.Wrap(ErrInvalidDidDocument, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeactivateDid{}
+
+func NewMsgDeactivateDid(creator string) *MsgDeactivateDid {
+	return &MsgDeactivateDid{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgDeactivateDid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+// newMsgDidDocument returns the DID document of the creator's identity with
+// the content of a create or update message.
+func newMsgDidDocument(creator string, methods []VerificationMethod, authentication []string, assertionMethod []string, services []Service) (DidDocument, error) {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return DidDocument{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	doc, err := NewDidDocument(DidFromAddress(creator), methods, authentication, assertionMethod, services)
+	if err != nil {
+		return DidDocument{}, errorsmod.Wrap(ErrInvalidDidDocument, err.Error())
+	}
+
+	return doc, nil
+}
@@ -8,10 +8,11 @@ import (
 
 var _ sdk.Msg = &MsgCreateIdentity{}
 
-func NewMsgCreateIdentity(creator string, cccdId string) *MsgCreateIdentity {
+func NewMsgCreateIdentity(creator string, commitment string, hashScheme HashScheme) *MsgCreateIdentity {
     return &MsgCreateIdentity{
-        Creator: creator,
-        CccdId:  cccdId,
+        Creator:    creator,
+        Commitment: commitment,
+        HashScheme: hashScheme,
     }
 }
 
@@ -20,10 +21,177 @@ func (msg *MsgCreateIdentity) ValidateBasic() error {
     if err != nil {
         return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
     }
-    
-    if msg.CccdId == "" {
-        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "CCCD ID cannot be empty")
+
+    if err := ValidateCommitment(msg.Commitment); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+    }
+
+    if _, ok := HashScheme_name[int32(msg.HashScheme)]; !ok {
+        return errorsmod.Wrapf(ErrUnsupportedHashScheme, "unknown hash scheme %d", msg.HashScheme)
+    }
+
+    return nil
+}
+
+var _ sdk.Msg = &MsgUpdateIdentity{}
+
+func NewMsgUpdateIdentity(creator string, commitment string, hashScheme HashScheme) *MsgUpdateIdentity {
+    return &MsgUpdateIdentity{
+        Creator:    creator,
+        Commitment: commitment,
+        HashScheme: hashScheme,
+    }
+}
+
+func (msg *MsgUpdateIdentity) ValidateBasic() error {
+    _, err := sdk.AccAddressFromBech32(msg.Creator)
+    if err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+    }
+
+    if err := ValidateCommitment(msg.Commitment); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+    }
+
+    if _, ok := HashScheme_name[int32(msg.HashScheme)]; !ok {
+        return errorsmod.Wrapf(ErrUnsupportedHashScheme, "unknown hash scheme %d", msg.HashScheme)
+    }
+
+    return nil
+}
+
+var _ sdk.Msg = &MsgRevokeIdentity{}
+
+func NewMsgRevokeIdentity(authority string, address string, reason string) *MsgRevokeIdentity {
+    return &MsgRevokeIdentity{
+        Authority: authority,
+        Address:   address,
+        Reason:    reason,
+    }
+}
+
+func (msg *MsgRevokeIdentity) ValidateBasic() error {
+    return validateAuthorityAndAddress(msg.Authority, msg.Address)
+}
+
+var _ sdk.Msg = &MsgSuspendIdentity{}
+
+func NewMsgSuspendIdentity(authority string, address string, reason string) *MsgSuspendIdentity {
+    return &MsgSuspendIdentity{
+        Authority: authority,
+        Address:   address,
+        Reason:    reason,
+    }
+}
+
+func (msg *MsgSuspendIdentity) ValidateBasic() error {
+    return validateAuthorityAndAddress(msg.Authority, msg.Address)
+}
+
+var _ sdk.Msg = &MsgReinstateIdentity{}
+
+func NewMsgReinstateIdentity(authority string, address string) *MsgReinstateIdentity {
+    return &MsgReinstateIdentity{
+        Authority: authority,
+        Address:   address,
+    }
+}
+
+func (msg *MsgReinstateIdentity) ValidateBasic() error {
+    return validateAuthorityAndAddress(msg.Authority, msg.Address)
+}
+
+var _ sdk.Msg = &MsgAttestIdentity{}
+
+func NewMsgAttestIdentity(verifier string, address string, idHash string) *MsgAttestIdentity {
+    return &MsgAttestIdentity{
+        Verifier: verifier,
+        Address:  address,
+        IdHash:   idHash,
+    }
+}
+
+func (msg *MsgAttestIdentity) ValidateBasic() error {
+    _, err := sdk.AccAddressFromBech32(msg.Verifier)
+    if err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+    }
+
+    if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid identity address (%s)", err)
+    }
+
+    if msg.Verifier == msg.Address {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "verifier cannot attest its own identity")
+    }
+
+    if err := ValidateCommitment(msg.IdHash); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+    }
+
+    if err := validateIdentityLevel(msg.Level); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+    }
+
+    if msg.CccdTag != "" {
+        if err := ValidateCommitment(msg.CccdTag); err != nil {
+            return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cccdTag: %s", err)
+        }
+    }
+
+    for _, idHash := range msg.LegacyIdHashes {
+        if err := ValidateCommitment(idHash); err != nil {
+            return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "legacyIdHashes: %s", err)
+        }
+    }
+
+    return nil
+}
+
+var _ sdk.Msg = &MsgSetIdentityLevel{}
+
+func NewMsgSetIdentityLevel(verifier string, address string, level IdentityLevel) *MsgSetIdentityLevel {
+    return &MsgSetIdentityLevel{
+        Verifier: verifier,
+        Address:  address,
+        Level:    level,
+    }
+}
+
+func (msg *MsgSetIdentityLevel) ValidateBasic() error {
+    if _, err := sdk.AccAddressFromBech32(msg.Verifier); err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid verifier address (%s)", err)
+    }
+
+    if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid identity address (%s)", err)
+    }
+
+    if msg.Verifier == msg.Address {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "verifier cannot set the level of its own identity")
+    }
+
+    if err := validateIdentityLevel(msg.Level); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
     }
-    
+
+    if msg.Level == IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "verifiers cannot lower an identity to self-declared")
+    }
+
+    return nil
+}
+
+// validateAuthorityAndAddress checks the signer and target of an
+// authority-gated lifecycle message.
+func validateAuthorityAndAddress(authority string, address string) error {
+    if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+        return errorsmod.Wrap(err, "invalid authority address")
+    }
+
+    if _, err := sdk.AccAddressFromBech32(address); err != nil {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid identity address (%s)", err)
+    }
+
     return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"Nexelra/testutil/sample"
@@ -22,10 +23,33 @@ func TestMsgCreateIdentity_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
-			name: "valid address",
+			name: "empty commitment",
 			msg: MsgCreateIdentity{
 				Creator: sample.AccAddress(),
 			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "malformed commitment",
+			msg: MsgCreateIdentity{
+				Creator:    sample.AccAddress(),
+				Commitment: strings.Repeat("z", CommitmentLength),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown hash scheme",
+			msg: MsgCreateIdentity{
+				Creator:    sample.AccAddress(),
+				Commitment: strings.Repeat("a", CommitmentLength),
+				HashScheme: HashScheme(99),
+			},
+			err: ErrUnsupportedHashScheme,
+		}, {
+			name: "valid address",
+			msg: MsgCreateIdentity{
+				Creator:    sample.AccAddress(),
+				Commitment: strings.Repeat("a", CommitmentLength),
+				HashScheme: HashScheme_HASH_SCHEME_HMAC_SHA256,
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -53,40 +77,18 @@ func TestMsgUpdateIdentity_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
-			name: "valid address",
+			name: "malformed commitment",
 			msg: MsgUpdateIdentity{
-				Creator: sample.AccAddress(),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := tt.msg.ValidateBasic()
-			if tt.err != nil {
-				require.ErrorIs(t, err, tt.err)
-				return
-			}
-			require.NoError(t, err)
-		})
-	}
-}
-
-func TestMsgDeleteIdentity_ValidateBasic(t *testing.T) {
-	tests := []struct {
-		name string
-		msg  MsgDeleteIdentity
-		err  error
-	}{
-		{
-			name: "invalid address",
-			msg: MsgDeleteIdentity{
-				Creator: "invalid_address",
+				Creator:    sample.AccAddress(),
+				Commitment: "abc",
 			},
-			err: sdkerrors.ErrInvalidAddress,
+			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid address",
-			msg: MsgDeleteIdentity{
-				Creator: sample.AccAddress(),
+			msg: MsgUpdateIdentity{
+				Creator:    sample.AccAddress(),
+				Commitment: strings.Repeat("a", CommitmentLength),
+				HashScheme: HashScheme_HASH_SCHEME_HMAC_SHA256,
 			},
 		},
 	}
@@ -101,3 +103,55 @@ func TestMsgDeleteIdentity_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRevokeIdentity_ValidateBasic(t *testing.T) {
+	authority := sample.AccAddress()
+
+	require.Error(t, NewMsgRevokeIdentity("invalid_address", sample.AccAddress(), "").ValidateBasic())
+	require.ErrorIs(t, NewMsgRevokeIdentity(authority, "invalid_address", "").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgRevokeIdentity(authority, sample.AccAddress(), "forged document").ValidateBasic())
+}
+
+func TestMsgAttestIdentity_ValidateBasic(t *testing.T) {
+	verifier := sample.AccAddress()
+	holder := sample.AccAddress()
+	idHash := strings.Repeat("a", CommitmentLength)
+
+	require.ErrorIs(t, NewMsgAttestIdentity("invalid_address", holder, idHash).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgAttestIdentity(verifier, "invalid_address", idHash).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgAttestIdentity(verifier, verifier, idHash).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgAttestIdentity(verifier, holder, "abc").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgAttestIdentity(verifier, holder, idHash).ValidateBasic())
+
+	enhanced := NewMsgAttestIdentity(verifier, holder, idHash)
+	enhanced.Level = IdentityLevel_IDENTITY_LEVEL_ENHANCED
+	require.NoError(t, enhanced.ValidateBasic())
+	enhanced.Level = IdentityLevel(99)
+	require.ErrorIs(t, enhanced.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	tagged := NewMsgAttestIdentity(verifier, holder, idHash)
+	tagged.CccdTag = strings.Repeat("b", CommitmentLength)
+	require.NoError(t, tagged.ValidateBasic())
+	tagged.CccdTag = "abc"
+	require.ErrorIs(t, tagged.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	legacy := NewMsgAttestIdentity(verifier, holder, idHash)
+	legacy.LegacyIdHashes = LegacyCommitments(DefaultPepper, "001099012345")
+	require.NoError(t, legacy.ValidateBasic())
+	legacy.LegacyIdHashes = append(legacy.LegacyIdHashes, "abc")
+	require.ErrorIs(t, legacy.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
+
+func TestMsgSetIdentityLevel_ValidateBasic(t *testing.T) {
+	verifier := sample.AccAddress()
+	holder := sample.AccAddress()
+	enhanced := IdentityLevel_IDENTITY_LEVEL_ENHANCED
+
+	require.ErrorIs(t, NewMsgSetIdentityLevel("invalid_address", holder, enhanced).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgSetIdentityLevel(verifier, "invalid_address", enhanced).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgSetIdentityLevel(verifier, verifier, enhanced).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgSetIdentityLevel(verifier, holder, IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgSetIdentityLevel(verifier, holder, IdentityLevel(99)).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgSetIdentityLevel(verifier, holder, enhanced).ValidateBasic())
+	require.NoError(t, NewMsgSetIdentityLevel(verifier, holder, IdentityLevel_IDENTITY_LEVEL_VERIFIED).ValidateBasic())
+}
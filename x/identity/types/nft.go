@@ -0,0 +1,10 @@
+package types
+
+const (
+	// NFTClassID is the id of the x/nft class of identity NFTs.
+	NFTClassID = "identity"
+
+	NFTClassName        = "Nexelra identity"
+	NFTClassSymbol      = "NXID"
+	NFTClassDescription = "Soulbound proof that the holder has a verified identity on Nexelra"
+)
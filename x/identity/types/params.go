@@ -1,32 +1,214 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyPepper           = []byte("Pepper")
+	KeyHashScheme       = []byte("HashScheme")
+	KeyVerifiers        = []byte("Verifiers")
+	KeyGatingPolicy     = []byte("GatingPolicy")
+	KeyIbcReceivePolicy = []byte("IbcReceivePolicy")
+	KeySpendPolicy      = []byte("SpendPolicy")
+	KeyValidatorLevel   = []byte("ValidatorLevel")
+)
+
+const (
+	// DefaultPepper is the pepper of a freshly initialized chain. Networks are
+	// expected to replace it through governance before opening registrations.
+	DefaultPepper = "nexelra-identity"
+
+	// DefaultHashScheme is the scheme new registrations use by default.
+	DefaultHashScheme = HashScheme_HASH_SCHEME_SALTED_HMAC_SHA256
+
+	// DefaultValidatorLevel lets any active identity operate a validator.
+	DefaultValidatorLevel = IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+)
+
+// IsVerifier reports whether address is a registered verifier
+func (p Params) IsVerifier(address string) bool {
+	for _, verifier := range p.Verifiers {
+		if verifier == address {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	pepper string,
+	hashScheme HashScheme,
+	verifiers []string,
+	gatingPolicy GatingPolicy,
+	ibcReceivePolicy IbcReceivePolicy,
+	validatorLevel IdentityLevel,
+	spendPolicy SpendPolicy,
+) Params {
+	return Params{
+		Pepper:           pepper,
+		HashScheme:       hashScheme,
+		Verifiers:        verifiers,
+		GatingPolicy:     gatingPolicy,
+		IbcReceivePolicy: ibcReceivePolicy,
+		ValidatorLevel:   validatorLevel,
+		SpendPolicy:      spendPolicy,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultPepper,
+		DefaultHashScheme,
+		nil,
+		DefaultGatingPolicy(),
+		DefaultIbcReceivePolicy(),
+		DefaultValidatorLevel,
+		DefaultSpendPolicy(),
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPepper, &p.Pepper, validatePepper),
+		paramtypes.NewParamSetPair(KeyHashScheme, &p.HashScheme, validateHashScheme),
+		paramtypes.NewParamSetPair(KeyVerifiers, &p.Verifiers, validateVerifiers),
+		paramtypes.NewParamSetPair(KeyGatingPolicy, &p.GatingPolicy, validateGatingPolicy),
+		paramtypes.NewParamSetPair(KeyIbcReceivePolicy, &p.IbcReceivePolicy, validateIbcReceivePolicy),
+		paramtypes.NewParamSetPair(KeyValidatorLevel, &p.ValidatorLevel, validateValidatorLevel),
+		paramtypes.NewParamSetPair(KeySpendPolicy, &p.SpendPolicy, validateSpendPolicy),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validatePepper(p.Pepper); err != nil {
+		return err
+	}
+	if err := validateHashScheme(p.HashScheme); err != nil {
+		return err
+	}
+	if err := validateVerifiers(p.Verifiers); err != nil {
+		return err
+	}
+	if err := validateGatingPolicy(p.GatingPolicy); err != nil {
+		return err
+	}
+	if err := validateIbcReceivePolicy(p.IbcReceivePolicy); err != nil {
+		return err
+	}
+	if err := validateValidatorLevel(p.ValidatorLevel); err != nil {
+		return err
+	}
+	if err := validateSpendPolicy(p.SpendPolicy); err != nil {
+		return err
+	}
+	if p.HashScheme != HashScheme_HASH_SCHEME_SHA256 && p.Pepper == "" {
+		return fmt.Errorf("pepper is required for hash scheme %s", p.HashScheme)
+	}
+
+	return nil
+}
+
+// validatePepper validates the Pepper param
+func validatePepper(v interface{}) error {
+	pepper, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if len(pepper) > 256 {
+		return fmt.Errorf("pepper too long: %d > 256", len(pepper))
+	}
+
+	return nil
+}
+
+// validateHashScheme validates the HashScheme param
+func validateHashScheme(v interface{}) error {
+	hashScheme, ok := v.(HashScheme)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := HashScheme_name[int32(hashScheme)]; !ok {
+		return fmt.Errorf("unknown hash scheme: %d", hashScheme)
+	}
+
+	return nil
+}
+
+// validateVerifiers validates the Verifiers param
+func validateVerifiers(v interface{}) error {
+	verifiers, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(verifiers))
+	for _, verifier := range verifiers {
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return fmt.Errorf("invalid verifier address %q: %w", verifier, err)
+		}
+		if seen[verifier] {
+			return fmt.Errorf("duplicate verifier: %s", verifier)
+		}
+		seen[verifier] = true
+	}
+
 	return nil
 }
+
+// validateGatingPolicy validates the GatingPolicy param
+func validateGatingPolicy(v interface{}) error {
+	gatingPolicy, ok := v.(GatingPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return gatingPolicy.Validate()
+}
+
+// validateIbcReceivePolicy validates the IbcReceivePolicy param
+func validateIbcReceivePolicy(v interface{}) error {
+	ibcReceivePolicy, ok := v.(IbcReceivePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return ibcReceivePolicy.Validate()
+}
+
+// validateValidatorLevel validates the ValidatorLevel param
+func validateValidatorLevel(v interface{}) error {
+	level, ok := v.(IdentityLevel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return validateIdentityLevel(level)
+}
+
+// validateSpendPolicy validates the SpendPolicy param
+func validateSpendPolicy(v interface{}) error {
+	spendPolicy, ok := v.(SpendPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return spendPolicy.Validate()
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,8 +27,93 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// GatingRule is the identity check applied to a message.
+type GatingRule int32
+
+const (
+	// GATING_RULE_SIGNER_AND_RECIPIENT requires an active identity for every
+	// signer and every recipient of the message.
+	GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT GatingRule = 0
+	// GATING_RULE_SIGNER_ONLY requires an active identity for every signer.
+	GatingRule_GATING_RULE_SIGNER_ONLY GatingRule = 1
+	// GATING_RULE_EXEMPT skips the identity check.
+	GatingRule_GATING_RULE_EXEMPT GatingRule = 2
+)
+
+var GatingRule_name = map[int32]string{
+	0: "GATING_RULE_SIGNER_AND_RECIPIENT",
+	1: "GATING_RULE_SIGNER_ONLY",
+	2: "GATING_RULE_EXEMPT",
+}
+
+var GatingRule_value = map[string]int32{
+	"GATING_RULE_SIGNER_AND_RECIPIENT": 0,
+	"GATING_RULE_SIGNER_ONLY":          1,
+	"GATING_RULE_EXEMPT":               2,
+}
+
+func (x GatingRule) String() string {
+	return proto.EnumName(GatingRule_name, int32(x))
+}
+
+func (GatingRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{0}
+}
+
+// IbcReceiveRule is the identity check applied to incoming ICS-20 transfers.
+type IbcReceiveRule int32
+
+const (
+	// IBC_RECEIVE_RULE_REQUIRE_IDENTITY acknowledges transfers to receivers
+	// without an active identity with an error, refunding the sender.
+	IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY IbcReceiveRule = 0
+	// IBC_RECEIVE_RULE_ALLOW accepts transfers to any receiver.
+	IbcReceiveRule_IBC_RECEIVE_RULE_ALLOW IbcReceiveRule = 1
+)
+
+var IbcReceiveRule_name = map[int32]string{
+	0: "IBC_RECEIVE_RULE_REQUIRE_IDENTITY",
+	1: "IBC_RECEIVE_RULE_ALLOW",
+}
+
+var IbcReceiveRule_value = map[string]int32{
+	"IBC_RECEIVE_RULE_REQUIRE_IDENTITY": 0,
+	"IBC_RECEIVE_RULE_ALLOW":            1,
+}
+
+func (x IbcReceiveRule) String() string {
+	return proto.EnumName(IbcReceiveRule_name, int32(x))
+}
+
+func (IbcReceiveRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{1}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// pepper is the chain-wide HMAC key clients use to derive CCCD commitments.
+	// It is public, the salt of salted commitments is what hides the CCCD ID.
+	// Unsalted commitments made under a previous pepper do not collide with new
+	// ones, so rotating it weakens the one-CCCD-one-address guarantee for
+	// their records.
+	Pepper string `protobuf:"bytes,1,opt,name=pepper,proto3" json:"pepper,omitempty"`
+	// hashScheme is the scheme new registrations must use.
+	HashScheme HashScheme `protobuf:"varint,2,opt,name=hashScheme,proto3,enum=nexelra.identity.HashScheme" json:"hashScheme,omitempty"`
+	// verifiers are the KYC providers allowed to attest identities. Removing a
+	// verifier does not undo attestations it already made.
+	Verifiers []string `protobuf:"bytes,3,rep,name=verifiers,proto3" json:"verifiers,omitempty"`
+	// gatingPolicy decides which transactions the ante handler's identity gate
+	// applies to.
+	GatingPolicy GatingPolicy `protobuf:"bytes,4,opt,name=gatingPolicy,proto3" json:"gatingPolicy"`
+	// ibcReceivePolicy decides which incoming ICS-20 transfers need a receiver
+	// with an active identity.
+	IbcReceivePolicy IbcReceivePolicy `protobuf:"bytes,5,opt,name=ibcReceivePolicy,proto3" json:"ibcReceivePolicy"`
+	// validatorLevel is the lowest level the active identity of a validator
+	// operator may have, checked when the validator is created or edited.
+	ValidatorLevel IdentityLevel `protobuf:"varint,6,opt,name=validatorLevel,proto3,enum=nexelra.identity.IdentityLevel" json:"validatorLevel,omitempty"`
+	// spendPolicy caps what each identity sends over a rolling window, across
+	// all the addresses it controls.
+	SpendPolicy SpendPolicy `protobuf:"bytes,7,opt,name=spendPolicy,proto3" json:"spendPolicy"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,125 +149,2873 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "nexelra.identity.Params")
+func (m *Params) GetPepper() string {
+	if m != nil {
+		return m.Pepper
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("nexelra/identity/params.proto", fileDescriptor_46d5373956aa67be) }
+func (m *Params) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_SHA256
+}
 
-var fileDescriptor_46d5373956aa67be = []byte{
-	// 154 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4b, 0xad, 0x48,
-	0xcd, 0x29, 0x4a, 0xd4, 0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x74, 0xb8, 0xd8, 0x02, 0xc0, 0x46, 0x59,
-	0x29, 0xbd, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x24, 0xcc, 0xb2, 0x0a, 0x84, 0x75,
-	0x10, 0x35, 0x4e, 0x46, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0xe1,
-	0x87, 0xa9, 0xa9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0x91, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0xa9, 0x0d, 0x06, 0xc7, 0xc4, 0x00, 0x00, 0x00,
+func (m *Params) GetVerifiers() []string {
+	if m != nil {
+		return m.Verifiers
+	}
+	return nil
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetGatingPolicy() GatingPolicy {
+	if m != nil {
+		return m.GatingPolicy
 	}
+	return GatingPolicy{}
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Params) GetIbcReceivePolicy() IbcReceivePolicy {
+	if m != nil {
+		return m.IbcReceivePolicy
+	}
+	return IbcReceivePolicy{}
+}
+
+func (m *Params) GetValidatorLevel() IdentityLevel {
+	if m != nil {
+		return m.ValidatorLevel
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (m *Params) GetSpendPolicy() SpendPolicy {
+	if m != nil {
+		return m.SpendPolicy
+	}
+	return SpendPolicy{}
+}
+
+// MsgGatingRule overrides the default rule for one message type.
+type MsgGatingRule struct {
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string     `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	Rule       GatingRule `protobuf:"varint,2,opt,name=rule,proto3,enum=nexelra.identity.GatingRule" json:"rule,omitempty"`
+}
+
+func (m *MsgGatingRule) Reset()         { *m = MsgGatingRule{} }
+func (m *MsgGatingRule) String() string { return proto.CompactTextString(m) }
+func (*MsgGatingRule) ProtoMessage()    {}
+func (*MsgGatingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{1}
+}
+func (m *MsgGatingRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGatingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGatingRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MsgGatingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGatingRule.Merge(m, src)
+}
+func (m *MsgGatingRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGatingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGatingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGatingRule proto.InternalMessageInfo
+
+func (m *MsgGatingRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *MsgGatingRule) GetRule() GatingRule {
+	if m != nil {
+		return m.Rule
 	}
-	return dAtA[:n], nil
+	return GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GatingPolicy is the governance-controlled configuration of the ante
+// handler's identity gate.
+type GatingPolicy struct {
+	// defaultRule applies to messages without an entry in msgRules.
+	DefaultRule GatingRule      `protobuf:"varint,1,opt,name=defaultRule,proto3,enum=nexelra.identity.GatingRule" json:"defaultRule,omitempty"`
+	MsgRules    []MsgGatingRule `protobuf:"bytes,2,rep,name=msgRules,proto3" json:"msgRules"`
+	// exemptModuleAccounts skips the identity check for the app's module
+	// accounts, as listed by BlockedAddresses().
+	ExemptModuleAccounts bool `protobuf:"varint,3,opt,name=exemptModuleAccounts,proto3" json:"exemptModuleAccounts,omitempty"`
+	// exemptAddresses are further addresses that never need an identity.
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exemptAddresses,proto3" json:"exemptAddresses,omitempty"`
+	// attributeRules require the signers of some messages to hold attribute
+	// flags on top of an active identity.
+	AttributeRules []MsgAttributeRule `protobuf:"bytes,5,rep,name=attributeRules,proto3" json:"attributeRules"`
+	// levelRules require the signers of some messages to hold an identity of
+	// a minimum level.
+	LevelRules []MsgLevelRule `protobuf:"bytes,6,rep,name=levelRules,proto3" json:"levelRules"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GatingPolicy) Reset()         { *m = GatingPolicy{} }
+func (m *GatingPolicy) String() string { return proto.CompactTextString(m) }
+func (*GatingPolicy) ProtoMessage()    {}
+func (*GatingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{2}
+}
+func (m *GatingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatingPolicy.Merge(m, src)
+}
+func (m *GatingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatingPolicy.DiscardUnknown(m)
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_GatingPolicy proto.InternalMessageInfo
+
+func (m *GatingPolicy) GetDefaultRule() GatingRule {
+	if m != nil {
+		return m.DefaultRule
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GatingPolicy) GetMsgRules() []MsgGatingRule {
+	if m != nil {
+		return m.MsgRules
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GatingPolicy) GetExemptModuleAccounts() bool {
+	if m != nil {
+		return m.ExemptModuleAccounts
+	}
+	return false
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *GatingPolicy) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *GatingPolicy) GetAttributeRules() []MsgAttributeRule {
+	if m != nil {
+		return m.AttributeRules
+	}
+	return nil
+}
+
+func (m *GatingPolicy) GetLevelRules() []MsgLevelRule {
+	if m != nil {
+		return m.LevelRules
+	}
+	return nil
+}
+
+// MsgLevelRule is the lowest identity level the signers of a message type
+// may have. Exempt messages and exempt signers are not checked.
+type MsgLevelRule struct {
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string        `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	MinLevel   IdentityLevel `protobuf:"varint,2,opt,name=minLevel,proto3,enum=nexelra.identity.IdentityLevel" json:"minLevel,omitempty"`
+}
+
+func (m *MsgLevelRule) Reset()         { *m = MsgLevelRule{} }
+func (m *MsgLevelRule) String() string { return proto.CompactTextString(m) }
+func (*MsgLevelRule) ProtoMessage()    {}
+func (*MsgLevelRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{3}
+}
+func (m *MsgLevelRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLevelRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLevelRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		return b[:n], nil
+	}
+}
+func (m *MsgLevelRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLevelRule.Merge(m, src)
+}
+func (m *MsgLevelRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLevelRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLevelRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLevelRule proto.InternalMessageInfo
+
+func (m *MsgLevelRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgLevelRule) GetMinLevel() IdentityLevel {
+	if m != nil {
+		return m.MinLevel
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+// SpendPolicy is the governance-controlled configuration of the per-identity
+// spend limits. The ante handler adds up the bank and ICS-20 transfers each
+// identity sent over the last window seconds, keyed by its commitment, and
+// rejects transactions that would take it over the limit of its level, or
+// that send more than the per-transaction cap of its level on their own.
+type SpendPolicy struct {
+	// window is the length (seconds) of the rolling window, one day by
+	// default. It may only be zero while there are no limits.
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// limits are the limits of each identity level. Levels without an entry
+	// are not limited.
+	Limits []LevelSpendLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+}
+
+func (m *SpendPolicy) Reset()         { *m = SpendPolicy{} }
+func (m *SpendPolicy) String() string { return proto.CompactTextString(m) }
+func (*SpendPolicy) ProtoMessage()    {}
+func (*SpendPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{4}
+}
+func (m *SpendPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		return b[:n], nil
+	}
+}
+func (m *SpendPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendPolicy.Merge(m, src)
+}
+func (m *SpendPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendPolicy proto.InternalMessageInfo
+
+func (m *SpendPolicy) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *SpendPolicy) GetLimits() []LevelSpendLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// LevelSpendLimit caps what the identities of a level may send within the
+// window of the spend policy and in a single transaction.
+type LevelSpendLimit struct {
+	Level IdentityLevel `protobuf:"varint,1,opt,name=level,proto3,enum=nexelra.identity.IdentityLevel" json:"level,omitempty"`
+	// maxAmount caps the funds sent, per denom. Denoms missing from it are not
+	// limited.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxAmount"`
+	// maxTxCount caps the transactions sending funds, zero for no cap.
+	MaxTxCount uint64 `protobuf:"varint,3,opt,name=maxTxCount,proto3" json:"maxTxCount,omitempty"`
+	// maxTxAmount caps the funds one transaction sends, per denom. Denoms
+	// missing from it are not limited. Unlike the window limits it also holds
+	// senders without an identity, to the SELF_DECLARED cap.
+	MaxTxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=maxTxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxTxAmount"`
+}
+
+func (m *LevelSpendLimit) Reset()         { *m = LevelSpendLimit{} }
+func (m *LevelSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*LevelSpendLimit) ProtoMessage()    {}
+func (*LevelSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{5}
+}
+func (m *LevelSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LevelSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LevelSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
+		return b[:n], nil
+	}
+}
+func (m *LevelSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LevelSpendLimit.Merge(m, src)
+}
+func (m *LevelSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LevelSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LevelSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LevelSpendLimit proto.InternalMessageInfo
+
+func (m *LevelSpendLimit) GetLevel() IdentityLevel {
+	if m != nil {
+		return m.Level
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (m *LevelSpendLimit) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+func (m *LevelSpendLimit) GetMaxTxCount() uint64 {
+	if m != nil {
+		return m.MaxTxCount
+	}
+	return 0
+}
+
+func (m *LevelSpendLimit) GetMaxTxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxTxAmount
+	}
+	return nil
+}
+
+// MsgAttributeRule lists the attributes the signers of a message type must
+// have proven. Exempt messages and exempt signers are not checked.
+type MsgAttributeRule struct {
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string   `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+	Attributes []string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *MsgAttributeRule) Reset()         { *m = MsgAttributeRule{} }
+func (m *MsgAttributeRule) String() string { return proto.CompactTextString(m) }
+func (*MsgAttributeRule) ProtoMessage()    {}
+func (*MsgAttributeRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{6}
+}
+func (m *MsgAttributeRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttributeRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttributeRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttributeRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttributeRule.Merge(m, src)
+}
+func (m *MsgAttributeRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttributeRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttributeRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttributeRule proto.InternalMessageInfo
+
+func (m *MsgAttributeRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgAttributeRule) GetAttributes() []string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// ChannelReceiveRule overrides the default rule for one channel.
+type ChannelReceiveRule struct {
+	// channelId is the channel on this chain the packets arrive on.
+	ChannelId string         `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Rule      IbcReceiveRule `protobuf:"varint,2,opt,name=rule,proto3,enum=nexelra.identity.IbcReceiveRule" json:"rule,omitempty"`
+}
+
+func (m *ChannelReceiveRule) Reset()         { *m = ChannelReceiveRule{} }
+func (m *ChannelReceiveRule) String() string { return proto.CompactTextString(m) }
+func (*ChannelReceiveRule) ProtoMessage()    {}
+func (*ChannelReceiveRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{7}
+}
+func (m *ChannelReceiveRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelReceiveRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelReceiveRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelReceiveRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelReceiveRule.Merge(m, src)
+}
+func (m *ChannelReceiveRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelReceiveRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelReceiveRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelReceiveRule proto.InternalMessageInfo
+
+func (m *ChannelReceiveRule) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelReceiveRule) GetRule() IbcReceiveRule {
+	if m != nil {
+		return m.Rule
+	}
+	return IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY
+}
+
+// IbcReceivePolicy is the governance-controlled configuration of the ICS-20
+// identity middleware.
+type IbcReceivePolicy struct {
+	// defaultRule applies to channels without an entry in channelRules.
+	DefaultRule  IbcReceiveRule       `protobuf:"varint,1,opt,name=defaultRule,proto3,enum=nexelra.identity.IbcReceiveRule" json:"defaultRule,omitempty"`
+	ChannelRules []ChannelReceiveRule `protobuf:"bytes,2,rep,name=channelRules,proto3" json:"channelRules"`
+}
+
+func (m *IbcReceivePolicy) Reset()         { *m = IbcReceivePolicy{} }
+func (m *IbcReceivePolicy) String() string { return proto.CompactTextString(m) }
+func (*IbcReceivePolicy) ProtoMessage()    {}
+func (*IbcReceivePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46d5373956aa67be, []int{8}
+}
+func (m *IbcReceivePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcReceivePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcReceivePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcReceivePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcReceivePolicy.Merge(m, src)
+}
+func (m *IbcReceivePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcReceivePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcReceivePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcReceivePolicy proto.InternalMessageInfo
+
+func (m *IbcReceivePolicy) GetDefaultRule() IbcReceiveRule {
+	if m != nil {
+		return m.DefaultRule
+	}
+	return IbcReceiveRule_IBC_RECEIVE_RULE_REQUIRE_IDENTITY
+}
+
+func (m *IbcReceivePolicy) GetChannelRules() []ChannelReceiveRule {
+	if m != nil {
+		return m.ChannelRules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("nexelra.identity.GatingRule", GatingRule_name, GatingRule_value)
+	proto.RegisterEnum("nexelra.identity.IbcReceiveRule", IbcReceiveRule_name, IbcReceiveRule_value)
+	proto.RegisterType((*Params)(nil), "nexelra.identity.Params")
+	proto.RegisterType((*MsgGatingRule)(nil), "nexelra.identity.MsgGatingRule")
+	proto.RegisterType((*GatingPolicy)(nil), "nexelra.identity.GatingPolicy")
+	proto.RegisterType((*MsgLevelRule)(nil), "nexelra.identity.MsgLevelRule")
+	proto.RegisterType((*SpendPolicy)(nil), "nexelra.identity.SpendPolicy")
+	proto.RegisterType((*LevelSpendLimit)(nil), "nexelra.identity.LevelSpendLimit")
+	proto.RegisterType((*MsgAttributeRule)(nil), "nexelra.identity.MsgAttributeRule")
+	proto.RegisterType((*ChannelReceiveRule)(nil), "nexelra.identity.ChannelReceiveRule")
+	proto.RegisterType((*IbcReceivePolicy)(nil), "nexelra.identity.IbcReceivePolicy")
+}
+
+func init() { proto.RegisterFile("nexelra/identity/params.proto", fileDescriptor_46d5373956aa67be) }
+
+var fileDescriptor_46d5373956aa67be = []byte{
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0x22, 0x45,
+	0x14, 0xa7, 0x81, 0xc1, 0xe1, 0x31, 0xce, 0x62, 0x65, 0x32, 0xf6, 0x8c, 0xbb, 0x0d, 0x4b, 0xd6,
+	0x84, 0x4c, 0xb2, 0xe0, 0x8e, 0xae, 0x87, 0xd5, 0x68, 0x80, 0xe9, 0x60, 0x1b, 0x60, 0xb1, 0x61,
+	0x74, 0xc7, 0x0b, 0x69, 0xba, 0x6b, 0x9b, 0x8a, 0xfd, 0xcf, 0xae, 0x86, 0x65, 0xbe, 0x82, 0x27,
+	0x3f, 0x82, 0x37, 0x8d, 0xf1, 0xb0, 0x07, 0x3f, 0xc4, 0x1e, 0x37, 0x7b, 0xf2, 0xa4, 0x66, 0xe6,
+	0xb0, 0x7e, 0x05, 0x6f, 0xa6, 0xab, 0x8b, 0xa1, 0xa1, 0xc9, 0xce, 0x5c, 0xbc, 0x40, 0xd7, 0xab,
+	0xdf, 0xfb, 0xbd, 0xf7, 0xaa, 0x7e, 0xfd, 0x03, 0xb8, 0xe3, 0xe0, 0x39, 0xb6, 0x7c, 0xad, 0x4e,
+	0x0c, 0xec, 0x04, 0x24, 0x38, 0xaf, 0x7b, 0x9a, 0xaf, 0xd9, 0xb4, 0xe6, 0xf9, 0x6e, 0xe0, 0xa2,
+	0x22, 0xdf, 0xae, 0x2d, 0xb6, 0x0f, 0xdf, 0xd1, 0x6c, 0xe2, 0xb8, 0x75, 0xf6, 0x19, 0x81, 0x0e,
+	0x25, 0xdd, 0xa5, 0xb6, 0x4b, 0xeb, 0x63, 0x8d, 0xe2, 0xfa, 0xec, 0xc1, 0x18, 0x07, 0xda, 0x83,
+	0xba, 0xee, 0x12, 0x87, 0xef, 0x1f, 0x44, 0xfb, 0x23, 0xb6, 0xaa, 0x47, 0x0b, 0xbe, 0xb5, 0x67,
+	0xba, 0xa6, 0x1b, 0xc5, 0xc3, 0x27, 0x1e, 0x2d, 0x25, 0x9a, 0x5a, 0x3c, 0x44, 0x80, 0xca, 0xbf,
+	0x19, 0xc8, 0xf5, 0x59, 0x9f, 0x68, 0x1f, 0x72, 0x1e, 0xf6, 0x3c, 0xec, 0x8b, 0x42, 0x59, 0xa8,
+	0xe6, 0x55, 0xbe, 0x42, 0x9f, 0x02, 0x4c, 0x34, 0x3a, 0x19, 0xe8, 0x13, 0x6c, 0x63, 0x31, 0x5d,
+	0x16, 0xaa, 0xbb, 0xc7, 0xb7, 0x6b, 0xeb, 0xe3, 0xd4, 0xbe, 0xb8, 0xc2, 0xa8, 0x31, 0x3c, 0xfa,
+	0x18, 0xf2, 0x33, 0xec, 0x93, 0xa7, 0x04, 0xfb, 0x54, 0xcc, 0x94, 0x33, 0xd5, 0x7c, 0x53, 0x7c,
+	0xf5, 0xfb, 0xfd, 0x3d, 0xde, 0x7c, 0xc3, 0x30, 0x7c, 0x4c, 0xe9, 0x20, 0xf0, 0x89, 0x63, 0xaa,
+	0x4b, 0x28, 0xea, 0xc2, 0x8e, 0xa9, 0x05, 0xc4, 0x31, 0xfb, 0xae, 0x45, 0xf4, 0x73, 0x31, 0x5b,
+	0x16, 0xaa, 0x85, 0x63, 0x29, 0x59, 0xb7, 0x1d, 0x43, 0x35, 0xf3, 0x2f, 0xfe, 0x2c, 0xa5, 0x7e,
+	0x79, 0xfd, 0xfc, 0x48, 0x50, 0x57, 0xd2, 0xd1, 0x19, 0x14, 0xc9, 0x58, 0x57, 0xb1, 0x8e, 0xc9,
+	0x0c, 0x73, 0xca, 0x2d, 0x46, 0x59, 0x49, 0x52, 0x2a, 0x6b, 0xc8, 0x38, 0x6d, 0x82, 0x06, 0xb5,
+	0x61, 0x77, 0xa6, 0x59, 0xc4, 0xd0, 0x02, 0xd7, 0xef, 0xe0, 0x19, 0xb6, 0xc4, 0x1c, 0x3b, 0xa3,
+	0xd2, 0x06, 0x62, 0xfe, 0xc0, 0x60, 0xea, 0x5a, 0x1a, 0xfa, 0x12, 0x0a, 0xd4, 0xc3, 0x8e, 0xc1,
+	0xdb, 0x7b, 0x8b, 0xb5, 0x77, 0x27, 0xc9, 0x32, 0x58, 0x82, 0xe2, 0x9d, 0xc5, 0x93, 0x1f, 0x55,
+	0xfe, 0xf9, 0xa9, 0x24, 0xfc, 0xf0, 0xfa, 0xf9, 0xd1, 0xc1, 0x42, 0x01, 0xf3, 0xa5, 0x06, 0xa2,
+	0x0b, 0xaf, 0x98, 0xf0, 0x76, 0x97, 0x9a, 0xd1, 0xf9, 0xa9, 0x53, 0x0b, 0x23, 0x09, 0xc0, 0xa6,
+	0xe6, 0xf0, 0xdc, 0xc3, 0xa7, 0xbe, 0xc5, 0x55, 0x10, 0x8b, 0xa0, 0x0f, 0x20, 0xeb, 0x4f, 0xad,
+	0x37, 0x68, 0x60, 0xc9, 0xa5, 0x32, 0xe4, 0xa3, 0x6c, 0xd8, 0x46, 0xe5, 0xe7, 0x0c, 0xec, 0xc4,
+	0xaf, 0x09, 0x7d, 0x06, 0x05, 0x03, 0x3f, 0xd5, 0xa6, 0x56, 0x10, 0x62, 0x45, 0xe1, 0x06, 0x7c,
+	0xf1, 0x04, 0xd4, 0x80, 0x6d, 0x9b, 0xb2, 0x38, 0x15, 0xd3, 0xe5, 0x4c, 0xb5, 0xb0, 0xe9, 0xb0,
+	0x57, 0x66, 0x6b, 0x66, 0xc3, 0x83, 0x52, 0xaf, 0xd2, 0xd0, 0x31, 0xec, 0xe1, 0x39, 0xb6, 0xbd,
+	0xa0, 0xeb, 0x1a, 0x53, 0x0b, 0x37, 0x74, 0xdd, 0x9d, 0x3a, 0x41, 0x28, 0x51, 0xa1, 0xba, 0xad,
+	0x6e, 0xdc, 0x43, 0x4d, 0xb8, 0x15, 0xc5, 0xb9, 0x6a, 0x31, 0x15, 0xb3, 0xd7, 0x28, 0x7a, 0x3d,
+	0x01, 0xf5, 0x61, 0x57, 0x0b, 0x02, 0x9f, 0x8c, 0xa7, 0x01, 0x8e, 0x06, 0xd8, 0x2a, 0x67, 0x36,
+	0xcb, 0xb0, 0x4b, 0xcd, 0x46, 0x1c, 0xca, 0x67, 0x58, 0xcb, 0x47, 0x27, 0x00, 0x16, 0xd3, 0x13,
+	0x63, 0xcb, 0x95, 0x33, 0x9b, 0xdf, 0x93, 0x2e, 0x35, 0x3b, 0x0b, 0x18, 0x67, 0x8a, 0xe5, 0xf1,
+	0x9b, 0xfa, 0x1e, 0x76, 0xe2, 0xb8, 0x6b, 0x15, 0xf1, 0x09, 0x6c, 0xdb, 0xc4, 0x89, 0x54, 0x9f,
+	0xbe, 0x99, 0xea, 0xaf, 0x12, 0x78, 0x49, 0x0b, 0x0a, 0x31, 0x41, 0x87, 0x2e, 0xf4, 0x8c, 0x38,
+	0x86, 0xfb, 0x8c, 0x55, 0xcb, 0xa8, 0x7c, 0x85, 0x3e, 0x87, 0x9c, 0x45, 0x6c, 0x12, 0x2c, 0x2e,
+	0xfc, 0x6e, 0xb2, 0x0e, 0x63, 0x65, 0x5c, 0x9d, 0x10, 0xc9, 0x87, 0xe4, 0x69, 0xbc, 0xda, 0xab,
+	0x34, 0xdc, 0x5a, 0xc3, 0xa1, 0x87, 0xb0, 0xc5, 0x0e, 0x42, 0x14, 0x6e, 0x36, 0x41, 0x84, 0x46,
+	0x0e, 0xe4, 0x6d, 0x6d, 0xde, 0xb0, 0x43, 0x6d, 0xf0, 0xa6, 0x0e, 0x6a, 0x5c, 0x04, 0xa1, 0x81,
+	0xd7, 0xb8, 0x81, 0xd7, 0x5a, 0x2e, 0x71, 0x9a, 0x0f, 0xc3, 0x66, 0x7e, 0xfd, 0xab, 0x54, 0x35,
+	0x49, 0x30, 0x99, 0x8e, 0x6b, 0xba, 0x6b, 0x73, 0x03, 0xe7, 0x5f, 0xf7, 0xa9, 0xf1, 0x5d, 0x3d,
+	0x38, 0xf7, 0x30, 0x65, 0x09, 0x34, 0x7a, 0xa9, 0x97, 0x25, 0xd8, 0x5d, 0x68, 0xf3, 0xe1, 0xbc,
+	0xc5, 0x0a, 0x86, 0x3a, 0xcd, 0xaa, 0xb1, 0x08, 0xf2, 0xa1, 0xc0, 0x56, 0xbc, 0xa3, 0xec, 0xff,
+	0xd4, 0x51, 0xbc, 0x08, 0x3f, 0xd4, 0x27, 0x50, 0x5c, 0xd7, 0xea, 0xb5, 0xca, 0x91, 0x00, 0xae,
+	0x74, 0x1c, 0xdd, 0x69, 0x5e, 0x8d, 0x45, 0x38, 0xb3, 0x03, 0xa8, 0x35, 0xd1, 0x1c, 0x07, 0x5b,
+	0xdc, 0x73, 0x19, 0xf7, 0x6d, 0xc8, 0xeb, 0x51, 0x54, 0x31, 0x38, 0xf5, 0x32, 0x80, 0x3e, 0x5a,
+	0x71, 0xa9, 0xf2, 0x9b, 0xec, 0x3d, 0xe1, 0x54, 0xbf, 0x09, 0x50, 0x5c, 0x77, 0x7f, 0xd4, 0xdc,
+	0xe4, 0x56, 0xd7, 0xf3, 0xae, 0x38, 0x56, 0x0f, 0x76, 0x78, 0x87, 0x71, 0xd7, 0xba, 0x97, 0x24,
+	0x49, 0x8e, 0xcb, 0x75, 0xbc, 0x92, 0x1f, 0xb5, 0x7b, 0x64, 0x02, 0xc4, 0xec, 0xfb, 0x1e, 0x94,
+	0xdb, 0x8d, 0xa1, 0xd2, 0x6b, 0x8f, 0xd4, 0xd3, 0x8e, 0x3c, 0x1a, 0x28, 0xed, 0x9e, 0xac, 0x8e,
+	0x1a, 0xbd, 0x93, 0x91, 0x2a, 0xb7, 0x94, 0xbe, 0x22, 0xf7, 0x86, 0xc5, 0x14, 0x7a, 0x0f, 0xde,
+	0xdd, 0x80, 0x7a, 0xdc, 0xeb, 0x9c, 0x15, 0x05, 0xb4, 0x0f, 0x28, 0xbe, 0x29, 0x3f, 0x91, 0xbb,
+	0xfd, 0x61, 0x31, 0x7d, 0x34, 0x80, 0xdd, 0xd5, 0xe9, 0xd0, 0xfb, 0x70, 0x57, 0x69, 0xb6, 0x42,
+	0x66, 0x59, 0xf9, 0x5a, 0x8e, 0xe0, 0xaa, 0xfc, 0xd5, 0xa9, 0xa2, 0xca, 0x23, 0xe5, 0x44, 0xee,
+	0x0d, 0x95, 0xe1, 0x59, 0x31, 0x85, 0x0e, 0x61, 0x3f, 0x01, 0x6b, 0x74, 0x3a, 0x8f, 0xbf, 0x29,
+	0x0a, 0xcd, 0xe3, 0x17, 0x17, 0x92, 0xf0, 0xf2, 0x42, 0x12, 0xfe, 0xbe, 0x90, 0x84, 0x1f, 0x2f,
+	0xa5, 0xd4, 0xcb, 0x4b, 0x29, 0xf5, 0xc7, 0xa5, 0x94, 0xfa, 0x56, 0xec, 0x25, 0x7f, 0xb4, 0x98,
+	0x10, 0xc7, 0x39, 0xf6, 0xb7, 0xe5, 0xc3, 0xff, 0x06, 0x00, 0xa7, 0x0c, 0xad, 0x8d, 0x6e, 0x09,
+	0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Pepper != that1.Pepper {
+		return false
+	}
+	if this.HashScheme != that1.HashScheme {
+		return false
+	}
+	if len(this.Verifiers) != len(that1.Verifiers) {
+		return false
+	}
+	for i := range this.Verifiers {
+		if this.Verifiers[i] != that1.Verifiers[i] {
+			return false
+		}
+	}
+	if !this.GatingPolicy.Equal(&that1.GatingPolicy) {
+		return false
+	}
+	if !this.IbcReceivePolicy.Equal(&that1.IbcReceivePolicy) {
+		return false
+	}
+	if this.ValidatorLevel != that1.ValidatorLevel {
+		return false
+	}
+	if !this.SpendPolicy.Equal(&that1.SpendPolicy) {
+		return false
+	}
+	return true
+}
+func (this *MsgGatingRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGatingRule)
+	if !ok {
+		that2, ok := that.(MsgGatingRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.Rule != that1.Rule {
+		return false
+	}
+	return true
+}
+func (this *GatingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatingPolicy)
+	if !ok {
+		that2, ok := that.(GatingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DefaultRule != that1.DefaultRule {
+		return false
+	}
+	if len(this.MsgRules) != len(that1.MsgRules) {
+		return false
+	}
+	for i := range this.MsgRules {
+		if !this.MsgRules[i].Equal(&that1.MsgRules[i]) {
+			return false
+		}
+	}
+	if this.ExemptModuleAccounts != that1.ExemptModuleAccounts {
+		return false
+	}
+	if len(this.ExemptAddresses) != len(that1.ExemptAddresses) {
+		return false
+	}
+	for i := range this.ExemptAddresses {
+		if this.ExemptAddresses[i] != that1.ExemptAddresses[i] {
+			return false
+		}
+	}
+	if len(this.AttributeRules) != len(that1.AttributeRules) {
+		return false
+	}
+	for i := range this.AttributeRules {
+		if !this.AttributeRules[i].Equal(&that1.AttributeRules[i]) {
+			return false
+		}
+	}
+	if len(this.LevelRules) != len(that1.LevelRules) {
+		return false
+	}
+	for i := range this.LevelRules {
+		if !this.LevelRules[i].Equal(&that1.LevelRules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgLevelRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgLevelRule)
+	if !ok {
+		that2, ok := that.(MsgLevelRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.MinLevel != that1.MinLevel {
+		return false
+	}
+	return true
+}
+func (this *SpendPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpendPolicy)
+	if !ok {
+		that2, ok := that.(SpendPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if len(this.Limits) != len(that1.Limits) {
+		return false
+	}
+	for i := range this.Limits {
+		if !this.Limits[i].Equal(&that1.Limits[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LevelSpendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LevelSpendLimit)
+	if !ok {
+		that2, ok := that.(LevelSpendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if len(this.MaxAmount) != len(that1.MaxAmount) {
+		return false
+	}
+	for i := range this.MaxAmount {
+		if !this.MaxAmount[i].Equal(&that1.MaxAmount[i]) {
+			return false
+		}
+	}
+	if this.MaxTxCount != that1.MaxTxCount {
+		return false
+	}
+	if len(this.MaxTxAmount) != len(that1.MaxTxAmount) {
+		return false
+	}
+	for i := range this.MaxTxAmount {
+		if !this.MaxTxAmount[i].Equal(&that1.MaxTxAmount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgAttributeRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgAttributeRule)
+	if !ok {
+		that2, ok := that.(MsgAttributeRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ChannelReceiveRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChannelReceiveRule)
+	if !ok {
+		that2, ok := that.(ChannelReceiveRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.Rule != that1.Rule {
+		return false
+	}
+	return true
+}
+func (this *IbcReceivePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IbcReceivePolicy)
+	if !ok {
+		that2, ok := that.(IbcReceivePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DefaultRule != that1.DefaultRule {
+		return false
+	}
+	if len(this.ChannelRules) != len(that1.ChannelRules) {
+		return false
+	}
+	for i := range this.ChannelRules {
+		if !this.ChannelRules[i].Equal(&that1.ChannelRules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SpendPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ValidatorLevel != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorLevel))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.IbcReceivePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.GatingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Verifiers) > 0 {
+		for iNdEx := len(m.Verifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verifiers[iNdEx])
+			copy(dAtA[i:], m.Verifiers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Verifiers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pepper) > 0 {
+		i -= len(m.Pepper)
+		copy(dAtA[i:], m.Pepper)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Pepper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGatingRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGatingRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGatingRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rule != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Rule))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LevelRules) > 0 {
+		for iNdEx := len(m.LevelRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LevelRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AttributeRules) > 0 {
+		for iNdEx := len(m.AttributeRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExemptModuleAccounts {
+		i--
+		if m.ExemptModuleAccounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgRules) > 0 {
+		for iNdEx := len(m.MsgRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultRule != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultRule))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLevelRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLevelRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLevelRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinLevel != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LevelSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LevelSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LevelSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxTxAmount) > 0 {
+		for iNdEx := len(m.MaxTxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxTxCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttributeRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttributeRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttributeRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attributes[iNdEx])
+			copy(dAtA[i:], m.Attributes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Attributes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelReceiveRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelReceiveRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelReceiveRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rule != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Rule))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcReceivePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcReceivePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcReceivePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelRules) > 0 {
+		for iNdEx := len(m.ChannelRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultRule != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultRule))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pepper)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovParams(uint64(m.HashScheme))
+	}
+	if len(m.Verifiers) > 0 {
+		for _, s := range m.Verifiers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.GatingPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.IbcReceivePolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ValidatorLevel != 0 {
+		n += 1 + sovParams(uint64(m.ValidatorLevel))
+	}
+	l = m.SpendPolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgGatingRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Rule != 0 {
+		n += 1 + sovParams(uint64(m.Rule))
+	}
+	return n
+}
+
+func (m *GatingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultRule != 0 {
+		n += 1 + sovParams(uint64(m.DefaultRule))
+	}
+	if len(m.MsgRules) > 0 {
+		for _, e := range m.MsgRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ExemptModuleAccounts {
+		n += 2
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AttributeRules) > 0 {
+		for _, e := range m.AttributeRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.LevelRules) > 0 {
+		for _, e := range m.LevelRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLevelRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinLevel != 0 {
+		n += 1 + sovParams(uint64(m.MinLevel))
+	}
+	return n
+}
+
+func (m *SpendPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LevelSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovParams(uint64(m.Level))
+	}
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTxCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxTxCount))
+	}
+	if len(m.MaxTxAmount) > 0 {
+		for _, e := range m.MaxTxAmount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAttributeRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, s := range m.Attributes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelReceiveRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Rule != 0 {
+		n += 1 + sovParams(uint64(m.Rule))
+	}
+	return n
+}
+
+func (m *IbcReceivePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultRule != 0 {
+		n += 1 + sovParams(uint64(m.DefaultRule))
+	}
+	if len(m.ChannelRules) > 0 {
+		for _, e := range m.ChannelRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pepper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pepper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifiers = append(m.Verifiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcReceivePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IbcReceivePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLevel", wireType)
+			}
+			m.ValidatorLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorLevel |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGatingRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGatingRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGatingRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			m.Rule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rule |= GatingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRule", wireType)
+			}
+			m.DefaultRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultRule |= GatingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgRules = append(m.MsgRules, MsgGatingRule{})
+			if err := m.MsgRules[len(m.MsgRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptModuleAccounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExemptModuleAccounts = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeRules = append(m.AttributeRules, MsgAttributeRule{})
+			if err := m.AttributeRules[len(m.AttributeRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LevelRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LevelRules = append(m.LevelRules, MsgLevelRule{})
+			if err := m.LevelRules[len(m.LevelRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLevelRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLevelRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLevelRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLevel", wireType)
+			}
+			m.MinLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLevel |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, LevelSpendLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LevelSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LevelSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LevelSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxCount", wireType)
+			}
+			m.MaxTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTxAmount = append(m.MaxTxAmount, types.Coin{})
+			if err := m.MaxTxAmount[len(m.MaxTxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttributeRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttributeRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttributeRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelReceiveRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelReceiveRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelReceiveRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			m.Rule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rule |= IbcReceiveRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcReceivePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcReceivePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcReceivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRule", wireType)
+			}
+			m.DefaultRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultRule |= IbcReceiveRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRules = append(m.ChannelRules, ChannelReceiveRule{})
+			if err := m.ChannelRules[len(m.ChannelRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
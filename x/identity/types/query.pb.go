@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -402,681 +404,7958 @@ func (m *QueryIdentityByCccdIdResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "nexelra.identity.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "nexelra.identity.QueryParamsResponse")
-	proto.RegisterType((*QueryGetIdentityRequest)(nil), "nexelra.identity.QueryGetIdentityRequest")
-	proto.RegisterType((*QueryGetIdentityResponse)(nil), "nexelra.identity.QueryGetIdentityResponse")
-	proto.RegisterType((*QueryAllIdentityRequest)(nil), "nexelra.identity.QueryAllIdentityRequest")
-	proto.RegisterType((*QueryAllIdentityResponse)(nil), "nexelra.identity.QueryAllIdentityResponse")
-	proto.RegisterType((*QueryIdentityByCccdIdRequest)(nil), "nexelra.identity.QueryIdentityByCccdIdRequest")
-	proto.RegisterType((*QueryIdentityByCccdIdResponse)(nil), "nexelra.identity.QueryIdentityByCccdIdResponse")
+type QueryIdentitiesByVerifierRequest struct {
+	Verifier   string             `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("nexelra/identity/query.proto", fileDescriptor_930113cfe876caeb) }
-
-var fileDescriptor_930113cfe876caeb = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xa1, 0x84, 0xf6, 0xba, 0x94, 0xa3, 0x02, 0xcb, 0x4a, 0xdd, 0xca, 0x2a, 0x05,
-	0x02, 0xf1, 0xa9, 0xc9, 0x08, 0x4b, 0x83, 0x44, 0xe9, 0x82, 0x8a, 0x27, 0xc4, 0x76, 0xb1, 0x4f,
-	0xc6, 0x92, 0xe3, 0x73, 0x7d, 0x2e, 0xd4, 0xaa, 0xca, 0xc0, 0xc4, 0x06, 0x12, 0x9f, 0x80, 0x05,
-	0x75, 0xe4, 0x63, 0x64, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0x82, 0xc4, 0xd7, 0x40, 0xb9, 0x3f, 0x29,
-	0x89, 0x63, 0x35, 0x30, 0x75, 0x89, 0xce, 0xbe, 0xf7, 0x79, 0x9e, 0x5f, 0xde, 0xbb, 0xd7, 0xb0,
-	0x1e, 0xd3, 0x23, 0x1a, 0xa5, 0x04, 0x87, 0x3e, 0x8d, 0xb3, 0x30, 0xcb, 0xf1, 0xc1, 0x21, 0x4d,
-	0x73, 0x27, 0x49, 0x59, 0xc6, 0xd0, 0x8a, 0xda, 0x75, 0xf4, 0xae, 0x79, 0x9d, 0xf4, 0xc2, 0x98,
-	0x61, 0xf1, 0x2b, 0x8b, 0xcc, 0xd5, 0x80, 0x05, 0x4c, 0x2c, 0xf1, 0x68, 0xa5, 0xde, 0xd6, 0x03,
-	0xc6, 0x82, 0x88, 0x62, 0x92, 0x84, 0x98, 0xc4, 0x31, 0xcb, 0x48, 0x16, 0xb2, 0x98, 0xab, 0xdd,
-	0x86, 0xc7, 0x78, 0x8f, 0x71, 0xdc, 0x25, 0x9c, 0xca, 0x44, 0xfc, 0x7a, 0xbb, 0x4b, 0x33, 0xb2,
-	0x8d, 0x13, 0x12, 0x84, 0xb1, 0x28, 0x56, 0xb5, 0x6b, 0x05, 0xc4, 0x84, 0xa4, 0xa4, 0xa7, 0xad,
-	0xd6, 0x0b, 0xdb, 0x7a, 0x21, 0x0b, 0xec, 0x55, 0x88, 0x9e, 0x8f, 0x12, 0xf6, 0x85, 0xca, 0xa5,
-	0x07, 0x87, 0x94, 0x67, 0xb6, 0x0b, 0x6f, 0x4c, 0xbc, 0xe5, 0x09, 0x8b, 0x39, 0x45, 0x0f, 0x61,
-	0x4d, 0xba, 0x1b, 0x60, 0x03, 0xdc, 0x5d, 0x6e, 0x19, 0xce, 0x74, 0x0b, 0x1c, 0xa9, 0xe8, 0x2c,
-	0xf5, 0x7f, 0xac, 0x57, 0x4e, 0x7f, 0x7f, 0x6d, 0x00, 0x57, 0x49, 0xec, 0x36, 0xbc, 0x25, 0x3c,
-	0x77, 0x69, 0xb6, 0xa7, 0xaa, 0x55, 0x1c, 0x32, 0xe0, 0x35, 0xe2, 0xfb, 0x29, 0xe5, 0xd2, 0x78,
-	0xc9, 0xd5, 0x8f, 0xf6, 0x0b, 0x68, 0x14, 0x45, 0x8a, 0xe6, 0x11, 0x5c, 0xd4, 0xb1, 0x8a, 0xc7,
-	0x2c, 0xf2, 0x68, 0x55, 0x67, 0x61, 0x44, 0xe4, 0x8e, 0x15, 0x36, 0x51, 0x38, 0x3b, 0x51, 0x34,
-	0x8d, 0xf3, 0x04, 0xc2, 0xf3, 0x3e, 0x2b, 0xeb, 0x2d, 0x47, 0x1e, 0x8a, 0x33, 0x3a, 0x14, 0x47,
-	0x5e, 0x03, 0x75, 0x28, 0xce, 0x3e, 0x09, 0xa8, 0xd2, 0xba, 0x7f, 0x29, 0xed, 0xcf, 0x40, 0xd1,
-	0x4f, 0x64, 0xcc, 0xa4, 0xbf, 0xf2, 0x6f, 0xf4, 0x68, 0x77, 0x02, 0xb1, 0x2a, 0x10, 0xef, 0x5c,
-	0x88, 0x28, 0xa3, 0x27, 0x18, 0xdf, 0xc2, 0xba, 0x40, 0x1c, 0x27, 0xe5, 0x8f, 0x3d, 0xcf, 0xdf,
-	0xf3, 0x75, 0x2f, 0x6e, 0xc2, 0x5a, 0xe8, 0x3f, 0x25, 0xfc, 0x95, 0x3a, 0x19, 0xf5, 0x34, 0xd5,
-	0xa3, 0xea, 0x7f, 0xf7, 0xe8, 0x0b, 0x80, 0x6b, 0x25, 0x00, 0x97, 0xaa, 0x51, 0xad, 0xfe, 0x02,
-	0xbc, 0x2a, 0x40, 0xd1, 0x1b, 0x58, 0x93, 0xb7, 0x1c, 0x6d, 0x16, 0x41, 0x8a, 0xc3, 0x64, 0xde,
-	0xbe, 0xa0, 0x4a, 0x86, 0xd9, 0x1b, 0xef, 0xbe, 0xfd, 0xfa, 0x54, 0x35, 0x91, 0x81, 0x9f, 0xcd,
-	0x1e, 0x69, 0xf4, 0x01, 0xc0, 0x45, 0xfd, 0x47, 0xd1, 0xbd, 0x12, 0xd7, 0xe2, 0x78, 0x99, 0x8d,
-	0x79, 0x4a, 0x15, 0xc5, 0x03, 0x41, 0xb1, 0x85, 0x36, 0x8b, 0x14, 0xe3, 0xc5, 0xb1, 0x9a, 0xce,
-	0x13, 0xf4, 0x1e, 0xc0, 0x65, 0x6d, 0xb1, 0x13, 0x45, 0xa5, 0x50, 0xc5, 0x21, 0x2b, 0x85, 0x9a,
-	0x31, 0x2b, 0xb6, 0x2d, 0xa0, 0xea, 0xc8, 0x2c, 0x87, 0x42, 0xa7, 0x00, 0xae, 0x4c, 0xdf, 0x21,
-	0xe4, 0x94, 0x84, 0x94, 0xdc, 0x76, 0x13, 0xcf, 0x5d, 0xaf, 0xc8, 0xda, 0x82, 0xac, 0x89, 0xee,
-	0x97, 0x93, 0x35, 0xbb, 0x79, 0xd3, 0xf3, 0x3c, 0x1f, 0x1f, 0xcb, 0xd1, 0x39, 0xe9, 0xb4, 0xfa,
-	0x03, 0x0b, 0x9c, 0x0d, 0x2c, 0xf0, 0x73, 0x60, 0x81, 0x8f, 0x43, 0xab, 0x72, 0x36, 0xb4, 0x2a,
-	0xdf, 0x87, 0x56, 0xe5, 0xa5, 0xa1, 0x5d, 0x8e, 0xce, 0x7d, 0xb2, 0x3c, 0xa1, 0xbc, 0x5b, 0x13,
-	0x9f, 0xeb, 0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xab, 0x04, 0x1f, 0x93, 0x06, 0x00,
-	0x00,
+func (m *QueryIdentitiesByVerifierRequest) Reset()         { *m = QueryIdentitiesByVerifierRequest{} }
+func (m *QueryIdentitiesByVerifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentitiesByVerifierRequest) ProtoMessage()    {}
+func (*QueryIdentitiesByVerifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{8}
+}
+func (m *QueryIdentitiesByVerifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentitiesByVerifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentitiesByVerifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIdentitiesByVerifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentitiesByVerifierRequest.Merge(m, src)
+}
+func (m *QueryIdentitiesByVerifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentitiesByVerifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentitiesByVerifierRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIdentitiesByVerifierRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Identity by address.
-	Identity(ctx context.Context, in *QueryGetIdentityRequest, opts ...grpc.CallOption) (*QueryGetIdentityResponse, error)
-	// Queries a list of Identity items.
-	IdentityAll(ctx context.Context, in *QueryAllIdentityRequest, opts ...grpc.CallOption) (*QueryAllIdentityResponse, error)
-	// Queries Identity by CCCD ID hash
-	IdentityByCccdId(ctx context.Context, in *QueryIdentityByCccdIdRequest, opts ...grpc.CallOption) (*QueryIdentityByCccdIdResponse, error)
+func (m *QueryIdentitiesByVerifierRequest) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryIdentitiesByVerifierRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryIdentitiesByVerifierResponse struct {
+	Identity   []Identity          `protobuf:"bytes,1,rep,name=identity,proto3" json:"identity"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryIdentitiesByVerifierResponse) Reset()         { *m = QueryIdentitiesByVerifierResponse{} }
+func (m *QueryIdentitiesByVerifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIdentitiesByVerifierResponse) ProtoMessage()    {}
+func (*QueryIdentitiesByVerifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{9}
 }
-
-func (c *queryClient) Identity(ctx context.Context, in *QueryGetIdentityRequest, opts ...grpc.CallOption) (*QueryGetIdentityResponse, error) {
-	out := new(QueryGetIdentityResponse)
-	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Identity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIdentitiesByVerifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIdentitiesByVerifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIdentitiesByVerifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIdentitiesByVerifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIdentitiesByVerifierResponse.Merge(m, src)
+}
+func (m *QueryIdentitiesByVerifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIdentitiesByVerifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIdentitiesByVerifierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IdentityAll(ctx context.Context, in *QueryAllIdentityRequest, opts ...grpc.CallOption) (*QueryAllIdentityResponse, error) {
-	out := new(QueryAllIdentityResponse)
-	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IdentityAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIdentitiesByVerifierResponse proto.InternalMessageInfo
+
+func (m *QueryIdentitiesByVerifierResponse) GetIdentity() []Identity {
+	if m != nil {
+		return m.Identity
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IdentityByCccdId(ctx context.Context, in *QueryIdentityByCccdIdRequest, opts ...grpc.CallOption) (*QueryIdentityByCccdIdResponse, error) {
-	out := new(QueryIdentityByCccdIdResponse)
-	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IdentityByCccdId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIdentitiesByVerifierResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Identity by address.
-	Identity(context.Context, *QueryGetIdentityRequest) (*QueryGetIdentityResponse, error)
-	// Queries a list of Identity items.
-	IdentityAll(context.Context, *QueryAllIdentityRequest) (*QueryAllIdentityResponse, error)
-	// Queries Identity by CCCD ID hash
-	IdentityByCccdId(context.Context, *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error)
+type QueryGatingPolicyRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGatingPolicyRequest) Reset()         { *m = QueryGatingPolicyRequest{} }
+func (m *QueryGatingPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGatingPolicyRequest) ProtoMessage()    {}
+func (*QueryGatingPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{10}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGatingPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Identity(ctx context.Context, req *QueryGetIdentityRequest) (*QueryGetIdentityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Identity not implemented")
+func (m *QueryGatingPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGatingPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IdentityAll(ctx context.Context, req *QueryAllIdentityRequest) (*QueryAllIdentityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IdentityAll not implemented")
+func (m *QueryGatingPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatingPolicyRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) IdentityByCccdId(ctx context.Context, req *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IdentityByCccdId not implemented")
+func (m *QueryGatingPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGatingPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatingPolicyRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryGatingPolicyRequest proto.InternalMessageInfo
+
+type QueryGatingPolicyResponse struct {
+	GatingPolicy GatingPolicy `protobuf:"bytes,1,opt,name=gatingPolicy,proto3" json:"gatingPolicy"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nexelra.identity.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGatingPolicyResponse) Reset()         { *m = QueryGatingPolicyResponse{} }
+func (m *QueryGatingPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGatingPolicyResponse) ProtoMessage()    {}
+func (*QueryGatingPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{11}
+}
+func (m *QueryGatingPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGatingPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGatingPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGatingPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGatingPolicyResponse.Merge(m, src)
+}
+func (m *QueryGatingPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGatingPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGatingPolicyResponse.DiscardUnknown(m)
 }
 
-func _Query_Identity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetIdentityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGatingPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryGatingPolicyResponse) GetGatingPolicy() GatingPolicy {
+	if m != nil {
+		return m.GatingPolicy
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Identity(ctx, in)
+	return GatingPolicy{}
+}
+
+type QueryMsgRequirementsRequest struct {
+	// msgTypeUrl is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msgTypeUrl,proto3" json:"msgTypeUrl,omitempty"`
+}
+
+func (m *QueryMsgRequirementsRequest) Reset()         { *m = QueryMsgRequirementsRequest{} }
+func (m *QueryMsgRequirementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRequirementsRequest) ProtoMessage()    {}
+func (*QueryMsgRequirementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{12}
+}
+func (m *QueryMsgRequirementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRequirementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRequirementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nexelra.identity.Query/Identity",
+}
+func (m *QueryMsgRequirementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRequirementsRequest.Merge(m, src)
+}
+func (m *QueryMsgRequirementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRequirementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRequirementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgRequirementsRequest proto.InternalMessageInfo
+
+func (m *QueryMsgRequirementsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Identity(ctx, req.(*QueryGetIdentityRequest))
+	return ""
+}
+
+// QueryMsgRequirementsResponse lists the checks the ante handler applies to
+// the signers of the message type. Exempt messages are not checked, so they
+// require no level and no attributes.
+type QueryMsgRequirementsResponse struct {
+	Rule GatingRule `protobuf:"varint,1,opt,name=rule,proto3,enum=nexelra.identity.GatingRule" json:"rule,omitempty"`
+	// minLevel is the lowest identity level the signers may have.
+	MinLevel IdentityLevel `protobuf:"varint,2,opt,name=minLevel,proto3,enum=nexelra.identity.IdentityLevel" json:"minLevel,omitempty"`
+	// attributes are the attributes the signers must have proven.
+	Attributes []string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// spendLimits cap the funds the message moves, by the level of the
+	// sender's identity.
+	SpendLimits []LevelSpendLimit `protobuf:"bytes,4,rep,name=spendLimits,proto3" json:"spendLimits"`
+}
+
+func (m *QueryMsgRequirementsResponse) Reset()         { *m = QueryMsgRequirementsResponse{} }
+func (m *QueryMsgRequirementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRequirementsResponse) ProtoMessage()    {}
+func (*QueryMsgRequirementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{13}
+}
+func (m *QueryMsgRequirementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRequirementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRequirementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMsgRequirementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRequirementsResponse.Merge(m, src)
+}
+func (m *QueryMsgRequirementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRequirementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRequirementsResponse.DiscardUnknown(m)
 }
 
-func _Query_IdentityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllIdentityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMsgRequirementsResponse proto.InternalMessageInfo
+
+func (m *QueryMsgRequirementsResponse) GetRule() GatingRule {
+	if m != nil {
+		return m.Rule
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IdentityAll(ctx, in)
+	return GatingRule_GATING_RULE_SIGNER_AND_RECIPIENT
+}
+
+func (m *QueryMsgRequirementsResponse) GetMinLevel() IdentityLevel {
+	if m != nil {
+		return m.MinLevel
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nexelra.identity.Query/IdentityAll",
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (m *QueryMsgRequirementsResponse) GetAttributes() []string {
+	if m != nil {
+		return m.Attributes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IdentityAll(ctx, req.(*QueryAllIdentityRequest))
+	return nil
+}
+
+func (m *QueryMsgRequirementsResponse) GetSpendLimits() []LevelSpendLimit {
+	if m != nil {
+		return m.SpendLimits
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IdentityByCccdId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIdentityByCccdIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IdentityByCccdId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nexelra.identity.Query/IdentityByCccdId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IdentityByCccdId(ctx, req.(*QueryIdentityByCccdIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryResolveDidRequest struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nexelra.identity.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Identity",
-			Handler:    _Query_Identity_Handler,
-		},
-		{
-			MethodName: "IdentityAll",
-			Handler:    _Query_IdentityAll_Handler,
-		},
-		{
-			MethodName: "IdentityByCccdId",
-			Handler:    _Query_IdentityByCccdId_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nexelra/identity/query.proto",
+func (m *QueryResolveDidRequest) Reset()         { *m = QueryResolveDidRequest{} }
+func (m *QueryResolveDidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidRequest) ProtoMessage()    {}
+func (*QueryResolveDidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{14}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveDidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolveDidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryResolveDidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolveDidRequest proto.InternalMessageInfo
+
+func (m *QueryResolveDidRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryResolveDidResponse is a W3C DID resolution result. Unknown and
+// malformed DIDs resolve to an error in didResolutionMetadata rather than
+// failing the query.
+type QueryResolveDidResponse struct {
+	DidResolutionMetadata DidResolutionMetadata `protobuf:"bytes,1,opt,name=didResolutionMetadata,proto3" json:"didResolutionMetadata"`
+	DidDocument           *DidDocument          `protobuf:"bytes,2,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentMetadata   DidDocumentMetadata   `protobuf:"bytes,3,opt,name=didDocumentMetadata,proto3" json:"didDocumentMetadata"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryResolveDidResponse) Reset()         { *m = QueryResolveDidResponse{} }
+func (m *QueryResolveDidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidResponse) ProtoMessage()    {}
+func (*QueryResolveDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{15}
+}
+func (m *QueryResolveDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetIdentityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResolveDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidResponse.Merge(m, src)
+}
+func (m *QueryResolveDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetIdentityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryResolveDidResponse proto.InternalMessageInfo
+
+func (m *QueryResolveDidResponse) GetDidResolutionMetadata() DidResolutionMetadata {
+	if m != nil {
+		return m.DidResolutionMetadata
+	}
+	return DidResolutionMetadata{}
 }
 
-func (m *QueryGetIdentityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryResolveDidResponse) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetIdentityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidResponse) GetDidDocumentMetadata() DidDocumentMetadata {
+	if m != nil {
+		return m.DidDocumentMetadata
 	}
-	return dAtA[:n], nil
+	return DidDocumentMetadata{}
 }
 
-func (m *QueryGetIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetIssuerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryGetIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Identity.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetIssuerRequest) Reset()         { *m = QueryGetIssuerRequest{} }
+func (m *QueryGetIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerRequest) ProtoMessage()    {}
+func (*QueryGetIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{16}
+}
+func (m *QueryGetIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerRequest.Merge(m, src)
+}
+func (m *QueryGetIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllIdentityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryGetIssuerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllIdentityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetIssuerResponse struct {
+	Issuer Issuer `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer"`
 }
 
-func (m *QueryAllIdentityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetIssuerResponse) Reset()         { *m = QueryGetIssuerResponse{} }
+func (m *QueryGetIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetIssuerResponse) ProtoMessage()    {}
+func (*QueryGetIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{17}
+}
+func (m *QueryGetIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetIssuerResponse.Merge(m, src)
+}
+func (m *QueryGetIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetIssuerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllIdentityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryGetIssuerResponse) GetIssuer() Issuer {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return Issuer{}
 }
 
-func (m *QueryAllIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllIssuerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Identity) > 0 {
-		for iNdEx := len(m.Identity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Identity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllIssuerRequest) Reset()         { *m = QueryAllIssuerRequest{} }
+func (m *QueryAllIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerRequest) ProtoMessage()    {}
+func (*QueryAllIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{18}
+}
+func (m *QueryAllIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerRequest.Merge(m, src)
+}
+func (m *QueryAllIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIdentityByCccdIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryAllIssuerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIdentityByCccdIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllIssuerResponse struct {
+	Issuer     []Issuer            `protobuf:"bytes,1,rep,name=issuer,proto3" json:"issuer"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIdentityByCccdIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllIssuerResponse) Reset()         { *m = QueryAllIssuerResponse{} }
+func (m *QueryAllIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllIssuerResponse) ProtoMessage()    {}
+func (*QueryAllIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{19}
+}
+func (m *QueryAllIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.IdHash) > 0 {
-		i -= len(m.IdHash)
-		copy(dAtA[i:], m.IdHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdHash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAllIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllIssuerResponse.Merge(m, src)
+}
+func (m *QueryAllIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryAllIssuerResponse) GetIssuer() []Issuer {
+	if m != nil {
+		return m.Issuer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryIdentityByCccdIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllIssuerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIdentityByCccdIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetCredentialSchemaRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryIdentityByCccdIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Identity) > 0 {
-		for iNdEx := len(m.Identity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Identity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetCredentialSchemaRequest) Reset()         { *m = QueryGetCredentialSchemaRequest{} }
+func (m *QueryGetCredentialSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialSchemaRequest) ProtoMessage()    {}
+func (*QueryGetCredentialSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{20}
+}
+func (m *QueryGetCredentialSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetCredentialSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialSchemaRequest.Merge(m, src)
+}
+func (m *QueryGetCredentialSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialSchemaRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryGetCredentialSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryGetCredentialSchemaRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+type QueryGetCredentialSchemaResponse struct {
+	CredentialSchema CredentialSchema `protobuf:"bytes,1,opt,name=credentialSchema,proto3" json:"credentialSchema"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCredentialSchemaResponse) Reset()         { *m = QueryGetCredentialSchemaResponse{} }
+func (m *QueryGetCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialSchemaResponse) ProtoMessage()    {}
+func (*QueryGetCredentialSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{21}
+}
+func (m *QueryGetCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryGetCredentialSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialSchemaResponse.Merge(m, src)
+}
+func (m *QueryGetCredentialSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetIdentityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetCredentialSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryGetCredentialSchemaResponse) GetCredentialSchema() CredentialSchema {
+	if m != nil {
+		return m.CredentialSchema
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return CredentialSchema{}
+}
+
+type QueryAllCredentialSchemaRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCredentialSchemaRequest) Reset()         { *m = QueryAllCredentialSchemaRequest{} }
+func (m *QueryAllCredentialSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCredentialSchemaRequest) ProtoMessage()    {}
+func (*QueryAllCredentialSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{22}
+}
+func (m *QueryAllCredentialSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCredentialSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCredentialSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAllCredentialSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCredentialSchemaRequest.Merge(m, src)
+}
+func (m *QueryAllCredentialSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCredentialSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCredentialSchemaRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetIdentityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllCredentialSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryAllCredentialSchemaRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Identity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllIdentityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAllCredentialSchemaResponse struct {
+	CredentialSchema []CredentialSchema  `protobuf:"bytes,1,rep,name=credentialSchema,proto3" json:"credentialSchema"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCredentialSchemaResponse) Reset()         { *m = QueryAllCredentialSchemaResponse{} }
+func (m *QueryAllCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCredentialSchemaResponse) ProtoMessage()    {}
+func (*QueryAllCredentialSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{23}
+}
+func (m *QueryAllCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCredentialSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCredentialSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAllCredentialSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCredentialSchemaResponse.Merge(m, src)
+}
+func (m *QueryAllCredentialSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCredentialSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCredentialSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCredentialSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryAllCredentialSchemaResponse) GetCredentialSchema() []CredentialSchema {
+	if m != nil {
+		return m.CredentialSchema
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllIdentityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCredentialSchemaResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
+	return nil
+}
+
+type QueryGetCredentialRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetCredentialRequest) Reset()         { *m = QueryGetCredentialRequest{} }
+func (m *QueryGetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialRequest) ProtoMessage()    {}
+func (*QueryGetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{24}
+}
+func (m *QueryGetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialRequest.Merge(m, src)
+}
+func (m *QueryGetCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryGetCredentialRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetCredentialResponse struct {
+	Credential Credential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential"`
+	// status is the status of the credential at the time of the queried block.
+	Status CredentialStatus `protobuf:"varint,2,opt,name=status,proto3,enum=nexelra.identity.CredentialStatus" json:"status,omitempty"`
+}
+
+func (m *QueryGetCredentialResponse) Reset()         { *m = QueryGetCredentialResponse{} }
+func (m *QueryGetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialResponse) ProtoMessage()    {}
+func (*QueryGetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{25}
+}
+func (m *QueryGetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialResponse.Merge(m, src)
+}
+func (m *QueryGetCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryGetCredentialResponse) GetCredential() Credential {
+	if m != nil {
+		return m.Credential
+	}
+	return Credential{}
+}
+
+func (m *QueryGetCredentialResponse) GetStatus() CredentialStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CredentialStatus_CREDENTIAL_STATUS_VALID
+}
+
+type QueryCredentialsByHolderRequest struct {
+	Holder     string             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialsByHolderRequest) Reset()         { *m = QueryCredentialsByHolderRequest{} }
+func (m *QueryCredentialsByHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialsByHolderRequest) ProtoMessage()    {}
+func (*QueryCredentialsByHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{26}
+}
+func (m *QueryCredentialsByHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialsByHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialsByHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialsByHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialsByHolderRequest.Merge(m, src)
+}
+func (m *QueryCredentialsByHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialsByHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialsByHolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialsByHolderRequest proto.InternalMessageInfo
+
+func (m *QueryCredentialsByHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *QueryCredentialsByHolderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCredentialsByHolderResponse struct {
+	Credential []Credential        `protobuf:"bytes,1,rep,name=credential,proto3" json:"credential"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialsByHolderResponse) Reset()         { *m = QueryCredentialsByHolderResponse{} }
+func (m *QueryCredentialsByHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialsByHolderResponse) ProtoMessage()    {}
+func (*QueryCredentialsByHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{27}
+}
+func (m *QueryCredentialsByHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialsByHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialsByHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialsByHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialsByHolderResponse.Merge(m, src)
+}
+func (m *QueryCredentialsByHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialsByHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialsByHolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialsByHolderResponse proto.InternalMessageInfo
+
+func (m *QueryCredentialsByHolderResponse) GetCredential() []Credential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+func (m *QueryCredentialsByHolderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCredentialStatusListRequest struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (m *QueryCredentialStatusListRequest) Reset()         { *m = QueryCredentialStatusListRequest{} }
+func (m *QueryCredentialStatusListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusListRequest) ProtoMessage()    {}
+func (*QueryCredentialStatusListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{28}
+}
+func (m *QueryCredentialStatusListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialStatusListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialStatusListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialStatusListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusListRequest.Merge(m, src)
+}
+func (m *QueryCredentialStatusListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialStatusListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialStatusListRequest proto.InternalMessageInfo
+
+func (m *QueryCredentialStatusListRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+// QueryCredentialStatusListResponse is the status list of an issuer in the
+// form of a W3C Bitstring Status List credential subject.
+type QueryCredentialStatusListResponse struct {
+	// statusPurpose is always revocation.
+	StatusPurpose string `protobuf:"bytes,1,opt,name=statusPurpose,proto3" json:"statusPurpose,omitempty"`
+	// encodedList is the GZIP compressed bitstring, base64url multibase
+	// encoded. It is padded to at least 131072 entries, as the specification
+	// requires for herd privacy.
+	EncodedList string `protobuf:"bytes,2,opt,name=encodedList,proto3" json:"encodedList,omitempty"`
+	// statusListSize is the number of entries in use.
+	StatusListSize uint64 `protobuf:"varint,3,opt,name=statusListSize,proto3" json:"statusListSize,omitempty"`
+}
+
+func (m *QueryCredentialStatusListResponse) Reset()         { *m = QueryCredentialStatusListResponse{} }
+func (m *QueryCredentialStatusListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusListResponse) ProtoMessage()    {}
+func (*QueryCredentialStatusListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{29}
+}
+func (m *QueryCredentialStatusListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialStatusListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialStatusListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialStatusListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusListResponse.Merge(m, src)
+}
+func (m *QueryCredentialStatusListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialStatusListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialStatusListResponse proto.InternalMessageInfo
+
+func (m *QueryCredentialStatusListResponse) GetStatusPurpose() string {
+	if m != nil {
+		return m.StatusPurpose
+	}
+	return ""
+}
+
+func (m *QueryCredentialStatusListResponse) GetEncodedList() string {
+	if m != nil {
+		return m.EncodedList
+	}
+	return ""
+}
+
+func (m *QueryCredentialStatusListResponse) GetStatusListSize() uint64 {
+	if m != nil {
+		return m.StatusListSize
+	}
+	return 0
+}
+
+type QueryGetAttributeCircuitRequest struct {
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+}
+
+func (m *QueryGetAttributeCircuitRequest) Reset()         { *m = QueryGetAttributeCircuitRequest{} }
+func (m *QueryGetAttributeCircuitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttributeCircuitRequest) ProtoMessage()    {}
+func (*QueryGetAttributeCircuitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{30}
+}
+func (m *QueryGetAttributeCircuitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttributeCircuitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttributeCircuitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttributeCircuitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttributeCircuitRequest.Merge(m, src)
+}
+func (m *QueryGetAttributeCircuitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttributeCircuitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttributeCircuitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttributeCircuitRequest proto.InternalMessageInfo
+
+func (m *QueryGetAttributeCircuitRequest) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+type QueryGetAttributeCircuitResponse struct {
+	AttributeCircuit AttributeCircuit `protobuf:"bytes,1,opt,name=attributeCircuit,proto3" json:"attributeCircuit"`
+}
+
+func (m *QueryGetAttributeCircuitResponse) Reset()         { *m = QueryGetAttributeCircuitResponse{} }
+func (m *QueryGetAttributeCircuitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttributeCircuitResponse) ProtoMessage()    {}
+func (*QueryGetAttributeCircuitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{31}
+}
+func (m *QueryGetAttributeCircuitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttributeCircuitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttributeCircuitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttributeCircuitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttributeCircuitResponse.Merge(m, src)
+}
+func (m *QueryGetAttributeCircuitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttributeCircuitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttributeCircuitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttributeCircuitResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttributeCircuitResponse) GetAttributeCircuit() AttributeCircuit {
+	if m != nil {
+		return m.AttributeCircuit
+	}
+	return AttributeCircuit{}
+}
+
+type QueryAllAttributeCircuitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttributeCircuitRequest) Reset()         { *m = QueryAllAttributeCircuitRequest{} }
+func (m *QueryAllAttributeCircuitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttributeCircuitRequest) ProtoMessage()    {}
+func (*QueryAllAttributeCircuitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{32}
+}
+func (m *QueryAllAttributeCircuitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttributeCircuitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttributeCircuitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttributeCircuitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttributeCircuitRequest.Merge(m, src)
+}
+func (m *QueryAllAttributeCircuitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttributeCircuitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttributeCircuitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttributeCircuitRequest proto.InternalMessageInfo
+
+func (m *QueryAllAttributeCircuitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllAttributeCircuitResponse struct {
+	AttributeCircuit []AttributeCircuit  `protobuf:"bytes,1,rep,name=attributeCircuit,proto3" json:"attributeCircuit"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttributeCircuitResponse) Reset()         { *m = QueryAllAttributeCircuitResponse{} }
+func (m *QueryAllAttributeCircuitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttributeCircuitResponse) ProtoMessage()    {}
+func (*QueryAllAttributeCircuitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{33}
+}
+func (m *QueryAllAttributeCircuitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttributeCircuitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttributeCircuitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttributeCircuitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttributeCircuitResponse.Merge(m, src)
+}
+func (m *QueryAllAttributeCircuitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttributeCircuitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttributeCircuitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttributeCircuitResponse proto.InternalMessageInfo
+
+func (m *QueryAllAttributeCircuitResponse) GetAttributeCircuit() []AttributeCircuit {
+	if m != nil {
+		return m.AttributeCircuit
+	}
+	return nil
+}
+
+func (m *QueryAllAttributeCircuitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetAttributeFlagRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Attribute string `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+}
+
+func (m *QueryGetAttributeFlagRequest) Reset()         { *m = QueryGetAttributeFlagRequest{} }
+func (m *QueryGetAttributeFlagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttributeFlagRequest) ProtoMessage()    {}
+func (*QueryGetAttributeFlagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{34}
+}
+func (m *QueryGetAttributeFlagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttributeFlagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttributeFlagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttributeFlagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttributeFlagRequest.Merge(m, src)
+}
+func (m *QueryGetAttributeFlagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttributeFlagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttributeFlagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttributeFlagRequest proto.InternalMessageInfo
+
+func (m *QueryGetAttributeFlagRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryGetAttributeFlagRequest) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+type QueryGetAttributeFlagResponse struct {
+	AttributeFlag AttributeFlag `protobuf:"bytes,1,opt,name=attributeFlag,proto3" json:"attributeFlag"`
+	// active is whether the flag holds at the time of the queried block, as the
+	// ante handler would check it.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryGetAttributeFlagResponse) Reset()         { *m = QueryGetAttributeFlagResponse{} }
+func (m *QueryGetAttributeFlagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttributeFlagResponse) ProtoMessage()    {}
+func (*QueryGetAttributeFlagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{35}
+}
+func (m *QueryGetAttributeFlagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttributeFlagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttributeFlagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttributeFlagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttributeFlagResponse.Merge(m, src)
+}
+func (m *QueryGetAttributeFlagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttributeFlagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttributeFlagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttributeFlagResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttributeFlagResponse) GetAttributeFlag() AttributeFlag {
+	if m != nil {
+		return m.AttributeFlag
+	}
+	return AttributeFlag{}
+}
+
+func (m *QueryGetAttributeFlagResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type QueryAttributeFlagsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeFlagsRequest) Reset()         { *m = QueryAttributeFlagsRequest{} }
+func (m *QueryAttributeFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeFlagsRequest) ProtoMessage()    {}
+func (*QueryAttributeFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{36}
+}
+func (m *QueryAttributeFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeFlagsRequest.Merge(m, src)
+}
+func (m *QueryAttributeFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeFlagsRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeFlagsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAttributeFlagsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAttributeFlagsResponse struct {
+	AttributeFlag []AttributeFlag     `protobuf:"bytes,1,rep,name=attributeFlag,proto3" json:"attributeFlag"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeFlagsResponse) Reset()         { *m = QueryAttributeFlagsResponse{} }
+func (m *QueryAttributeFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeFlagsResponse) ProtoMessage()    {}
+func (*QueryAttributeFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{37}
+}
+func (m *QueryAttributeFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeFlagsResponse.Merge(m, src)
+}
+func (m *QueryAttributeFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeFlagsResponse) GetAttributeFlag() []AttributeFlag {
+	if m != nil {
+		return m.AttributeFlag
+	}
+	return nil
+}
+
+func (m *QueryAttributeFlagsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySpendAllowanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySpendAllowanceRequest) Reset()         { *m = QuerySpendAllowanceRequest{} }
+func (m *QuerySpendAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendAllowanceRequest) ProtoMessage()    {}
+func (*QuerySpendAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{38}
+}
+func (m *QuerySpendAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendAllowanceRequest.Merge(m, src)
+}
+func (m *QuerySpendAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendAllowanceRequest proto.InternalMessageInfo
+
+func (m *QuerySpendAllowanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySpendAllowanceResponse is the spend of the identity of an address
+// within the window ending at the queried block, and what is left of the
+// limit of its level. The spend is shared by all the addresses of the
+// CCCD of the identity.
+type QuerySpendAllowanceResponse struct {
+	// cccdKey is the key the spend is shared under, see Identity.CccdKey.
+	CccdKey string        `protobuf:"bytes,1,opt,name=cccdKey,proto3" json:"cccdKey,omitempty"`
+	Level   IdentityLevel `protobuf:"varint,2,opt,name=level,proto3,enum=nexelra.identity.IdentityLevel" json:"level,omitempty"`
+	// window is the length (seconds) of the rolling window.
+	Window      int64                                    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	SpentAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spentAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spentAmount"`
+	TxCount     uint64                                   `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
+	// limited is whether the level has a limit. The remaining fields are only
+	// set if so.
+	Limited bool            `protobuf:"varint,6,opt,name=limited,proto3" json:"limited,omitempty"`
+	Limit   LevelSpendLimit `protobuf:"bytes,7,opt,name=limit,proto3" json:"limit"`
+	// remainingAmount is what is left of maxAmount, per denom of the limit.
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=remainingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remainingAmount"`
+	// remainingTxCount is what is left of maxTxCount, zero if it is not
+	// capped.
+	RemainingTxCount uint64 `protobuf:"varint,9,opt,name=remainingTxCount,proto3" json:"remainingTxCount,omitempty"`
+}
+
+func (m *QuerySpendAllowanceResponse) Reset()         { *m = QuerySpendAllowanceResponse{} }
+func (m *QuerySpendAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendAllowanceResponse) ProtoMessage()    {}
+func (*QuerySpendAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930113cfe876caeb, []int{39}
+}
+func (m *QuerySpendAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendAllowanceResponse.Merge(m, src)
+}
+func (m *QuerySpendAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendAllowanceResponse proto.InternalMessageInfo
+
+func (m *QuerySpendAllowanceResponse) GetCccdKey() string {
+	if m != nil {
+		return m.CccdKey
+	}
+	return ""
+}
+
+func (m *QuerySpendAllowanceResponse) GetLevel() IdentityLevel {
+	if m != nil {
+		return m.Level
+	}
+	return IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED
+}
+
+func (m *QuerySpendAllowanceResponse) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QuerySpendAllowanceResponse) GetSpentAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpentAmount
+	}
+	return nil
+}
+
+func (m *QuerySpendAllowanceResponse) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *QuerySpendAllowanceResponse) GetLimited() bool {
+	if m != nil {
+		return m.Limited
+	}
+	return false
+}
+
+func (m *QuerySpendAllowanceResponse) GetLimit() LevelSpendLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return LevelSpendLimit{}
+}
+
+func (m *QuerySpendAllowanceResponse) GetRemainingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return nil
+}
+
+func (m *QuerySpendAllowanceResponse) GetRemainingTxCount() uint64 {
+	if m != nil {
+		return m.RemainingTxCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "nexelra.identity.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "nexelra.identity.QueryParamsResponse")
+	proto.RegisterType((*QueryGetIdentityRequest)(nil), "nexelra.identity.QueryGetIdentityRequest")
+	proto.RegisterType((*QueryGetIdentityResponse)(nil), "nexelra.identity.QueryGetIdentityResponse")
+	proto.RegisterType((*QueryAllIdentityRequest)(nil), "nexelra.identity.QueryAllIdentityRequest")
+	proto.RegisterType((*QueryAllIdentityResponse)(nil), "nexelra.identity.QueryAllIdentityResponse")
+	proto.RegisterType((*QueryIdentityByCccdIdRequest)(nil), "nexelra.identity.QueryIdentityByCccdIdRequest")
+	proto.RegisterType((*QueryIdentityByCccdIdResponse)(nil), "nexelra.identity.QueryIdentityByCccdIdResponse")
+	proto.RegisterType((*QueryIdentitiesByVerifierRequest)(nil), "nexelra.identity.QueryIdentitiesByVerifierRequest")
+	proto.RegisterType((*QueryIdentitiesByVerifierResponse)(nil), "nexelra.identity.QueryIdentitiesByVerifierResponse")
+	proto.RegisterType((*QueryGatingPolicyRequest)(nil), "nexelra.identity.QueryGatingPolicyRequest")
+	proto.RegisterType((*QueryGatingPolicyResponse)(nil), "nexelra.identity.QueryGatingPolicyResponse")
+	proto.RegisterType((*QueryMsgRequirementsRequest)(nil), "nexelra.identity.QueryMsgRequirementsRequest")
+	proto.RegisterType((*QueryMsgRequirementsResponse)(nil), "nexelra.identity.QueryMsgRequirementsResponse")
+	proto.RegisterType((*QueryResolveDidRequest)(nil), "nexelra.identity.QueryResolveDidRequest")
+	proto.RegisterType((*QueryResolveDidResponse)(nil), "nexelra.identity.QueryResolveDidResponse")
+	proto.RegisterType((*QueryGetIssuerRequest)(nil), "nexelra.identity.QueryGetIssuerRequest")
+	proto.RegisterType((*QueryGetIssuerResponse)(nil), "nexelra.identity.QueryGetIssuerResponse")
+	proto.RegisterType((*QueryAllIssuerRequest)(nil), "nexelra.identity.QueryAllIssuerRequest")
+	proto.RegisterType((*QueryAllIssuerResponse)(nil), "nexelra.identity.QueryAllIssuerResponse")
+	proto.RegisterType((*QueryGetCredentialSchemaRequest)(nil), "nexelra.identity.QueryGetCredentialSchemaRequest")
+	proto.RegisterType((*QueryGetCredentialSchemaResponse)(nil), "nexelra.identity.QueryGetCredentialSchemaResponse")
+	proto.RegisterType((*QueryAllCredentialSchemaRequest)(nil), "nexelra.identity.QueryAllCredentialSchemaRequest")
+	proto.RegisterType((*QueryAllCredentialSchemaResponse)(nil), "nexelra.identity.QueryAllCredentialSchemaResponse")
+	proto.RegisterType((*QueryGetCredentialRequest)(nil), "nexelra.identity.QueryGetCredentialRequest")
+	proto.RegisterType((*QueryGetCredentialResponse)(nil), "nexelra.identity.QueryGetCredentialResponse")
+	proto.RegisterType((*QueryCredentialsByHolderRequest)(nil), "nexelra.identity.QueryCredentialsByHolderRequest")
+	proto.RegisterType((*QueryCredentialsByHolderResponse)(nil), "nexelra.identity.QueryCredentialsByHolderResponse")
+	proto.RegisterType((*QueryCredentialStatusListRequest)(nil), "nexelra.identity.QueryCredentialStatusListRequest")
+	proto.RegisterType((*QueryCredentialStatusListResponse)(nil), "nexelra.identity.QueryCredentialStatusListResponse")
+	proto.RegisterType((*QueryGetAttributeCircuitRequest)(nil), "nexelra.identity.QueryGetAttributeCircuitRequest")
+	proto.RegisterType((*QueryGetAttributeCircuitResponse)(nil), "nexelra.identity.QueryGetAttributeCircuitResponse")
+	proto.RegisterType((*QueryAllAttributeCircuitRequest)(nil), "nexelra.identity.QueryAllAttributeCircuitRequest")
+	proto.RegisterType((*QueryAllAttributeCircuitResponse)(nil), "nexelra.identity.QueryAllAttributeCircuitResponse")
+	proto.RegisterType((*QueryGetAttributeFlagRequest)(nil), "nexelra.identity.QueryGetAttributeFlagRequest")
+	proto.RegisterType((*QueryGetAttributeFlagResponse)(nil), "nexelra.identity.QueryGetAttributeFlagResponse")
+	proto.RegisterType((*QueryAttributeFlagsRequest)(nil), "nexelra.identity.QueryAttributeFlagsRequest")
+	proto.RegisterType((*QueryAttributeFlagsResponse)(nil), "nexelra.identity.QueryAttributeFlagsResponse")
+	proto.RegisterType((*QuerySpendAllowanceRequest)(nil), "nexelra.identity.QuerySpendAllowanceRequest")
+	proto.RegisterType((*QuerySpendAllowanceResponse)(nil), "nexelra.identity.QuerySpendAllowanceResponse")
+}
+
+func init() { proto.RegisterFile("nexelra/identity/query.proto", fileDescriptor_930113cfe876caeb) }
+
+var fileDescriptor_930113cfe876caeb = []byte{
+	// 2037 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x9a, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0xc0, 0x4d, 0xad, 0xbc, 0x96, 0x9e, 0x63, 0x45, 0x1d, 0xdb, 0xca, 0x9a, 0x5e, 0xaf, 0x24,
+	0xfa, 0x8f, 0x64, 0x29, 0xbb, 0xb4, 0x56, 0xb0, 0x9b, 0xc6, 0x4d, 0x03, 0x49, 0x86, 0x13, 0x23,
+	0x76, 0xa1, 0xd2, 0x6e, 0x50, 0xf4, 0x52, 0x50, 0xe4, 0x64, 0x35, 0x2d, 0x97, 0xdc, 0x90, 0x5c,
+	0xd9, 0x1b, 0x41, 0x41, 0x5b, 0x14, 0x69, 0x6f, 0x0d, 0x12, 0xf4, 0x50, 0x20, 0x97, 0xf6, 0x50,
+	0xb8, 0xe9, 0xa1, 0x41, 0x0b, 0xf4, 0x50, 0xf4, 0xd2, 0x5b, 0x8e, 0x01, 0x7a, 0xe9, 0xa9, 0x2d,
+	0xec, 0x02, 0xfd, 0x0a, 0x3d, 0x06, 0x1c, 0x3e, 0x72, 0xb9, 0x1c, 0x92, 0xbb, 0xeb, 0x6c, 0x80,
+	0x5c, 0xbc, 0xe4, 0xcc, 0x7b, 0x6f, 0x7e, 0xef, 0xcd, 0xcc, 0xe3, 0xcc, 0xb3, 0xa0, 0x6a, 0xd3,
+	0x47, 0xd4, 0x72, 0x75, 0x95, 0x99, 0xd4, 0xf6, 0x99, 0xdf, 0x53, 0xdf, 0xee, 0x52, 0xb7, 0xd7,
+	0xe8, 0xb8, 0x8e, 0xef, 0x90, 0x79, 0xec, 0x6d, 0x44, 0xbd, 0xf2, 0xd7, 0xf4, 0x36, 0xb3, 0x1d,
+	0x95, 0xff, 0x1b, 0x0a, 0xc9, 0x67, 0x5a, 0x4e, 0xcb, 0xe1, 0x8f, 0x6a, 0xf0, 0x84, 0xad, 0xd5,
+	0x96, 0xe3, 0xb4, 0x2c, 0xaa, 0xea, 0x1d, 0xa6, 0xea, 0xb6, 0xed, 0xf8, 0xba, 0xcf, 0x1c, 0xdb,
+	0xc3, 0xde, 0x35, 0xc3, 0xf1, 0xda, 0x8e, 0xa7, 0xee, 0xe9, 0x1e, 0x0d, 0x47, 0x54, 0x0f, 0x36,
+	0xf6, 0xa8, 0xaf, 0x6f, 0xa8, 0x1d, 0xbd, 0xc5, 0x6c, 0x2e, 0x8c, 0xb2, 0xb5, 0xa4, 0x6c, 0x24,
+	0x65, 0x38, 0x2c, 0xea, 0xbf, 0x20, 0xb8, 0xd0, 0xd1, 0x5d, 0xbd, 0x1d, 0x0d, 0xb5, 0x24, 0x74,
+	0xeb, 0xbe, 0xef, 0xb2, 0xbd, 0xae, 0x4f, 0x51, 0x62, 0x59, 0x90, 0x30, 0x5c, 0xca, 0x1f, 0x75,
+	0x0b, 0x45, 0x64, 0x41, 0xc4, 0x64, 0x26, 0xf6, 0x2d, 0x0a, 0x7d, 0xd1, 0x43, 0x28, 0xa0, 0x9c,
+	0x01, 0xf2, 0x9d, 0xc0, 0xc5, 0x5d, 0x8e, 0xa5, 0xd1, 0xb7, 0xbb, 0xd4, 0xf3, 0x15, 0x0d, 0x4e,
+	0x0f, 0xb4, 0x7a, 0x1d, 0xc7, 0xf6, 0x28, 0xb9, 0x09, 0xe5, 0x10, 0xbf, 0x22, 0x2d, 0x49, 0xab,
+	0x27, 0x9b, 0x95, 0x46, 0x7a, 0x0e, 0x1a, 0xa1, 0xc6, 0xf6, 0xec, 0xa7, 0xff, 0x5a, 0x3c, 0xf6,
+	0xf8, 0x7f, 0x9f, 0xac, 0x49, 0x1a, 0xaa, 0x28, 0x9b, 0xf0, 0x02, 0xb7, 0xf9, 0x1a, 0xf5, 0xef,
+	0xa0, 0x34, 0x0e, 0x47, 0x2a, 0x70, 0x42, 0x37, 0x4d, 0x97, 0x7a, 0xa1, 0xe1, 0x59, 0x2d, 0x7a,
+	0x55, 0xbe, 0x07, 0x15, 0x51, 0x09, 0x69, 0xbe, 0x09, 0x33, 0xd1, 0xb0, 0xc8, 0x23, 0x8b, 0x3c,
+	0x91, 0xd6, 0xf6, 0x74, 0x40, 0xa4, 0xc5, 0x1a, 0x8a, 0x8e, 0x38, 0x5b, 0x96, 0x95, 0xc6, 0xb9,
+	0x0d, 0xd0, 0x9f, 0x68, 0x34, 0x7d, 0xa5, 0x11, 0xce, 0x74, 0x23, 0x98, 0xe9, 0x46, 0xb8, 0x0e,
+	0x71, 0xbe, 0x1b, 0xbb, 0x7a, 0x8b, 0xa2, 0xae, 0x96, 0xd0, 0x54, 0x7e, 0x23, 0x41, 0x45, 0x1c,
+	0x23, 0x93, 0xbe, 0x34, 0x1e, 0x3d, 0x79, 0x6d, 0x00, 0x71, 0x8a, 0x23, 0xae, 0x0c, 0x45, 0x0c,
+	0x87, 0x1e, 0x60, 0x7c, 0x17, 0xaa, 0x1c, 0x31, 0x1e, 0xa9, 0xb7, 0x63, 0x18, 0xe6, 0x1d, 0x33,
+	0x8a, 0xc5, 0x02, 0x94, 0x99, 0xf9, 0xba, 0xee, 0xed, 0xe3, 0xcc, 0xe0, 0x1b, 0xb9, 0x9d, 0x01,
+	0xf0, 0x2c, 0x31, 0xfa, 0x9d, 0x04, 0x17, 0x72, 0x00, 0xbe, 0x5a, 0x81, 0x7a, 0x4f, 0x82, 0xa5,
+	0x24, 0x28, 0xa3, 0xde, 0x76, 0xef, 0x4d, 0xea, 0xb2, 0xb7, 0x18, 0x75, 0xa3, 0x68, 0xc9, 0x30,
+	0x73, 0x80, 0x4d, 0x18, 0xaf, 0xf8, 0x7d, 0x62, 0x11, 0xfb, 0x58, 0x82, 0xe5, 0x02, 0x90, 0xaf,
+	0x56, 0xd4, 0xe4, 0x68, 0xff, 0xea, 0x3e, 0xb3, 0x5b, 0xbb, 0x8e, 0xc5, 0x8c, 0x68, 0x9b, 0x29,
+	0x3f, 0x84, 0x73, 0x19, 0x7d, 0xc8, 0x7f, 0x0f, 0x9e, 0x6b, 0x25, 0xda, 0x71, 0x17, 0xd6, 0x44,
+	0x1f, 0x92, 0xda, 0xc9, 0xb4, 0x33, 0xa0, 0xae, 0xbc, 0x02, 0xe7, 0xf9, 0x58, 0xf7, 0xbc, 0x56,
+	0x30, 0x3c, 0x73, 0x69, 0x9b, 0xda, 0x7e, 0x94, 0xef, 0x48, 0x0d, 0xa0, 0xed, 0xb5, 0x1e, 0xf4,
+	0x3a, 0xf4, 0xbb, 0xae, 0x85, 0x33, 0x97, 0x68, 0x51, 0xfe, 0x2f, 0x41, 0x35, 0x5b, 0x1f, 0x71,
+	0xaf, 0xc1, 0xb4, 0xdb, 0xb5, 0x28, 0x57, 0x9d, 0x6b, 0x56, 0xf3, 0x30, 0xb5, 0xae, 0x45, 0x35,
+	0x2e, 0x49, 0x6e, 0xc2, 0x4c, 0x9b, 0xd9, 0x77, 0xe9, 0x01, 0xb5, 0x78, 0x80, 0xe7, 0x9a, 0x8b,
+	0xf9, 0x13, 0xc4, 0xc5, 0xb4, 0x58, 0x21, 0xe0, 0x8d, 0x3f, 0x14, 0x5e, 0xa5, 0xb4, 0x54, 0x0a,
+	0x78, 0xfb, 0x2d, 0xe4, 0x0e, 0x9c, 0xf4, 0x3a, 0xd4, 0x36, 0xef, 0xb2, 0x36, 0xf3, 0xbd, 0xca,
+	0x34, 0x5f, 0x00, 0xcb, 0xa2, 0x7d, 0x6e, 0xed, 0x7e, 0x2c, 0x89, 0xeb, 0x20, 0xa9, 0xab, 0xac,
+	0xc1, 0x02, 0xf7, 0x5c, 0xa3, 0x9e, 0x63, 0x1d, 0xd0, 0x5b, 0x2c, 0x4e, 0x0d, 0xf3, 0x50, 0x32,
+	0x99, 0x89, 0xd1, 0x0a, 0x1e, 0x95, 0xc7, 0x53, 0xf0, 0x82, 0x20, 0x8c, 0x11, 0xda, 0x87, 0xb3,
+	0x26, 0x7f, 0x75, 0xac, 0x6e, 0xb0, 0x34, 0xee, 0x51, 0x5f, 0x37, 0x75, 0x5f, 0xc7, 0x99, 0x5d,
+	0x11, 0xe1, 0x6e, 0x65, 0x89, 0x27, 0xa7, 0x38, 0xdb, 0x20, 0x79, 0x15, 0x4e, 0x9a, 0xcc, 0xbc,
+	0xe5, 0x18, 0xdd, 0x60, 0x8e, 0x70, 0xf5, 0x5e, 0xc8, 0xb4, 0x1f, 0x09, 0x69, 0x49, 0x0d, 0xb2,
+	0x07, 0xa7, 0x13, 0xaf, 0x31, 0x68, 0x89, 0x1b, 0xba, 0x5c, 0x68, 0x28, 0x0b, 0x33, 0xcb, 0x98,
+	0xb2, 0x01, 0x67, 0xe3, 0x0f, 0x9b, 0xe7, 0x75, 0xa9, 0x3b, 0xfc, 0x5b, 0xb8, 0x0b, 0x0b, 0x69,
+	0x15, 0x8c, 0xed, 0x0d, 0x28, 0x33, 0xde, 0x92, 0xff, 0x5d, 0x0e, 0x35, 0x70, 0x82, 0x51, 0x5a,
+	0xf9, 0x01, 0x9c, 0x8d, 0xbf, 0x4f, 0x03, 0x10, 0x93, 0xfa, 0x02, 0xfe, 0x5a, 0x82, 0x85, 0xf4,
+	0x08, 0x19, 0xcc, 0xa5, 0xd1, 0x99, 0x27, 0x97, 0x9a, 0x36, 0x60, 0x31, 0x0a, 0xe7, 0x4e, 0x7c,
+	0xa4, 0xba, 0x6f, 0xec, 0xd3, 0xb6, 0x1e, 0x85, 0x61, 0x0e, 0xa6, 0x70, 0x81, 0x4f, 0x6b, 0x53,
+	0xcc, 0x54, 0x1e, 0xc1, 0x52, 0xbe, 0x0a, 0xfa, 0xf5, 0x00, 0xe6, 0x8d, 0x54, 0x1f, 0x06, 0x50,
+	0x11, 0x3d, 0x4c, 0x5b, 0x41, 0x5f, 0x05, 0x0b, 0x0a, 0x43, 0xd8, 0x2d, 0xcb, 0xca, 0x83, 0x9d,
+	0xd4, 0x9c, 0xfd, 0x3d, 0xfa, 0xd0, 0x65, 0x8e, 0x55, 0xe8, 0x65, 0xe9, 0x8b, 0x79, 0x39, 0xb9,
+	0xb9, 0x5d, 0x87, 0x73, 0xe2, 0x44, 0xe5, 0xcd, 0xea, 0x47, 0x12, 0xc8, 0x59, 0xd2, 0xe8, 0xea,
+	0x36, 0x40, 0x1f, 0x14, 0xe3, 0x5a, 0x2d, 0x72, 0x12, 0xdd, 0x4b, 0x68, 0x91, 0x97, 0xa1, 0xec,
+	0xf9, 0xba, 0xdf, 0xf5, 0x30, 0xd5, 0x17, 0x07, 0x89, 0x4b, 0x6a, 0xa8, 0xa1, 0xfc, 0x44, 0xc2,
+	0xb9, 0xef, 0x4b, 0x78, 0xdb, 0xbd, 0xd7, 0x1d, 0xcb, 0xec, 0xef, 0xd7, 0x05, 0x28, 0xef, 0xf3,
+	0x86, 0xe8, 0x94, 0x16, 0xbe, 0x4d, 0xec, 0xcc, 0xf1, 0xc7, 0x68, 0x4d, 0x64, 0x32, 0xe4, 0x04,
+	0xaa, 0xf4, 0x0c, 0x81, 0x9a, 0xd8, 0x0a, 0x78, 0x59, 0x00, 0x0e, 0xc3, 0x7a, 0x97, 0x79, 0x7e,
+	0xf2, 0x6c, 0xdb, 0x4f, 0x9b, 0xb3, 0x71, 0x5a, 0xfc, 0x30, 0x3a, 0x61, 0x65, 0x2b, 0xa3, 0xbb,
+	0x97, 0xe0, 0x54, 0x38, 0x43, 0xbb, 0x5d, 0xb7, 0xe3, 0x78, 0x14, 0x8d, 0x0c, 0x36, 0x92, 0x25,
+	0x38, 0x49, 0x6d, 0xc3, 0x31, 0xa9, 0x19, 0x28, 0x73, 0x8f, 0x66, 0xb5, 0x64, 0x13, 0xb9, 0x02,
+	0x73, 0x5e, 0x6c, 0xfd, 0x3e, 0x7b, 0x87, 0xf2, 0x0f, 0xcd, 0xb4, 0x96, 0x6a, 0x55, 0x5e, 0xed,
+	0xe7, 0xab, 0xad, 0xe8, 0x4b, 0xbf, 0xc3, 0x5c, 0xa3, 0xcb, 0x62, 0x87, 0xaa, 0x30, 0x1b, 0x1f,
+	0x02, 0x10, 0xa7, 0xdf, 0x90, 0xcc, 0x5e, 0xa2, 0x81, 0xfe, 0xbe, 0xd6, 0x53, 0x7d, 0xf9, 0xd9,
+	0x2b, 0x6d, 0x25, 0xda, 0xd7, 0x69, 0x0b, 0xc9, 0xec, 0x95, 0x87, 0xfe, 0x65, 0x64, 0xaf, 0x31,
+	0xbd, 0x2c, 0x7d, 0x31, 0x2f, 0x27, 0xb7, 0x76, 0xdf, 0x84, 0xaa, 0x30, 0x51, 0xb7, 0x2d, 0xbd,
+	0x35, 0xf4, 0x88, 0x30, 0xb8, 0x00, 0xa6, 0xd2, 0x0b, 0xe0, 0x67, 0xd1, 0x5d, 0x4b, 0x34, 0x8c,
+	0x81, 0x79, 0x03, 0x4e, 0xe9, 0xc9, 0x0e, 0x9c, 0x88, 0xc5, 0x82, 0xa8, 0x04, 0x62, 0x18, 0x92,
+	0x41, 0xdd, 0x60, 0x7b, 0xe9, 0x86, 0xcf, 0x0e, 0x42, 0x92, 0x19, 0x0d, 0xdf, 0x94, 0x77, 0x31,
+	0xdd, 0x0e, 0x98, 0xf0, 0x86, 0x3b, 0x37, 0xa9, 0x64, 0xf6, 0x67, 0x09, 0xce, 0x67, 0x02, 0xe4,
+	0x07, 0xa1, 0xf4, 0xcc, 0x41, 0x98, 0xd8, 0xa2, 0xb8, 0x81, 0x51, 0xe3, 0xa7, 0xf5, 0x2d, 0xcb,
+	0x72, 0x1e, 0xea, 0xb6, 0x41, 0x87, 0x9f, 0x1a, 0x7f, 0x35, 0x0d, 0xe7, 0x33, 0x15, 0xd1, 0xdb,
+	0x0a, 0x9c, 0x30, 0x0c, 0xc3, 0x7c, 0x83, 0xf6, 0x22, 0x4d, 0x7c, 0x25, 0xd7, 0xe1, 0xb8, 0x35,
+	0xce, 0xf5, 0x24, 0x94, 0x0e, 0xa6, 0xfd, 0x21, 0xb3, 0x4d, 0xe7, 0x21, 0xcf, 0x63, 0x25, 0x0d,
+	0xdf, 0x88, 0x1b, 0xde, 0x49, 0xfc, 0xad, 0xb6, 0xd3, 0xb5, 0x7d, 0xbc, 0x93, 0x9c, 0x1b, 0x08,
+	0x45, 0x14, 0x84, 0x1d, 0x87, 0xd9, 0xdb, 0xd7, 0x83, 0x70, 0x7e, 0xfc, 0xef, 0xc5, 0xd5, 0x16,
+	0xf3, 0xf7, 0xbb, 0x7b, 0x0d, 0xc3, 0x69, 0xab, 0xa1, 0x30, 0xfe, 0xd4, 0x3d, 0xf3, 0x47, 0xaa,
+	0xdf, 0xeb, 0x50, 0x8f, 0x2b, 0x78, 0xe1, 0x69, 0x3b, 0x39, 0x48, 0xe0, 0x9c, 0xff, 0x68, 0x87,
+	0x8f, 0x77, 0x9c, 0x27, 0xd5, 0xe8, 0x35, 0xe8, 0xb1, 0x82, 0x0b, 0x0e, 0x35, 0x2b, 0x65, 0xbe,
+	0x3a, 0xa3, 0x57, 0xf2, 0x0a, 0x1c, 0xe7, 0x8f, 0x95, 0x13, 0x4b, 0xd2, 0x38, 0xb7, 0xa6, 0x50,
+	0x8b, 0xbc, 0x03, 0xcf, 0xbb, 0xb4, 0xad, 0x33, 0x9b, 0xd9, 0x2d, 0x74, 0x75, 0xe6, 0x4b, 0x72,
+	0x35, 0x3d, 0x10, 0x59, 0x83, 0xf9, 0xb8, 0xe9, 0x01, 0xfa, 0x3d, 0xcb, 0xfd, 0x16, 0xda, 0x9b,
+	0x1f, 0x9c, 0x83, 0xe3, 0x7c, 0x5d, 0x90, 0x87, 0x50, 0x0e, 0xab, 0x76, 0xe4, 0x92, 0xe8, 0xab,
+	0x58, 0x1c, 0x94, 0x2f, 0x0f, 0x91, 0x0a, 0x17, 0x96, 0xb2, 0xf4, 0xd3, 0x7f, 0xfc, 0xf7, 0xc3,
+	0x29, 0x99, 0x54, 0xd4, 0x6f, 0x67, 0xd7, 0x40, 0xc9, 0x2f, 0x25, 0x98, 0x89, 0x96, 0x10, 0xb9,
+	0x9a, 0x63, 0x55, 0x2c, 0x17, 0xca, 0x6b, 0xa3, 0x88, 0x22, 0xc5, 0x8b, 0x9c, 0xe2, 0x0a, 0xb9,
+	0x24, 0x52, 0xc4, 0x0f, 0x87, 0xb8, 0x57, 0x8e, 0xc8, 0x2f, 0x24, 0x38, 0x19, 0x99, 0xd8, 0xb2,
+	0xac, 0x5c, 0x28, 0xb1, 0x68, 0x28, 0xaf, 0x8d, 0x22, 0x8a, 0x50, 0x0a, 0x87, 0xaa, 0x12, 0x39,
+	0x1f, 0x8a, 0x3c, 0x96, 0x60, 0x3e, 0x5d, 0x13, 0x23, 0x8d, 0x9c, 0x41, 0x72, 0xaa, 0x77, 0xb2,
+	0x3a, 0xb2, 0x3c, 0x92, 0x6d, 0x72, 0xb2, 0x3a, 0x59, 0xcf, 0x27, 0xab, 0xef, 0xf5, 0xea, 0x41,
+	0x8a, 0x50, 0x0f, 0xc3, 0x52, 0xe0, 0x11, 0xf9, 0x9b, 0x04, 0x67, 0xb2, 0x8a, 0x51, 0xa4, 0x59,
+	0x3c, 0x7c, 0x56, 0x09, 0x4d, 0xde, 0x1c, 0x4b, 0x07, 0xb1, 0x6f, 0x72, 0xec, 0xeb, 0x64, 0x33,
+	0x17, 0x9b, 0x51, 0x2f, 0x00, 0x8f, 0xaa, 0x71, 0xea, 0x61, 0xf4, 0x74, 0x44, 0xde, 0x97, 0xe0,
+	0xb9, 0x64, 0x15, 0x89, 0xe4, 0xae, 0x2f, 0xb1, 0x88, 0x25, 0xaf, 0x8f, 0x24, 0x8b, 0x98, 0x2b,
+	0x1c, 0x73, 0x99, 0x2c, 0x8a, 0x98, 0x61, 0xb5, 0xaa, 0xde, 0x09, 0x09, 0x3e, 0x92, 0xe0, 0xf9,
+	0x54, 0xa9, 0x89, 0xd4, 0x73, 0x46, 0xca, 0x2e, 0x69, 0xc9, 0x8d, 0x51, 0xc5, 0x91, 0x6d, 0x8d,
+	0xb3, 0x5d, 0x22, 0x8a, 0xc8, 0xd6, 0xf6, 0x5a, 0x75, 0x37, 0x89, 0xf2, 0x9e, 0x04, 0xd0, 0x2f,
+	0xf1, 0x90, 0xd5, 0x9c, 0xa1, 0x84, 0x92, 0x91, 0x7c, 0x75, 0x04, 0x49, 0xe4, 0xb9, 0xc8, 0x79,
+	0x2e, 0x90, 0xf3, 0x22, 0x8f, 0xc9, 0x4c, 0xf5, 0xd0, 0x64, 0xe6, 0x11, 0xf9, 0xb9, 0x04, 0xe5,
+	0xb0, 0x4a, 0x40, 0x56, 0x0a, 0x92, 0x42, 0xb2, 0xb6, 0x21, 0xaf, 0x0e, 0x17, 0x1c, 0x1e, 0x92,
+	0xf0, 0xa6, 0x90, 0xc8, 0x1c, 0x3f, 0x96, 0x60, 0x36, 0x54, 0x0f, 0xf2, 0xc6, 0x4a, 0x41, 0x32,
+	0x18, 0x09, 0x46, 0xa8, 0x97, 0x14, 0xa5, 0x53, 0xac, 0x8c, 0xfc, 0x41, 0x82, 0xf9, 0xf4, 0x55,
+	0x9b, 0x6c, 0xe4, 0x7b, 0x9b, 0x53, 0x48, 0x90, 0x9b, 0xe3, 0xa8, 0x20, 0xdd, 0x35, 0x4e, 0xb7,
+	0x46, 0x56, 0x45, 0xba, 0xfe, 0xed, 0xae, 0xee, 0x71, 0xa5, 0x20, 0x71, 0x1c, 0x91, 0xdf, 0x4b,
+	0x70, 0x3a, 0x6d, 0x2e, 0x08, 0xdd, 0x46, 0x7e, 0x44, 0xc6, 0x05, 0x2e, 0x28, 0x60, 0x28, 0xeb,
+	0x1c, 0xf8, 0x32, 0xb9, 0x38, 0x02, 0x30, 0xf9, 0x40, 0x02, 0xe8, 0x5b, 0x22, 0xeb, 0xa3, 0x04,
+	0x28, 0x82, 0x7b, 0x71, 0x34, 0x61, 0xc4, 0xba, 0xca, 0xb1, 0x2e, 0x92, 0xe5, 0x22, 0xac, 0x30,
+	0x80, 0x7f, 0x19, 0x08, 0x60, 0x7c, 0x1d, 0xcf, 0x0d, 0x60, 0x7e, 0xf9, 0x40, 0x6e, 0x8e, 0xa3,
+	0x82, 0xa4, 0x2f, 0x71, 0xd2, 0x26, 0xb9, 0x56, 0x44, 0xca, 0x73, 0x6e, 0x58, 0x8b, 0x50, 0x0f,
+	0xc3, 0xdf, 0x23, 0xf2, 0x57, 0x09, 0xce, 0x64, 0xdd, 0xac, 0xc9, 0x70, 0x0c, 0xe1, 0x0e, 0x2f,
+	0x6f, 0x8e, 0xa5, 0x83, 0xec, 0xdf, 0xe0, 0xec, 0x9b, 0x64, 0xa3, 0x78, 0xf2, 0xb9, 0x62, 0xdd,
+	0x62, 0x9e, 0xaf, 0x1e, 0x86, 0x7b, 0xec, 0x88, 0xfc, 0x49, 0x82, 0xf9, 0xf4, 0x8d, 0xb0, 0x68,
+	0x93, 0xe5, 0xdc, 0x77, 0xe5, 0xe6, 0x38, 0x2a, 0x88, 0xfd, 0x75, 0x8e, 0xbd, 0x41, 0x54, 0x11,
+	0x3b, 0xbe, 0x74, 0xd4, 0x8d, 0x50, 0x49, 0x3d, 0x8c, 0x9b, 0xc2, 0xbd, 0x96, 0xb6, 0x3a, 0x64,
+	0xaf, 0x8d, 0xcb, 0x5d, 0x70, 0xdd, 0x2e, 0xda, 0x6b, 0x02, 0x37, 0xf9, 0x44, 0x82, 0x53, 0x03,
+	0xf7, 0x2a, 0xd2, 0x18, 0x21, 0x54, 0x89, 0xeb, 0xb1, 0xac, 0x8e, 0x2c, 0x8f, 0x7c, 0xdf, 0xe2,
+	0x7c, 0x2f, 0x91, 0x1b, 0x45, 0x7c, 0x6f, 0x59, 0x7a, 0xab, 0x9f, 0xef, 0x07, 0xc2, 0xfb, 0x5b,
+	0x09, 0xe6, 0x06, 0x2c, 0x7b, 0x24, 0x6f, 0xd7, 0x67, 0xde, 0x79, 0xe5, 0xfa, 0x88, 0xd2, 0xc3,
+	0x0f, 0x69, 0x83, 0xbc, 0x5e, 0xe2, 0x03, 0x15, 0x40, 0x0e, 0x5e, 0x01, 0x73, 0x21, 0x33, 0xaf,
+	0x98, 0x72, 0x7d, 0x44, 0xe9, 0xe1, 0x90, 0xfc, 0xbf, 0x97, 0xea, 0x7a, 0xa4, 0xd2, 0x87, 0xdc,
+	0x6e, 0x7e, 0xfa, 0xa4, 0x26, 0x7d, 0xf6, 0xa4, 0x26, 0xfd, 0xe7, 0x49, 0x4d, 0x7a, 0xff, 0x69,
+	0xed, 0xd8, 0x67, 0x4f, 0x6b, 0xc7, 0xfe, 0xf9, 0xb4, 0x76, 0xec, 0xfb, 0x95, 0xc8, 0xca, 0xa3,
+	0xbe, 0x1d, 0x7e, 0x21, 0xda, 0x2b, 0xf3, 0x3f, 0x64, 0xd8, 0xfc, 0x7c, 0x00, 0xb2, 0x4e, 0x2d,
+	0xa5, 0x2e, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Identity by address.
+	Identity(ctx context.Context, in *QueryGetIdentityRequest, opts ...grpc.CallOption) (*QueryGetIdentityResponse, error)
+	// Queries a list of Identity items.
+	IdentityAll(ctx context.Context, in *QueryAllIdentityRequest, opts ...grpc.CallOption) (*QueryAllIdentityResponse, error)
+	// Queries Identity by CCCD ID hash
+	IdentityByCccdId(ctx context.Context, in *QueryIdentityByCccdIdRequest, opts ...grpc.CallOption) (*QueryIdentityByCccdIdResponse, error)
+	// Queries the identities attested by a verifier.
+	IdentitiesByVerifier(ctx context.Context, in *QueryIdentitiesByVerifierRequest, opts ...grpc.CallOption) (*QueryIdentitiesByVerifierResponse, error)
+	// Queries the identity gating policy enforced by the ante handler.
+	GatingPolicy(ctx context.Context, in *QueryGatingPolicyRequest, opts ...grpc.CallOption) (*QueryGatingPolicyResponse, error)
+	// Explains what the identity gate requires of the signers of a message type.
+	MsgRequirements(ctx context.Context, in *QueryMsgRequirementsRequest, opts ...grpc.CallOption) (*QueryMsgRequirementsResponse, error)
+	// Resolves a did:nexelra DID to its DID document.
+	ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error)
+	// Queries a credential issuer by address.
+	Issuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error)
+	// Queries a list of credential issuers.
+	IssuerAll(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error)
+	// Queries a credential schema by id.
+	CredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error)
+	// Queries a list of credential schemas.
+	CredentialSchemaAll(ctx context.Context, in *QueryAllCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryAllCredentialSchemaResponse, error)
+	// Queries a credential and its current status by id.
+	Credential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error)
+	// Queries the credentials issued to a holder.
+	CredentialsByHolder(ctx context.Context, in *QueryCredentialsByHolderRequest, opts ...grpc.CallOption) (*QueryCredentialsByHolderResponse, error)
+	// Queries the revocation status list of an issuer.
+	CredentialStatusList(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error)
+	// Queries the circuit proving an attribute.
+	AttributeCircuit(ctx context.Context, in *QueryGetAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryGetAttributeCircuitResponse, error)
+	// Queries all attribute circuits.
+	AttributeCircuitAll(ctx context.Context, in *QueryAllAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryAllAttributeCircuitResponse, error)
+	// Queries the flag of an attribute on an identity.
+	AttributeFlag(ctx context.Context, in *QueryGetAttributeFlagRequest, opts ...grpc.CallOption) (*QueryGetAttributeFlagResponse, error)
+	// Queries the attribute flags of an identity.
+	AttributeFlags(ctx context.Context, in *QueryAttributeFlagsRequest, opts ...grpc.CallOption) (*QueryAttributeFlagsResponse, error)
+	// Queries what the identity of an address may still send within the
+	// window of the spend policy.
+	SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Identity(ctx context.Context, in *QueryGetIdentityRequest, opts ...grpc.CallOption) (*QueryGetIdentityResponse, error) {
+	out := new(QueryGetIdentityResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Identity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IdentityAll(ctx context.Context, in *QueryAllIdentityRequest, opts ...grpc.CallOption) (*QueryAllIdentityResponse, error) {
+	out := new(QueryAllIdentityResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IdentityAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IdentityByCccdId(ctx context.Context, in *QueryIdentityByCccdIdRequest, opts ...grpc.CallOption) (*QueryIdentityByCccdIdResponse, error) {
+	out := new(QueryIdentityByCccdIdResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IdentityByCccdId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IdentitiesByVerifier(ctx context.Context, in *QueryIdentitiesByVerifierRequest, opts ...grpc.CallOption) (*QueryIdentitiesByVerifierResponse, error) {
+	out := new(QueryIdentitiesByVerifierResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IdentitiesByVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GatingPolicy(ctx context.Context, in *QueryGatingPolicyRequest, opts ...grpc.CallOption) (*QueryGatingPolicyResponse, error) {
+	out := new(QueryGatingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/GatingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgRequirements(ctx context.Context, in *QueryMsgRequirementsRequest, opts ...grpc.CallOption) (*QueryMsgRequirementsResponse, error) {
+	out := new(QueryMsgRequirementsResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/MsgRequirements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error) {
+	out := new(QueryResolveDidResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/ResolveDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Issuer(ctx context.Context, in *QueryGetIssuerRequest, opts ...grpc.CallOption) (*QueryGetIssuerResponse, error) {
+	out := new(QueryGetIssuerResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Issuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuerAll(ctx context.Context, in *QueryAllIssuerRequest, opts ...grpc.CallOption) (*QueryAllIssuerResponse, error) {
+	out := new(QueryAllIssuerResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/IssuerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchema(ctx context.Context, in *QueryGetCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryGetCredentialSchemaResponse, error) {
+	out := new(QueryGetCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/CredentialSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchemaAll(ctx context.Context, in *QueryAllCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryAllCredentialSchemaResponse, error) {
+	out := new(QueryAllCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/CredentialSchemaAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Credential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error) {
+	out := new(QueryGetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/Credential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialsByHolder(ctx context.Context, in *QueryCredentialsByHolderRequest, opts ...grpc.CallOption) (*QueryCredentialsByHolderResponse, error) {
+	out := new(QueryCredentialsByHolderResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/CredentialsByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusList(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error) {
+	out := new(QueryCredentialStatusListResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/CredentialStatusList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeCircuit(ctx context.Context, in *QueryGetAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryGetAttributeCircuitResponse, error) {
+	out := new(QueryGetAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/AttributeCircuit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeCircuitAll(ctx context.Context, in *QueryAllAttributeCircuitRequest, opts ...grpc.CallOption) (*QueryAllAttributeCircuitResponse, error) {
+	out := new(QueryAllAttributeCircuitResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/AttributeCircuitAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeFlag(ctx context.Context, in *QueryGetAttributeFlagRequest, opts ...grpc.CallOption) (*QueryGetAttributeFlagResponse, error) {
+	out := new(QueryGetAttributeFlagResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/AttributeFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeFlags(ctx context.Context, in *QueryAttributeFlagsRequest, opts ...grpc.CallOption) (*QueryAttributeFlagsResponse, error) {
+	out := new(QueryAttributeFlagsResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/AttributeFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error) {
+	out := new(QuerySpendAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/nexelra.identity.Query/SpendAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Identity by address.
+	Identity(context.Context, *QueryGetIdentityRequest) (*QueryGetIdentityResponse, error)
+	// Queries a list of Identity items.
+	IdentityAll(context.Context, *QueryAllIdentityRequest) (*QueryAllIdentityResponse, error)
+	// Queries Identity by CCCD ID hash
+	IdentityByCccdId(context.Context, *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error)
+	// Queries the identities attested by a verifier.
+	IdentitiesByVerifier(context.Context, *QueryIdentitiesByVerifierRequest) (*QueryIdentitiesByVerifierResponse, error)
+	// Queries the identity gating policy enforced by the ante handler.
+	GatingPolicy(context.Context, *QueryGatingPolicyRequest) (*QueryGatingPolicyResponse, error)
+	// Explains what the identity gate requires of the signers of a message type.
+	MsgRequirements(context.Context, *QueryMsgRequirementsRequest) (*QueryMsgRequirementsResponse, error)
+	// Resolves a did:nexelra DID to its DID document.
+	ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error)
+	// Queries a credential issuer by address.
+	Issuer(context.Context, *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error)
+	// Queries a list of credential issuers.
+	IssuerAll(context.Context, *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error)
+	// Queries a credential schema by id.
+	CredentialSchema(context.Context, *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error)
+	// Queries a list of credential schemas.
+	CredentialSchemaAll(context.Context, *QueryAllCredentialSchemaRequest) (*QueryAllCredentialSchemaResponse, error)
+	// Queries a credential and its current status by id.
+	Credential(context.Context, *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error)
+	// Queries the credentials issued to a holder.
+	CredentialsByHolder(context.Context, *QueryCredentialsByHolderRequest) (*QueryCredentialsByHolderResponse, error)
+	// Queries the revocation status list of an issuer.
+	CredentialStatusList(context.Context, *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error)
+	// Queries the circuit proving an attribute.
+	AttributeCircuit(context.Context, *QueryGetAttributeCircuitRequest) (*QueryGetAttributeCircuitResponse, error)
+	// Queries all attribute circuits.
+	AttributeCircuitAll(context.Context, *QueryAllAttributeCircuitRequest) (*QueryAllAttributeCircuitResponse, error)
+	// Queries the flag of an attribute on an identity.
+	AttributeFlag(context.Context, *QueryGetAttributeFlagRequest) (*QueryGetAttributeFlagResponse, error)
+	// Queries the attribute flags of an identity.
+	AttributeFlags(context.Context, *QueryAttributeFlagsRequest) (*QueryAttributeFlagsResponse, error)
+	// Queries what the identity of an address may still send within the
+	// window of the spend policy.
+	SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Identity(ctx context.Context, req *QueryGetIdentityRequest) (*QueryGetIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identity not implemented")
+}
+func (*UnimplementedQueryServer) IdentityAll(ctx context.Context, req *QueryAllIdentityRequest) (*QueryAllIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentityAll not implemented")
+}
+func (*UnimplementedQueryServer) IdentityByCccdId(ctx context.Context, req *QueryIdentityByCccdIdRequest) (*QueryIdentityByCccdIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentityByCccdId not implemented")
+}
+func (*UnimplementedQueryServer) IdentitiesByVerifier(ctx context.Context, req *QueryIdentitiesByVerifierRequest) (*QueryIdentitiesByVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentitiesByVerifier not implemented")
+}
+func (*UnimplementedQueryServer) GatingPolicy(ctx context.Context, req *QueryGatingPolicyRequest) (*QueryGatingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatingPolicy not implemented")
+}
+func (*UnimplementedQueryServer) MsgRequirements(ctx context.Context, req *QueryMsgRequirementsRequest) (*QueryMsgRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRequirements not implemented")
+}
+func (*UnimplementedQueryServer) ResolveDid(ctx context.Context, req *QueryResolveDidRequest) (*QueryResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDid not implemented")
+}
+func (*UnimplementedQueryServer) Issuer(ctx context.Context, req *QueryGetIssuerRequest) (*QueryGetIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issuer not implemented")
+}
+func (*UnimplementedQueryServer) IssuerAll(ctx context.Context, req *QueryAllIssuerRequest) (*QueryAllIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAll not implemented")
+}
+func (*UnimplementedQueryServer) CredentialSchema(ctx context.Context, req *QueryGetCredentialSchemaRequest) (*QueryGetCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchema not implemented")
+}
+func (*UnimplementedQueryServer) CredentialSchemaAll(ctx context.Context, req *QueryAllCredentialSchemaRequest) (*QueryAllCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemaAll not implemented")
+}
+func (*UnimplementedQueryServer) Credential(ctx context.Context, req *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credential not implemented")
+}
+func (*UnimplementedQueryServer) CredentialsByHolder(ctx context.Context, req *QueryCredentialsByHolderRequest) (*QueryCredentialsByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialsByHolder not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatusList(ctx context.Context, req *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusList not implemented")
+}
+func (*UnimplementedQueryServer) AttributeCircuit(ctx context.Context, req *QueryGetAttributeCircuitRequest) (*QueryGetAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeCircuit not implemented")
+}
+func (*UnimplementedQueryServer) AttributeCircuitAll(ctx context.Context, req *QueryAllAttributeCircuitRequest) (*QueryAllAttributeCircuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeCircuitAll not implemented")
+}
+func (*UnimplementedQueryServer) AttributeFlag(ctx context.Context, req *QueryGetAttributeFlagRequest) (*QueryGetAttributeFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeFlag not implemented")
+}
+func (*UnimplementedQueryServer) AttributeFlags(ctx context.Context, req *QueryAttributeFlagsRequest) (*QueryAttributeFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeFlags not implemented")
+}
+func (*UnimplementedQueryServer) SpendAllowance(ctx context.Context, req *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowance not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Identity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Identity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/Identity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Identity(ctx, req.(*QueryGetIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IdentityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentityAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/IdentityAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentityAll(ctx, req.(*QueryAllIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IdentityByCccdId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentityByCccdIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentityByCccdId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/IdentityByCccdId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentityByCccdId(ctx, req.(*QueryIdentityByCccdIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IdentitiesByVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIdentitiesByVerifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IdentitiesByVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/IdentitiesByVerifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IdentitiesByVerifier(ctx, req.(*QueryIdentitiesByVerifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GatingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGatingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GatingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/GatingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GatingPolicy(ctx, req.(*QueryGatingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/MsgRequirements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRequirements(ctx, req.(*QueryMsgRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/ResolveDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDid(ctx, req.(*QueryResolveDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Issuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Issuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/Issuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Issuer(ctx, req.(*QueryGetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/IssuerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAll(ctx, req.(*QueryAllIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/CredentialSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchema(ctx, req.(*QueryGetCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchemaAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchemaAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/CredentialSchemaAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchemaAll(ctx, req.(*QueryAllCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Credential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Credential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/Credential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Credential(ctx, req.(*QueryGetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialsByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialsByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialsByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/CredentialsByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialsByHolder(ctx, req.(*QueryCredentialsByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/CredentialStatusList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusList(ctx, req.(*QueryCredentialStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeCircuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttributeCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeCircuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/AttributeCircuit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeCircuit(ctx, req.(*QueryGetAttributeCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeCircuitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttributeCircuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeCircuitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/AttributeCircuitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeCircuitAll(ctx, req.(*QueryAllAttributeCircuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttributeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/AttributeFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeFlag(ctx, req.(*QueryGetAttributeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/AttributeFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeFlags(ctx, req.(*QueryAttributeFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nexelra.identity.Query/SpendAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowance(ctx, req.(*QuerySpendAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nexelra.identity.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Identity",
+			Handler:    _Query_Identity_Handler,
+		},
+		{
+			MethodName: "IdentityAll",
+			Handler:    _Query_IdentityAll_Handler,
+		},
+		{
+			MethodName: "IdentityByCccdId",
+			Handler:    _Query_IdentityByCccdId_Handler,
+		},
+		{
+			MethodName: "IdentitiesByVerifier",
+			Handler:    _Query_IdentitiesByVerifier_Handler,
+		},
+		{
+			MethodName: "GatingPolicy",
+			Handler:    _Query_GatingPolicy_Handler,
+		},
+		{
+			MethodName: "MsgRequirements",
+			Handler:    _Query_MsgRequirements_Handler,
+		},
+		{
+			MethodName: "ResolveDid",
+			Handler:    _Query_ResolveDid_Handler,
+		},
+		{
+			MethodName: "Issuer",
+			Handler:    _Query_Issuer_Handler,
+		},
+		{
+			MethodName: "IssuerAll",
+			Handler:    _Query_IssuerAll_Handler,
+		},
+		{
+			MethodName: "CredentialSchema",
+			Handler:    _Query_CredentialSchema_Handler,
+		},
+		{
+			MethodName: "CredentialSchemaAll",
+			Handler:    _Query_CredentialSchemaAll_Handler,
+		},
+		{
+			MethodName: "Credential",
+			Handler:    _Query_Credential_Handler,
+		},
+		{
+			MethodName: "CredentialsByHolder",
+			Handler:    _Query_CredentialsByHolder_Handler,
+		},
+		{
+			MethodName: "CredentialStatusList",
+			Handler:    _Query_CredentialStatusList_Handler,
+		},
+		{
+			MethodName: "AttributeCircuit",
+			Handler:    _Query_AttributeCircuit_Handler,
+		},
+		{
+			MethodName: "AttributeCircuitAll",
+			Handler:    _Query_AttributeCircuitAll_Handler,
+		},
+		{
+			MethodName: "AttributeFlag",
+			Handler:    _Query_AttributeFlag_Handler,
+		},
+		{
+			MethodName: "AttributeFlags",
+			Handler:    _Query_AttributeFlags_Handler,
+		},
+		{
+			MethodName: "SpendAllowance",
+			Handler:    _Query_SpendAllowance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nexelra/identity/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIdentityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIdentityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIdentityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIdentityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Identity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIdentityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIdentityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIdentityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIdentityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIdentityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		for iNdEx := len(m.Identity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentityByCccdIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentityByCccdIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentityByCccdIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IdHash) > 0 {
+		i -= len(m.IdHash)
+		copy(dAtA[i:], m.IdHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentityByCccdIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentityByCccdIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentityByCccdIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		for iNdEx := len(m.Identity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentitiesByVerifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentitiesByVerifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentitiesByVerifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIdentitiesByVerifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIdentitiesByVerifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIdentitiesByVerifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		for iNdEx := len(m.Identity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGatingPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGatingPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGatingPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGatingPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGatingPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGatingPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GatingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgRequirementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgRequirementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attributes[iNdEx])
+			copy(dAtA[i:], m.Attributes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Attributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rule != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rule))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DidResolutionMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		for iNdEx := len(m.Issuer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issuer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CredentialSchema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCredentialSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCredentialSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCredentialSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialSchema) > 0 {
+		for iNdEx := len(m.CredentialSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialsByHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialsByHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialsByHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialsByHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialsByHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialsByHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credential) > 0 {
+		for iNdEx := len(m.Credential) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credential[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StatusListSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StatusListSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EncodedList) > 0 {
+		i -= len(m.EncodedList)
+		copy(dAtA[i:], m.EncodedList)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncodedList)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StatusPurpose) > 0 {
+		i -= len(m.StatusPurpose)
+		copy(dAtA[i:], m.StatusPurpose)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusPurpose)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttributeCircuitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttributeCircuitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttributeCircuitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttributeCircuitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttributeCircuitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttributeCircuitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AttributeCircuit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttributeCircuitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttributeCircuitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttributeCircuitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttributeCircuitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttributeCircuitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttributeCircuitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttributeCircuit) > 0 {
+		for iNdEx := len(m.AttributeCircuit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeCircuit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttributeFlagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttributeFlagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttributeFlagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttributeFlagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttributeFlagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttributeFlagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.AttributeFlag.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttributeFlag) > 0 {
+		for iNdEx := len(m.AttributeFlag) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeFlag[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingTxCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RemainingAmount) > 0 {
+		for iNdEx := len(m.RemainingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Limited {
+		i--
+		if m.Limited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SpentAmount) > 0 {
+		for iNdEx := len(m.SpentAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Level != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CccdKey) > 0 {
+		i -= len(m.CccdKey)
+		copy(dAtA[i:], m.CccdKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CccdKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetIdentityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Identity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIdentityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		for _, e := range m.Identity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentityByCccdIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IdHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentityByCccdIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		for _, e := range m.Identity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentitiesByVerifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIdentitiesByVerifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		for _, e := range m.Identity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGatingPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGatingPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatingPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMsgRequirementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgRequirementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rule != 0 {
+		n += 1 + sovQuery(uint64(m.Rule))
+	}
+	if m.MinLevel != 0 {
+		n += 1 + sovQuery(uint64(m.MinLevel))
+	}
+	if len(m.Attributes) > 0 {
+		for _, s := range m.Attributes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DidResolutionMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.DidDocumentMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Issuer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		for _, e := range m.Issuer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CredentialSchema.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCredentialSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CredentialSchema) > 0 {
+		for _, e := range m.CredentialSchema {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
-	if len(m.Identity) > 0 {
-		for _, e := range m.Identity {
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credential.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryCredentialsByHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialsByHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		for _, e := range m.Credential {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StatusPurpose)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EncodedList)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StatusListSize != 0 {
+		n += 1 + sovQuery(uint64(m.StatusListSize))
+	}
+	return n
+}
+
+func (m *QueryGetAttributeCircuitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttributeCircuitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AttributeCircuit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAttributeCircuitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAttributeCircuitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AttributeCircuit) > 0 {
+		for _, e := range m.AttributeCircuit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttributeFlagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttributeFlagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AttributeFlag.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAttributeFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AttributeFlag) > 0 {
+		for _, e := range m.AttributeFlag {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CccdKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Level != 0 {
+		n += 1 + sovQuery(uint64(m.Level))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	if len(m.SpentAmount) > 0 {
+		for _, e := range m.SpentAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.Limited {
+		n += 2
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemainingAmount) > 0 {
+		for _, e := range m.RemainingAmount {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.RemainingTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingTxCount))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIdentityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIdentityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIdentityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Identity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIdentityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIdentityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIdentityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = append(m.Identity, Identity{})
+			if err := m.Identity[len(m.Identity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentityByCccdIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentityByCccdIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentityByCccdIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentityByCccdIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentityByCccdIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentityByCccdIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = append(m.Identity, Identity{})
+			if err := m.Identity[len(m.Identity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentitiesByVerifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentitiesByVerifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentitiesByVerifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIdentitiesByVerifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIdentitiesByVerifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIdentitiesByVerifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = append(m.Identity, Identity{})
+			if err := m.Identity[len(m.Identity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGatingPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGatingPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGatingPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGatingPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGatingPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGatingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgRequirementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRequirementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRequirementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgRequirementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRequirementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRequirementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			m.Rule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rule |= GatingRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLevel", wireType)
+			}
+			m.MinLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinLevel |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, LevelSpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolutionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DidResolutionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer, Issuer{})
+			if err := m.Issuer[len(m.Issuer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCredentialSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCredentialSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CredentialSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCredentialSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCredentialSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchema = append(m.CredentialSchema, CredentialSchema{})
+			if err := m.CredentialSchema[len(m.CredentialSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CredentialStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialsByHolderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialsByHolderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialsByHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialsByHolderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialsByHolderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialsByHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential, Credential{})
+			if err := m.Credential[len(m.Credential)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusPurpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusPurpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusListSize", wireType)
+			}
+			m.StatusListSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusListSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttributeCircuitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttributeCircuitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttributeCircuitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryIdentityByCccdIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IdHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryIdentityByCccdIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Identity) > 0 {
-		for _, e := range m.Identity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetAttributeCircuitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttributeCircuitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttributeCircuitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeCircuit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttributeCircuit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAttributeCircuitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1099,12 +8378,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAttributeCircuitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAttributeCircuitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1126,7 +8441,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAttributeCircuitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1149,15 +8464,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAttributeCircuitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAttributeCircuitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeCircuit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1184,7 +8499,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AttributeCircuit = append(m.AttributeCircuit, AttributeCircuit{})
+			if err := m.AttributeCircuit[len(m.AttributeCircuit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1209,7 +8561,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIdentityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAttributeFlagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1232,15 +8584,47 @@ func (m *QueryGetIdentityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIdentityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAttributeFlagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIdentityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAttributeFlagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1268,7 +8652,7 @@ func (m *QueryGetIdentityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Attribute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1291,7 +8675,7 @@ func (m *QueryGetIdentityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetIdentityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAttributeFlagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1314,15 +8698,15 @@ func (m *QueryGetIdentityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetIdentityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAttributeFlagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAttributeFlagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeFlag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1349,10 +8733,30 @@ func (m *QueryGetIdentityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Identity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AttributeFlag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1374,7 +8778,7 @@ func (m *QueryGetIdentityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllIdentityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1397,13 +8801,45 @@ func (m *QueryAllIdentityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllIdentityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllIdentityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1460,7 +8896,7 @@ func (m *QueryAllIdentityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllIdentityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1483,15 +8919,15 @@ func (m *QueryAllIdentityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllIdentityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeFlag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1518,8 +8954,8 @@ func (m *QueryAllIdentityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = append(m.Identity, Identity{})
-			if err := m.Identity[len(m.Identity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AttributeFlag = append(m.AttributeFlag, AttributeFlag{})
+			if err := m.AttributeFlag[len(m.AttributeFlag)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1580,7 +9016,7 @@ func (m *QueryAllIdentityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIdentityByCccdIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1603,15 +9039,15 @@ func (m *QueryIdentityByCccdIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIdentityByCccdIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIdentityByCccdIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1639,43 +9075,7 @@ func (m *QueryIdentityByCccdIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1698,7 +9098,7 @@ func (m *QueryIdentityByCccdIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIdentityByCccdIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,15 +9121,85 @@ func (m *QueryIdentityByCccdIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIdentityByCccdIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIdentityByCccdIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CccdKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CccdKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= IdentityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1756,14 +9226,53 @@ func (m *QueryIdentityByCccdIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = append(m.Identity, Identity{})
-			if err := m.Identity[len(m.Identity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpentAmount = append(m.SpentAmount, types.Coin{})
+			if err := m.SpentAmount[len(m.SpentAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Limited = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1790,13 +9299,63 @@ func (m *QueryIdentityByCccdIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmount = append(m.RemainingAmount, types.Coin{})
+			if err := m.RemainingAmount[len(m.RemainingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTxCount", wireType)
+			}
+			m.RemainingTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultSpendWindow is the window of the spend limits of a freshly
+	// initialized chain, one day.
+	DefaultSpendWindow = int64(24 * 60 * 60)
+
+	// SpendBucketCount is the number of slices the spend window is split
+	// into. A spend leaves the window up to one slice after window seconds
+	// have passed, an hour for the default window.
+	SpendBucketCount = 24
+)
+
+// DefaultSpendPolicy returns the policy of a freshly initialized chain: a one
+// day window without limits.
+func DefaultSpendPolicy() SpendPolicy {
+	return SpendPolicy{Window: DefaultSpendWindow}
+}
+
+// LimitFor returns the spend limit of the identities of level
+func (p SpendPolicy) LimitFor(level IdentityLevel) (LevelSpendLimit, bool) {
+	for _, l := range p.Limits {
+		if l.Level == level {
+			return l, true
+		}
+	}
+
+	return LevelSpendLimit{}, false
+}
+
+// BucketLength returns the length (seconds) of the slices of the window
+func (p SpendPolicy) BucketLength() int64 {
+	if length := p.Window / SpendBucketCount; length > 0 {
+		return length
+	}
+	return 1
+}
+
+// Validate validates the spend policy
+func (p SpendPolicy) Validate() error {
+	if p.Window < 0 {
+		return fmt.Errorf("negative spend window: %d", p.Window)
+	}
+	if p.Window == 0 && len(p.Limits) > 0 {
+		return fmt.Errorf("spend limits need a window")
+	}
+
+	seen := make(map[IdentityLevel]bool, len(p.Limits))
+	for _, l := range p.Limits {
+		if err := validateIdentityLevel(l.Level); err != nil {
+			return fmt.Errorf("spend limit: %w", err)
+		}
+		if seen[l.Level] {
+			return fmt.Errorf("duplicate spend limit for %s", l.Level)
+		}
+		seen[l.Level] = true
+
+		if len(l.MaxAmount) == 0 && l.MaxTxCount == 0 && len(l.MaxTxAmount) == 0 {
+			return fmt.Errorf("spend limit for %s sets no limit", l.Level)
+		}
+		if err := l.MaxAmount.Validate(); err != nil {
+			return fmt.Errorf("spend limit for %s: %w", l.Level, err)
+		}
+		if err := l.MaxTxAmount.Validate(); err != nil {
+			return fmt.Errorf("spend limit for %s: max tx amount: %w", l.Level, err)
+		}
+	}
+
+	return nil
+}
+
+// ExceedsAmount reports whether amount goes over the limit in any of the
+// denoms of l.
+func (l LevelSpendLimit) ExceedsAmount(amount sdk.Coins) bool {
+	return exceeds(l.MaxAmount, amount)
+}
+
+// ExceedsTxAmount reports whether amount, sent in one transaction, goes over
+// the per-transaction limit in any of the denoms of l.
+func (l LevelSpendLimit) ExceedsTxAmount(amount sdk.Coins) bool {
+	return exceeds(l.MaxTxAmount, amount)
+}
+
+func exceeds(limits, amount sdk.Coins) bool {
+	for _, limit := range limits {
+		if amount.AmountOf(limit.Denom).GT(limit.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExceedsTxCount reports whether txCount goes over the limit.
+func (l LevelSpendLimit) ExceedsTxCount(txCount uint64) bool {
+	return l.MaxTxCount > 0 && txCount > l.MaxTxCount
+}
+
+// Remaining returns what is left of the limit once amount was sent in txCount
+// transactions.
+func (l LevelSpendLimit) Remaining(amount sdk.Coins, txCount uint64) (sdk.Coins, uint64) {
+	remaining := sdk.NewCoins()
+	for _, limit := range l.MaxAmount {
+		if left := limit.Amount.Sub(amount.AmountOf(limit.Denom)); left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(limit.Denom, left))
+		}
+	}
+
+	var remainingTxCount uint64
+	if l.MaxTxCount > txCount {
+		remainingTxCount = l.MaxTxCount - txCount
+	}
+	return remaining, remainingTxCount
+}
+
+// Usage returns the funds sent, and the transactions they were sent in,
+// within the window of policy ending at now (unix seconds).
+func (s IdentitySpend) Usage(policy SpendPolicy, now int64) (amount sdk.Coins, txCount uint64) {
+	amount = sdk.NewCoins()
+	for _, bucket := range s.Buckets {
+		if inWindow(policy, bucket, now) {
+			amount = amount.Add(bucket.Amount...)
+			txCount += bucket.TxCount
+		}
+	}
+	return amount, txCount
+}
+
+// Record adds a transaction sending amount at now (unix seconds) to the
+// spend, dropping the buckets that left the window of policy.
+func (s *IdentitySpend) Record(policy SpendPolicy, now int64, amount sdk.Coins) {
+	buckets := s.Buckets[:0]
+	for _, bucket := range s.Buckets {
+		if inWindow(policy, bucket, now) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	length := policy.BucketLength()
+	start := now - now%length
+	// the last bucket may be longer if the window was shortened since
+	if n := len(buckets); n > 0 && buckets[n-1].Start >= start {
+		buckets[n-1].Amount = buckets[n-1].Amount.Add(amount...)
+		buckets[n-1].TxCount++
+	} else {
+		buckets = append(buckets, SpendBucket{Start: start, Amount: sdk.NewCoins(amount...), TxCount: 1})
+	}
+	s.Buckets = buckets
+}
+
+// Validate validates the spend of an identity
+func (s IdentitySpend) Validate() error {
+	if s.CccdKey == "" {
+		return fmt.Errorf("spend without cccdKey")
+	}
+
+	for i, bucket := range s.Buckets {
+		if i > 0 && bucket.Start <= s.Buckets[i-1].Start {
+			return fmt.Errorf("spend of %s: buckets out of order", s.CccdKey)
+		}
+		if err := bucket.Amount.Validate(); err != nil {
+			return fmt.Errorf("spend of %s: %w", s.CccdKey, err)
+		}
+	}
+
+	return nil
+}
+
+// inWindow reports whether any part of bucket lies within the window of
+// policy ending at now. Counting partly expired buckets in full errs on the
+// side of the limit.
+func inWindow(policy SpendPolicy, bucket SpendBucket, now int64) bool {
+	return bucket.Start+policy.BucketLength() > now-policy.Window
+}
@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nexelra/identity/spend.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SpendBucket adds up the funds an identity sent, and the transactions it
+// sent them in, during one slice of the spend window.
+type SpendBucket struct {
+	// start is the block time (unix seconds) the slice starts at, a multiple of
+	// the slice length.
+	Start   int64                                    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	TxCount uint64                                   `protobuf:"varint,3,opt,name=txCount,proto3" json:"txCount,omitempty"`
+}
+
+func (m *SpendBucket) Reset()         { *m = SpendBucket{} }
+func (m *SpendBucket) String() string { return proto.CompactTextString(m) }
+func (*SpendBucket) ProtoMessage()    {}
+func (*SpendBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59cb0bded57c0978, []int{0}
+}
+func (m *SpendBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendBucket.Merge(m, src)
+}
+func (m *SpendBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendBucket proto.InternalMessageInfo
+
+func (m *SpendBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SpendBucket) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SpendBucket) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// IdentitySpend is the recent spend of a CCCD, keyed by its tag, or by the
+// commitment of identities attested before tags, so that every address of
+// the CCCD shares it. Buckets that left the window are pruned when the CCCD
+// sends again.
+type IdentitySpend struct {
+	// cccdKey is the CCCD key of the identities sharing the spend, see
+	// Identity.CccdKey.
+	CccdKey string `protobuf:"bytes,1,opt,name=cccdKey,proto3" json:"cccdKey,omitempty"`
+	// buckets are ordered by start.
+	Buckets []SpendBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *IdentitySpend) Reset()         { *m = IdentitySpend{} }
+func (m *IdentitySpend) String() string { return proto.CompactTextString(m) }
+func (*IdentitySpend) ProtoMessage()    {}
+func (*IdentitySpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59cb0bded57c0978, []int{1}
+}
+func (m *IdentitySpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentitySpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentitySpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentitySpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentitySpend.Merge(m, src)
+}
+func (m *IdentitySpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentitySpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentitySpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentitySpend proto.InternalMessageInfo
+
+func (m *IdentitySpend) GetCccdKey() string {
+	if m != nil {
+		return m.CccdKey
+	}
+	return ""
+}
+
+func (m *IdentitySpend) GetBuckets() []SpendBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SpendBucket)(nil), "nexelra.identity.SpendBucket")
+	proto.RegisterType((*IdentitySpend)(nil), "nexelra.identity.IdentitySpend")
+}
+
+func init() { proto.RegisterFile("nexelra/identity/spend.proto", fileDescriptor_59cb0bded57c0978) }
+
+var fileDescriptor_59cb0bded57c0978 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0x8e, 0xff, 0xf6, 0x6f, 0x85, 0x2b, 0x24, 0x88, 0x3a, 0x84, 0x0a, 0xdc, 0xaa, 0x53, 0x84,
+	0x84, 0xad, 0x16, 0x31, 0xb2, 0xa4, 0x13, 0x42, 0x62, 0x08, 0x1b, 0x9b, 0xe3, 0x58, 0xad, 0x55,
+	0x62, 0x57, 0xb5, 0x8b, 0xda, 0xb7, 0xe0, 0x2d, 0x40, 0x4c, 0x3c, 0x46, 0xc7, 0x8e, 0x4c, 0x80,
+	0x92, 0x81, 0xd7, 0x40, 0x71, 0x1c, 0x81, 0x58, 0xec, 0x3b, 0x7f, 0x77, 0xfe, 0xbe, 0xef, 0x0e,
+	0x1e, 0x4b, 0xbe, 0xe6, 0xf7, 0x4b, 0x4a, 0x44, 0xca, 0xa5, 0x11, 0x66, 0x43, 0xf4, 0x82, 0xcb,
+	0x14, 0x2f, 0x96, 0xca, 0x28, 0xff, 0xc0, 0xa1, 0xb8, 0x46, 0x7b, 0x87, 0x34, 0x13, 0x52, 0x11,
+	0x7b, 0x56, 0x45, 0x3d, 0xc4, 0x94, 0xce, 0x94, 0x26, 0x09, 0xd5, 0x9c, 0x3c, 0x8c, 0x12, 0x6e,
+	0xe8, 0x88, 0x30, 0x25, 0xa4, 0xc3, 0xbb, 0x53, 0x35, 0x55, 0x36, 0x24, 0x65, 0x54, 0xbd, 0x0e,
+	0x9f, 0x00, 0xec, 0xdc, 0x96, 0x54, 0xd1, 0x8a, 0xcd, 0xb9, 0xf1, 0xbb, 0xf0, 0xbf, 0x36, 0x74,
+	0x69, 0x02, 0x30, 0x00, 0x61, 0x23, 0xae, 0x12, 0x7f, 0x06, 0x5b, 0x34, 0x53, 0x2b, 0x69, 0x82,
+	0x7f, 0x83, 0x46, 0xd8, 0x19, 0x1f, 0xe1, 0x8a, 0x0c, 0x97, 0x64, 0xd8, 0x91, 0xe1, 0x89, 0x12,
+	0x32, 0xba, 0xd8, 0xbe, 0xf7, 0xbd, 0x97, 0x8f, 0x7e, 0x38, 0x15, 0x66, 0xb6, 0x4a, 0x30, 0x53,
+	0x19, 0x71, 0xca, 0xaa, 0xeb, 0x4c, 0xa7, 0x73, 0x62, 0x36, 0x0b, 0xae, 0x6d, 0x83, 0x7e, 0xfe,
+	0x7a, 0x3d, 0x05, 0xb1, 0xfb, 0xdf, 0x0f, 0x60, 0xdb, 0xac, 0x27, 0x96, 0xaa, 0x31, 0x00, 0x61,
+	0x33, 0xae, 0xd3, 0xe1, 0x0c, 0xee, 0x5f, 0x39, 0xfb, 0x56, 0x70, 0x59, 0xca, 0x18, 0x4b, 0xaf,
+	0xf9, 0xc6, 0x8a, 0xdd, 0x8b, 0xeb, 0xd4, 0xbf, 0x84, 0xed, 0xc4, 0xda, 0xd1, 0x4e, 0xef, 0x09,
+	0xfe, 0x3b, 0x41, 0xfc, 0xcb, 0x74, 0xd4, 0x2c, 0x35, 0xc7, 0x75, 0x4f, 0x34, 0xde, 0xe6, 0x08,
+	0xec, 0x72, 0x04, 0x3e, 0x73, 0x04, 0x1e, 0x0b, 0xe4, 0xed, 0x0a, 0xe4, 0xbd, 0x15, 0xc8, 0xbb,
+	0x0b, 0x6e, 0xdc, 0x9a, 0xd6, 0x3f, 0x8b, 0xb2, 0x56, 0x92, 0x96, 0x1d, 0xe7, 0xf9, 0xf7, 0x00,
+	0x81, 0xf8, 0xb1, 0xa3, 0xc9, 0x01, 0x00, 0x00,
+}
+
+func (m *SpendBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintSpend(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Start != 0 {
+		i = encodeVarintSpend(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentitySpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentitySpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentitySpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CccdKey) > 0 {
+		i -= len(m.CccdKey)
+		copy(dAtA[i:], m.CccdKey)
+		i = encodeVarintSpend(dAtA, i, uint64(len(m.CccdKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSpend(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSpend(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SpendBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovSpend(uint64(m.Start))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSpend(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovSpend(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *IdentitySpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CccdKey)
+	if l > 0 {
+		n += 1 + l + sovSpend(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovSpend(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSpend(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSpend(x uint64) (n int) {
+	return sovSpend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SpendBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentitySpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentitySpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentitySpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CccdKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CccdKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, SpendBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSpend(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSpend
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSpend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSpend
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSpend
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSpend
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSpend        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSpend          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSpend = fmt.Errorf("proto: unexpected end of group")
+)
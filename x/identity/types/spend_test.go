@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/types"
+)
+
+func TestIdentitySpendWindow(t *testing.T) {
+	policy := types.DefaultSpendPolicy()
+	require.Equal(t, int64(3600), policy.BucketLength())
+
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	const hour = int64(3600)
+	start := 1000 * hour
+
+	spend := types.IdentitySpend{CccdKey: "h"}
+	spend.Record(policy, start+10, stake(10))
+	spend.Record(policy, start+20, stake(20))
+	spend.Record(policy, start+hour, stake(5))
+	require.Len(t, spend.Buckets, 2)
+	require.NoError(t, spend.Validate())
+
+	amount, txCount := spend.Usage(policy, start+hour)
+	require.Equal(t, stake(35), amount)
+	require.Equal(t, uint64(3), txCount)
+
+	// the first bucket counts until all of it left the window
+	amount, txCount = spend.Usage(policy, start+24*hour+hour-1)
+	require.Equal(t, stake(35), amount)
+	require.Equal(t, uint64(3), txCount)
+	amount, txCount = spend.Usage(policy, start+25*hour)
+	require.Equal(t, stake(5), amount)
+	require.Equal(t, uint64(1), txCount)
+
+	// recording prunes what left the window
+	spend.Record(policy, start+25*hour, stake(1))
+	require.Len(t, spend.Buckets, 2)
+	require.Equal(t, start+hour, spend.Buckets[0].Start)
+}
+
+func TestLevelSpendLimit(t *testing.T) {
+	limit := types.LevelSpendLimit{
+		Level:      types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		MaxAmount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		MaxTxCount: 3,
+	}
+	require.False(t, limit.ExceedsAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 1000))))
+	require.True(t, limit.ExceedsAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 101))))
+	require.False(t, limit.ExceedsTxCount(3))
+	require.True(t, limit.ExceedsTxCount(4))
+
+	remaining, remainingTxCount := limit.Remaining(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), remaining)
+	require.Equal(t, uint64(2), remainingTxCount)
+	remaining, remainingTxCount = limit.Remaining(sdk.NewCoins(sdk.NewInt64Coin("stake", 140)), 5)
+	require.True(t, remaining.IsZero())
+	require.Zero(t, remainingTxCount)
+
+	// without a transaction count cap
+	limit.MaxTxCount = 0
+	require.False(t, limit.ExceedsTxCount(1000))
+
+	// the per-transaction amount is separate from the window amount
+	require.False(t, limit.ExceedsTxAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	limit.MaxTxAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.False(t, limit.ExceedsTxAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 1000))))
+	require.True(t, limit.ExceedsTxAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 11))))
+}
+
+func TestSpendPolicyValidate(t *testing.T) {
+	verified := types.LevelSpendLimit{Level: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED, MaxTxCount: 10}
+
+	tests := []struct {
+		desc   string
+		policy types.SpendPolicy
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			policy: types.DefaultSpendPolicy(),
+			valid:  true,
+		},
+		{
+			desc:   "unset",
+			policy: types.SpendPolicy{},
+			valid:  true,
+		},
+		{
+			desc:   "limits",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{verified}},
+			valid:  true,
+		},
+		{
+			desc: "per-transaction amount only",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{{
+				Level:       types.IdentityLevel_IDENTITY_LEVEL_SELF_DECLARED,
+				MaxTxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			}}},
+			valid: true,
+		},
+		{
+			desc:   "limits without window",
+			policy: types.SpendPolicy{Limits: []types.LevelSpendLimit{verified}},
+		},
+		{
+			desc:   "negative window",
+			policy: types.SpendPolicy{Window: -1},
+		},
+		{
+			desc:   "duplicate level",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{verified, verified}},
+		},
+		{
+			desc:   "unknown level",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{{Level: 9, MaxTxCount: 1}}},
+		},
+		{
+			desc:   "empty limit",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{{Level: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED}}},
+		},
+		{
+			desc: "invalid amount",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{{
+				Level:     types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+				MaxAmount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.ZeroInt()}},
+			}}},
+		},
+		{
+			desc: "unsorted per-transaction amount",
+			policy: types.SpendPolicy{Window: 3600, Limits: []types.LevelSpendLimit{{
+				Level:       types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+				MaxTxAmount: sdk.Coins{sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("stake", 1)},
+			}}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
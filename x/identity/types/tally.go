@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+const (
+	// MetadataKeyTallyMode is the key of the proposal metadata JSON object
+	// that selects how a governance proposal is tallied.
+	MetadataKeyTallyMode = "tally_mode"
+
+	// TallyModeOnePersonOneVote tallies a proposal with one vote per active
+	// identity, whatever the voter's stake.
+	TallyModeOnePersonOneVote = "one-person-one-vote"
+)
+
+// IsOnePersonOneVote reports whether the metadata of a governance proposal
+// selects the one-person-one-vote tally, i.e. it is a JSON object such as
+// {"tally_mode":"one-person-one-vote"}. Any other metadata, including
+// metadata that is not JSON, keeps the stake-weighted tally of x/gov.
+func IsOnePersonOneVote(metadata string) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &fields); err != nil {
+		return false
+	}
+
+	var mode string
+	if err := json.Unmarshal(fields[MetadataKeyTallyMode], &mode); err != nil {
+		return false
+	}
+	return mode == TallyModeOnePersonOneVote
+}
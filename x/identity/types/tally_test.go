@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/x/identity/types"
+)
+
+func TestIsOnePersonOneVote(t *testing.T) {
+	for _, tc := range []struct {
+		metadata string
+		want     bool
+	}{
+		{metadata: `{"tally_mode":"one-person-one-vote"}`, want: true},
+		{metadata: `{"title":"t","tally_mode":"one-person-one-vote"}`, want: true},
+		{metadata: `{"tally_mode":"stake"}`},
+		{metadata: `{"tally_mode":1}`},
+		{metadata: `{"title":"t"}`},
+		{metadata: `["tally_mode","one-person-one-vote"]`},
+		{metadata: "ipfs://CID"},
+		{metadata: ""},
+	} {
+		require.Equal(t, tc.want, types.IsOnePersonOneVote(tc.metadata), tc.metadata)
+	}
+}
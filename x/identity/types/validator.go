@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateOperatorIdentity returns an error unless identity qualifies the
+// account operator to run a validator: it must be active and verified to at
+// least level. found is false when operator has no identity.
+func ValidateOperatorIdentity(operator string, identity Identity, found bool, level IdentityLevel) error {
+	if !found || identity.Status != IdentityStatus_IDENTITY_STATUS_ACTIVE {
+		return errorsmod.Wrapf(ErrOperatorNoIdentity, "%s=%s", AttributeKeyAddress, operator)
+	}
+	if identity.Level < level {
+		return errorsmod.Wrapf(ErrOperatorIdentityLevel, "%s=%s: %s, %s required",
+			AttributeKeyAddress, operator, identity.Level, level)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"Nexelra/testutil/sample"
+	"Nexelra/x/identity/types"
+)
+
+func TestValidateOperatorIdentity(t *testing.T) {
+	operator := sample.AccAddress()
+	verified := types.Identity{Address: operator, Verifier: sample.AccAddress(), Level: types.IdentityLevel_IDENTITY_LEVEL_VERIFIED}
+
+	tests := []struct {
+		desc     string
+		identity types.Identity
+		found    bool
+		level    types.IdentityLevel
+		err      error
+	}{
+		{
+			desc:     "active",
+			identity: types.Identity{Address: operator},
+			found:    true,
+		},
+		{
+			desc: "missing",
+			err:  types.ErrOperatorNoIdentity,
+		},
+		{
+			desc:     "suspended",
+			identity: types.Identity{Address: operator, Status: types.IdentityStatus_IDENTITY_STATUS_SUSPENDED},
+			found:    true,
+			err:      types.ErrOperatorNoIdentity,
+		},
+		{
+			desc:     "self-declared below level",
+			identity: types.Identity{Address: operator},
+			found:    true,
+			level:    types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+			err:      types.ErrOperatorIdentityLevel,
+		},
+		{
+			desc:     "verified at level",
+			identity: verified,
+			found:    true,
+			level:    types.IdentityLevel_IDENTITY_LEVEL_VERIFIED,
+		},
+		{
+			desc:     "verified below level",
+			identity: verified,
+			found:    true,
+			level:    types.IdentityLevel_IDENTITY_LEVEL_ENHANCED,
+			err:      types.ErrOperatorIdentityLevel,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateOperatorIdentity(operator, tc.identity, tc.found, tc.level)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Contains(t, err.Error(), operator)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}